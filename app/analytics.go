@@ -0,0 +1,169 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	utils "github.com/daniarmas/api_go/utils"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gp "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxAnalyticsEvents is the number of events a batch may carry.
+	maxAnalyticsEvents = 100
+	// maxHourlyAnalyticsRange and maxDailyAnalyticsRange bound the time
+	// range of an analytics query.
+	maxHourlyAnalyticsRange = 31 * 24 * time.Hour
+	maxDailyAnalyticsRange  = 366 * 24 * time.Hour
+)
+
+func (m *AnalyticsServer) CollectAnalytics(ctx context.Context, req *pb.CollectAnalyticsRequest) (*gp.Empty, error) {
+	var violations []*epb.BadRequest_FieldViolation
+	md := utils.GetMetadata(ctx)
+	if len(req.BusinessAnalytics)+len(req.ItemAnalytics) > maxAnalyticsEvents {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "businessAnalytics",
+			Description: fmt.Sprintf("The request can't carry more than %d events", maxAnalyticsEvents),
+		})
+	}
+	for index, item := range req.BusinessAnalytics {
+		if !utils.IsValidUUID(&item.BusinessId) {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("businessAnalytics[%d].businessId", index),
+				Description: "The businessId field is not a valid uuid v4",
+			})
+		}
+		if item.Type == pb.BusinessAnalyticsType_BusinessAnalyticsTypeUnspecified {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("businessAnalytics[%d].type", index),
+				Description: "The type field is required",
+			})
+		}
+	}
+	for index, item := range req.ItemAnalytics {
+		if !utils.IsValidUUID(&item.ItemId) {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("itemAnalytics[%d].itemId", index),
+				Description: "The itemId field is not a valid uuid v4",
+			})
+		}
+		if item.Type == pb.ItemAnalyticsType_ItemAnalyticsTypeUnspecified {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("itemAnalytics[%d].type", index),
+				Description: "The type field is required",
+			})
+		}
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.analyticsService.CollectAnalytics(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *AnalyticsServer) GetBusinessAnalytics(ctx context.Context, req *pb.GetBusinessAnalyticsRequest) (*pb.GetBusinessAnalyticsResponse, error) {
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	maxRange := maxHourlyAnalyticsRange
+	if req.Granularity == pb.AnalyticsGranularity_AnalyticsGranularityDay {
+		maxRange = maxDailyAnalyticsRange
+	}
+	violations := validateAnalyticsQuery(req.BusinessId, req.StartTime, req.EndTime, maxRange)
+	if req.Granularity == pb.AnalyticsGranularity_AnalyticsGranularityUnspecified {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "granularity",
+			Description: "The granularity field is required",
+		})
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.analyticsService.GetBusinessAnalytics(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *AnalyticsServer) ListItemAnalytics(ctx context.Context, req *pb.ListItemAnalyticsRequest) (*pb.ListItemAnalyticsResponse, error) {
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	violations := validateAnalyticsQuery(req.BusinessId, req.StartTime, req.EndTime, maxDailyAnalyticsRange)
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.analyticsService.ListItemAnalytics(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// validateAnalyticsQuery checks the business and the time range of an
+// analytics query.
+func validateAnalyticsQuery(businessId string, startTime *timestamppb.Timestamp, endTime *timestamppb.Timestamp, maxRange time.Duration) []*epb.BadRequest_FieldViolation {
+	var violations []*epb.BadRequest_FieldViolation
+	if businessId == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "businessId",
+			Description: "The businessId field is required",
+		})
+	} else if !utils.IsValidUUID(&businessId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "businessId",
+			Description: "The businessId field is not a valid uuid v4",
+		})
+	}
+	if startTime == nil {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "startTime",
+			Description: "The startTime field is required",
+		})
+	}
+	if endTime == nil {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "endTime",
+			Description: "The endTime field is required",
+		})
+	}
+	if startTime != nil && endTime != nil {
+		timeRange := endTime.AsTime().Sub(startTime.AsTime())
+		if timeRange <= 0 {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       "endTime",
+				Description: "The endTime field must be after the startTime field",
+			})
+		} else if timeRange > maxRange {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       "endTime",
+				Description: fmt.Sprintf("The time range can't be longer than %s", maxRange),
+			})
+		}
+	}
+	return violations
+}
+
+func invalidArgumentsError(violations []*epb.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, "Invalid Arguments")
+	for _, violation := range violations {
+		st, _ = st.WithDetails(violation)
+	}
+	return st.Err()
+}
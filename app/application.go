@@ -20,25 +20,7 @@ func (m *ApplicationServer) ListApplication(ctx context.Context, req *pb.ListApp
 	}
 	res, err := m.applicationService.ListApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -78,27 +60,7 @@ func (m *ApplicationServer) DeleteApplication(ctx context.Context, req *pb.Delet
 	}
 	res, err := m.applicationService.DeleteApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "application not found":
-			st = status.New(codes.NotFound, "application not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -150,25 +112,7 @@ func (m *ApplicationServer) CreateApplication(ctx context.Context, req *pb.Creat
 	}
 	res, err := m.applicationService.CreateApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
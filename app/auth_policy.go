@@ -0,0 +1,36 @@
+package app
+
+import "github.com/daniarmas/api_go/internal/usecase"
+
+// AuthPolicies tells what every method requires from its caller. The methods
+// missing from the table require a signed in user.
+var AuthPolicies = map[string]usecase.AuthPolicy{
+	"/main.AuthenticationService/CreateVerificationCode": usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/GetVerificationCode":    usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/SignIn":                 usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/SignUp":                 usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/SessionExists":          usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/RefreshToken":           usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/SendPushNotification":   usecase.AuthPolicyApplication,
+	"/main.AuthenticationService/CheckSession":           usecase.AuthPolicyOptionalUser,
+	"/main.AuthenticationService/ListJwk":                usecase.AuthPolicyPublic,
+	"/main.AnalyticsService/CollectAnalytics":            usecase.AuthPolicyApplication,
+	"/main.BusinessService/Feed":                         usecase.AuthPolicyApplication,
+	"/main.BusinessService/GetBusiness":                  usecase.AuthPolicyApplication,
+	"/main.BusinessService/GetBusinessWithDistance":      usecase.AuthPolicyApplication,
+	"/main.BusinessService/SearchBusiness":               usecase.AuthPolicyApplication,
+	"/main.BusinessService/ListBusinessInBounds":         usecase.AuthPolicyApplication,
+	"/main.BusinessService/GetBusinessDeliveryZone":      usecase.AuthPolicyApplication,
+	"/main.ItemService/ListItem":                         usecase.AuthPolicyApplication,
+	"/main.ItemService/GetItem":                          usecase.AuthPolicyApplication,
+	"/main.ItemService/SearchItem":                       usecase.AuthPolicyOptionalUser,
+	"/main.ItemService/SearchItemByBusiness":             usecase.AuthPolicyApplication,
+	"/main.ItemService/SuggestSearch":                    usecase.AuthPolicyOptionalUser,
+	"/main.UserService/GetAddressInfo":                   usecase.AuthPolicyApplication,
+}
+
+// IsPublicMethod tells whether the method doesn't require anything from its
+// caller, not even the metadata of the clients.
+func IsPublicMethod(method string) bool {
+	return AuthPolicies[method] == usecase.AuthPolicyPublic
+}
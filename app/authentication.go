@@ -64,23 +64,7 @@ func (m *AuthenticationServer) SendPushNotification(ctx context.Context, req *pb
 	}
 	res, err := m.authenticationService.SendPushNotification(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -140,23 +124,7 @@ func (m *AuthenticationServer) SessionExists(ctx context.Context, req *pb.Sessio
 	}
 	res, err := m.authenticationService.SessionExists(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "verification code not found":
-			st = status.New(codes.NotFound, "Verification code not found")
-		case "user not found":
-			st = status.New(codes.NotFound, "User not found")
-		case "session not exists":
-			st = status.New(codes.NotFound, "Session not exists")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -208,21 +176,7 @@ func (m *AuthenticationServer) CreateVerificationCode(ctx context.Context, req *
 	}
 	res, err := m.authenticationService.CreateVerificationCode(ctx, req, meta)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "user not found":
-			st = status.New(codes.NotFound, "User not found")
-		case "user already exists":
-			st = status.New(codes.AlreadyExists, "User already exists")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -295,19 +249,7 @@ func (m *AuthenticationServer) GetVerificationCode(ctx context.Context, req *pb.
 	}
 	res, err := m.authenticationService.GetVerificationCode(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "verification code not found":
-			st = status.New(codes.NotFound, "Verification code not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -367,23 +309,7 @@ func (m *AuthenticationServer) SignIn(ctx context.Context, req *pb.SignInRequest
 	}
 	res, err := m.authenticationService.SignIn(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "verification code not found":
-			st = status.New(codes.NotFound, "Verification code not found")
-		case "user not found":
-			st = status.New(codes.NotFound, "User not found")
-		case "session limit reached":
-			st = status.New(codes.PermissionDenied, "Session limit reached")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -522,47 +448,16 @@ func (m *AuthenticationServer) SignUp(ctx context.Context, req *pb.SignUpRequest
 	}
 	res, err := m.authenticationService.SignUp(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "verification code not found":
-			st = status.New(codes.NotFound, "Verification code not found")
-		case "user exists":
-			st = status.New(codes.AlreadyExists, "User already exists")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
 
 func (m *AuthenticationServer) CheckSession(ctx context.Context, req *gp.Empty) (*pb.CheckSessionResponse, error) {
-	var st *status.Status
 	md := utils.GetMetadata(ctx)
 	res, err := m.authenticationService.CheckSession(ctx, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -596,23 +491,7 @@ func (m *AuthenticationServer) SignOut(ctx context.Context, req *pb.SignOutReque
 	}
 	res, err := m.authenticationService.SignOut(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -640,23 +519,15 @@ func (m *AuthenticationServer) RefreshToken(ctx context.Context, req *pb.Refresh
 	}
 	res, err := m.authenticationService.RefreshToken(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "refresh token not found":
-			st = status.New(codes.Unauthenticated, "Refresh token not found")
-		case "refresh token expired":
-			st = status.New(codes.Unauthenticated, "Refresh token expired")
-		case "refresh token contains an invalid number of segments", "refresh token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Refresh token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *AuthenticationServer) ListJwk(ctx context.Context, req *gp.Empty) (*pb.ListJwkResponse, error) {
+	res, err := m.authenticationService.ListJwk(ctx)
+	if err != nil {
+		return nil, err
 	}
 	return res, nil
 }
@@ -670,23 +541,61 @@ func (m *AuthenticationServer) ListSession(ctx context.Context, req *gp.Empty) (
 	}
 	res, err := m.authenticationService.ListSession(ctx, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *AuthenticationServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*gp.Empty, error) {
+	var invalidSessionId *epb.BadRequest_FieldViolation
+	var invalidArgs bool
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	if req.SessionId == "" {
+		invalidArgs = true
+		invalidSessionId = &epb.BadRequest_FieldViolation{
+			Field:       "sessionId",
+			Description: "The sessionId field is required",
 		}
+	} else if req.SessionId != "" {
+		if !utils.IsValidUUID(&req.SessionId) {
+			invalidArgs = true
+			invalidSessionId = &epb.BadRequest_FieldViolation{
+				Field:       "sessionId",
+				Description: "The sessionId field is not a valid uuid v4",
+			}
+		}
+	}
+	if invalidArgs {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidSessionId != nil {
+			st, _ = st.WithDetails(
+				invalidSessionId,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.authenticationService.RevokeSession(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *AuthenticationServer) RevokeAllOtherSessions(ctx context.Context, req *gp.Empty) (*gp.Empty, error) {
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
 		return nil, st.Err()
 	}
+	res, err := m.authenticationService.RevokeAllOtherSessions(ctx, md)
+	if err != nil {
+		return nil, err
+	}
 	return res, nil
 }
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	utils "github.com/daniarmas/api_go/utils"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gp "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// validateBanExpirationTime fails when the ban would be over before it starts.
+func validateBanExpirationTime(expirationTime *timestamppb.Timestamp) *epb.BadRequest_FieldViolation {
+	if expirationTime == nil {
+		return nil
+	}
+	if !expirationTime.IsValid() || !expirationTime.AsTime().After(time.Now()) {
+		return &epb.BadRequest_FieldViolation{
+			Field:       "expirationTime",
+			Description: "The expirationTime field must be in the future",
+		}
+	}
+	return nil
+}
+
+func (m *BanServer) BanDevice(ctx context.Context, req *pb.BanDeviceRequest) (*pb.BannedDevice, error) {
+	var invalidDeviceIdentifier, invalidReason, invalidExpirationTime *epb.BadRequest_FieldViolation
+	var invalidArgs bool
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	if req.DeviceIdentifier == "" {
+		invalidArgs = true
+		invalidDeviceIdentifier = &epb.BadRequest_FieldViolation{
+			Field:       "deviceIdentifier",
+			Description: "The deviceIdentifier field is required",
+		}
+	}
+	if req.Reason == "" {
+		invalidArgs = true
+		invalidReason = &epb.BadRequest_FieldViolation{
+			Field:       "reason",
+			Description: "The reason field is required",
+		}
+	}
+	invalidExpirationTime = validateBanExpirationTime(req.ExpirationTime)
+	if invalidExpirationTime != nil {
+		invalidArgs = true
+	}
+	if invalidArgs {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidDeviceIdentifier != nil {
+			st, _ = st.WithDetails(
+				invalidDeviceIdentifier,
+			)
+		}
+		if invalidReason != nil {
+			st, _ = st.WithDetails(
+				invalidReason,
+			)
+		}
+		if invalidExpirationTime != nil {
+			st, _ = st.WithDetails(
+				invalidExpirationTime,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.banService.BanDevice(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BanServer) UnbanDevice(ctx context.Context, req *pb.UnbanDeviceRequest) (*gp.Empty, error) {
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	if req.DeviceIdentifier == "" {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		st, _ = st.WithDetails(&epb.BadRequest_FieldViolation{
+			Field:       "deviceIdentifier",
+			Description: "The deviceIdentifier field is required",
+		})
+		return nil, st.Err()
+	}
+	res, err := m.banService.UnbanDevice(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BanServer) BanUser(ctx context.Context, req *pb.BanUserRequest) (*pb.BannedUser, error) {
+	var invalidUserId, invalidReason, invalidExpirationTime *epb.BadRequest_FieldViolation
+	var invalidArgs bool
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	if req.UserId == "" {
+		invalidArgs = true
+		invalidUserId = &epb.BadRequest_FieldViolation{
+			Field:       "userId",
+			Description: "The userId field is required",
+		}
+	} else if !utils.IsValidUUID(&req.UserId) {
+		invalidArgs = true
+		invalidUserId = &epb.BadRequest_FieldViolation{
+			Field:       "userId",
+			Description: "The userId field is not a valid uuid v4",
+		}
+	}
+	if req.Reason == "" {
+		invalidArgs = true
+		invalidReason = &epb.BadRequest_FieldViolation{
+			Field:       "reason",
+			Description: "The reason field is required",
+		}
+	}
+	invalidExpirationTime = validateBanExpirationTime(req.ExpirationTime)
+	if invalidExpirationTime != nil {
+		invalidArgs = true
+	}
+	if invalidArgs {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidUserId != nil {
+			st, _ = st.WithDetails(
+				invalidUserId,
+			)
+		}
+		if invalidReason != nil {
+			st, _ = st.WithDetails(
+				invalidReason,
+			)
+		}
+		if invalidExpirationTime != nil {
+			st, _ = st.WithDetails(
+				invalidExpirationTime,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.banService.BanUser(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BanServer) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest) (*gp.Empty, error) {
+	var invalidUserId *epb.BadRequest_FieldViolation
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	if req.UserId == "" {
+		invalidUserId = &epb.BadRequest_FieldViolation{
+			Field:       "userId",
+			Description: "The userId field is required",
+		}
+	} else if !utils.IsValidUUID(&req.UserId) {
+		invalidUserId = &epb.BadRequest_FieldViolation{
+			Field:       "userId",
+			Description: "The userId field is not a valid uuid v4",
+		}
+	}
+	if invalidUserId != nil {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		st, _ = st.WithDetails(
+			invalidUserId,
+		)
+		return nil, st.Err()
+	}
+	res, err := m.banService.UnbanUser(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
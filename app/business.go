@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/utils"
@@ -72,27 +73,7 @@ func (m *BusinessServer) BusinessIsInRange(ctx context.Context, req *pb.Business
 	}
 	err := m.businessService.BusinessIsInRange(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "business not found":
-			st = status.New(codes.NotFound, "Business not found")
-		case "business is not in range":
-			st = status.New(codes.OK, "Business is not in range")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	if err != nil {
 		return nil, err
@@ -149,27 +130,7 @@ func (m *BusinessServer) ModifyBusinessRolePermission(ctx context.Context, req *
 	}
 	res, err := m.businessService.ModifyBusinessRolePermission(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "business role not found":
-			st = status.New(codes.AlreadyExists, "Business role not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -209,27 +170,7 @@ func (m *BusinessServer) UpdateBusinessRole(ctx context.Context, req *pb.UpdateB
 	}
 	res, err := m.businessService.UpdateBusinessRole(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "business role not found":
-			st = status.New(codes.AlreadyExists, "Business role not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -269,27 +210,7 @@ func (m *BusinessServer) DeleteBusinessRole(ctx context.Context, req *pb.DeleteB
 	}
 	res, err := m.businessService.DeleteBusinessRole(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "business role not found":
-			st = status.New(codes.AlreadyExists, "Business role not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -363,25 +284,7 @@ func (m *BusinessServer) CreateBusinessRole(ctx context.Context, req *pb.CreateB
 	}
 	res, err := m.businessService.CreateBusinessRole(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -421,25 +324,7 @@ func (m *BusinessServer) ListBusinessRole(ctx context.Context, req *pb.ListBusin
 	}
 	res, err := m.businessService.ListBusinessRole(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -569,27 +454,7 @@ func (m *BusinessServer) UpdatePartnerApplication(ctx context.Context, req *pb.U
 	}
 	res, err := m.businessService.UpdatePartnerApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "partner application not found":
-			st = status.New(codes.NotFound, "Partner application not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -603,25 +468,7 @@ func (m *BusinessServer) ListPartnerApplication(ctx context.Context, req *pb.Lis
 	}
 	res, err := m.businessService.ListPartnerApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -731,29 +578,7 @@ func (m *BusinessServer) CreatePartnerApplication(ctx context.Context, req *pb.C
 	}
 	res, err := m.businessService.CreatePartnerApplication(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "already register as business user":
-			st = status.New(codes.AlreadyExists, "Already register as business user")
-		case "already exists a business with that name":
-			st = status.New(codes.AlreadyExists, "Already register as business user")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -767,31 +592,7 @@ func (m *BusinessServer) CreateBusiness(ctx context.Context, req *pb.CreateBusin
 	}
 	res, err := m.businessService.CreateBusiness(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "HighQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "HighQualityPhotoObject missing")
-		case "LowQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "LowQualityPhotoObject missing")
-		case "ThumbnailObject missing":
-			st = status.New(codes.InvalidArgument, "ThumbnailObject missing")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -805,139 +606,57 @@ func (m *BusinessServer) UpdateBusiness(ctx context.Context, req *pb.UpdateBusin
 	}
 	res, err := m.businessService.UpdateBusiness(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "HighQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "HighQualityPhotoObject missing")
-		case "LowQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "LowQualityPhotoObject missing")
-		case "ThumbnailObject missing":
-			st = status.New(codes.InvalidArgument, "ThumbnailObject missing")
-		case "business is open":
-			st = status.New(codes.InvalidArgument, "Business is open")
-		case "item in the cart":
-			st = status.New(codes.InvalidArgument, "Item in the cart")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
 
 func (m *BusinessServer) Feed(ctx context.Context, req *pb.FeedRequest) (*pb.FeedResponse, error) {
-	var invalidProvinceId, invalidMunicipalityId, invalidLocation, invalidSearchMunicipalityType *epb.BadRequest_FieldViolation
-	var invalidArgs bool
-	var st *status.Status
+	var violations []*epb.BadRequest_FieldViolation
 	md := utils.GetMetadata(ctx)
-	if req.SearchMunicipalityType == pb.SearchMunicipalityType_SearchMunicipalityTypeUnspecified {
-		invalidArgs = true
-		invalidSearchMunicipalityType = &epb.BadRequest_FieldViolation{
-			Field:       "SearchMunicipalityType",
-			Description: "The SearchMunicipalityType field is required",
-		}
-	}
 	if req.Location == nil {
-		invalidArgs = true
-		invalidLocation = &epb.BadRequest_FieldViolation{
-			Field:       "Location",
-			Description: "The Location field is required",
-		}
-	} else if req.Location != nil {
-		if req.Location.Latitude == 0 {
-			invalidArgs = true
-			invalidLocation = &epb.BadRequest_FieldViolation{
-				Field:       "Location.Latitude",
-				Description: "The Location.Latitude field is required",
-			}
-		} else if req.Location.Longitude == 0 {
-			invalidArgs = true
-			invalidLocation = &epb.BadRequest_FieldViolation{
-				Field:       "Location.Longitude",
-				Description: "The Location.Longitude field is required",
-			}
-		}
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "location",
+			Description: "The location field is required",
+		})
+	} else {
+		violations = append(violations, pointViolations("location", req.Location)...)
 	}
 	if req.ProvinceId == "" {
-		invalidArgs = true
-		invalidProvinceId = &epb.BadRequest_FieldViolation{
-			Field:       "ProvinceId",
-			Description: "The ProvinceId field is required",
-		}
-	} else if req.ProvinceId != "" {
-		if !utils.IsValidUUID(&req.ProvinceId) {
-			invalidArgs = true
-			invalidProvinceId = &epb.BadRequest_FieldViolation{
-				Field:       "ProvinceId",
-				Description: "The ProvinceId field is not a valid uuid v4",
-			}
-		}
-	}
-	if req.MunicipalityId == "" {
-		invalidArgs = true
-		invalidMunicipalityId = &epb.BadRequest_FieldViolation{
-			Field:       "MunicipalityId",
-			Description: "The MunicipalityId field is required",
-		}
-	} else if req.MunicipalityId != "" {
-		if !utils.IsValidUUID(&req.MunicipalityId) {
-			invalidArgs = true
-			invalidMunicipalityId = &epb.BadRequest_FieldViolation{
-				Field:       "MunicipalityId",
-				Description: "The MunicipalityId field is not a valid uuid v4",
-			}
-		}
-	}
-	if invalidArgs {
-		st = status.New(codes.InvalidArgument, "Invalid Arguments")
-		if invalidMunicipalityId != nil {
-			st, _ = st.WithDetails(
-				invalidMunicipalityId,
-			)
-		}
-		if invalidLocation != nil {
-			st, _ = st.WithDetails(
-				invalidLocation,
-			)
-		}
-		if invalidSearchMunicipalityType != nil {
-			st, _ = st.WithDetails(
-				invalidSearchMunicipalityType,
-			)
-		}
-		if invalidProvinceId != nil {
-			st, _ = st.WithDetails(
-				invalidProvinceId,
-			)
-		}
-		return nil, st.Err()
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is required",
+		})
+	} else if !utils.IsValidUUID(&req.ProvinceId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is not a valid uuid v4",
+		})
+	}
+	if req.MunicipalityId != "" && !utils.IsValidUUID(&req.MunicipalityId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "municipalityId",
+			Description: "The municipalityId field is not a valid uuid v4",
+		})
+	}
+	if req.BusinessCategoryId != "" && !utils.IsValidUUID(&req.BusinessCategoryId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "businessCategoryId",
+			Description: "The businessCategoryId field is not a valid uuid v4",
+		})
+	}
+	if _, ok := pb.FeedOrder_name[int32(req.Order)]; !ok {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "order",
+			Description: "The order field is not a valid feed order",
+		})
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
 	}
 	res, err := m.businessService.Feed(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -999,19 +718,7 @@ func (m *BusinessServer) GetBusiness(ctx context.Context, req *pb.GetBusinessReq
 	}
 	res, err := m.businessService.GetBusiness(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "business not found":
-			st = status.New(codes.NotFound, "Business not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	if err != nil {
 		return nil, err
@@ -1076,22 +783,165 @@ func (m *BusinessServer) GetBusinessWithDistance(ctx context.Context, req *pb.Ge
 	}
 	res, err := m.businessService.GetBusinessWithDistance(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "business not found":
-			st = status.New(codes.NotFound, "Business not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BusinessServer) SearchBusiness(ctx context.Context, req *pb.SearchBusinessRequest) (*pb.SearchBusinessResponse, error) {
+	var violations []*epb.BadRequest_FieldViolation
+	md := utils.GetMetadata(ctx)
+	if req.Query == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "query",
+			Description: "The query field is required",
+		})
+	}
+	if req.ProvinceId == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is required",
+		})
+	} else if !utils.IsValidUUID(&req.ProvinceId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is not a valid uuid v4",
+		})
+	}
+	if req.MunicipalityId != "" && !utils.IsValidUUID(&req.MunicipalityId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "municipalityId",
+			Description: "The municipalityId field is not a valid uuid v4",
+		})
+	}
+	if req.BusinessCategoryId != "" && !utils.IsValidUUID(&req.BusinessCategoryId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "businessCategoryId",
+			Description: "The businessCategoryId field is not a valid uuid v4",
+		})
+	}
+	if req.Location != nil {
+		violations = append(violations, pointViolations("location", req.Location)...)
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.businessService.SearchBusiness(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BusinessServer) ListBusinessInBounds(ctx context.Context, req *pb.ListBusinessInBoundsRequest) (*pb.ListBusinessInBoundsResponse, error) {
+	var violations []*epb.BadRequest_FieldViolation
+	md := utils.GetMetadata(ctx)
+	if req.Bounds == nil || req.Bounds.SouthWest == nil || req.Bounds.NorthEast == nil {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "bounds",
+			Description: "The bounds.southWest and bounds.northEast fields are required",
+		})
+	} else {
+		pointErrors := append(pointViolations("bounds.southWest", req.Bounds.SouthWest), pointViolations("bounds.northEast", req.Bounds.NorthEast)...)
+		violations = append(violations, pointErrors...)
+		if len(pointErrors) == 0 && (req.Bounds.SouthWest.Latitude >= req.Bounds.NorthEast.Latitude || req.Bounds.SouthWest.Longitude >= req.Bounds.NorthEast.Longitude) {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       "bounds",
+				Description: "The bounds.southWest field must be south and west of bounds.northEast",
+			})
+		}
+	}
+	if req.Zoom < 0 || req.Zoom > maxMapZoom {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "zoom",
+			Description: fmt.Sprintf("The zoom field must be between 0 and %d", maxMapZoom),
+		})
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.businessService.ListBusinessInBounds(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *BusinessServer) UpdateBusinessDeliveryZone(ctx context.Context, req *pb.UpdateBusinessDeliveryZoneRequest) (*pb.BusinessDeliveryZone, error) {
+	var violations []*epb.BadRequest_FieldViolation
+	md := utils.GetMetadata(ctx)
+	violations = append(violations, businessIdViolations(req.BusinessId)...)
+	if req.GeoJson == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "geoJson",
+			Description: "The geoJson field is required",
+		})
+	} else if len(req.GeoJson) > maxDeliveryZoneGeoJson {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "geoJson",
+			Description: fmt.Sprintf("The geoJson field must be at most %d bytes", maxDeliveryZoneGeoJson),
+		})
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.businessService.UpdateBusinessDeliveryZone(ctx, req, md)
+	if err != nil {
+		return nil, err
 	}
+	return res, nil
+}
+
+func (m *BusinessServer) GetBusinessDeliveryZone(ctx context.Context, req *pb.GetBusinessDeliveryZoneRequest) (*pb.BusinessDeliveryZone, error) {
+	md := utils.GetMetadata(ctx)
+	if violations := businessIdViolations(req.BusinessId); len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.businessService.GetBusinessDeliveryZone(ctx, req, md)
 	if err != nil {
 		return nil, err
 	}
 	return res, nil
 }
+
+// maxDeliveryZoneGeoJson bounds the size of a delivery zone in a request.
+const maxDeliveryZoneGeoJson = 1 << 20
+
+func businessIdViolations(businessId string) []*epb.BadRequest_FieldViolation {
+	if businessId == "" {
+		return []*epb.BadRequest_FieldViolation{{
+			Field:       "businessId",
+			Description: "The businessId field is required",
+		}}
+	} else if !utils.IsValidUUID(&businessId) {
+		return []*epb.BadRequest_FieldViolation{{
+			Field:       "businessId",
+			Description: "The businessId field is not a valid uuid v4",
+		}}
+	}
+	return nil
+}
+
+// maxMapZoom is the highest zoom of the map tiles.
+const maxMapZoom = 22
+
+// pointViolations checks the latitude and longitude ranges of the point.
+func pointViolations(field string, point *pb.Point) []*epb.BadRequest_FieldViolation {
+	var violations []*epb.BadRequest_FieldViolation
+	if point.Latitude < -90 || point.Latitude > 90 {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       field + ".latitude",
+			Description: "The " + field + ".latitude field must be between -90 and 90",
+		})
+	}
+	if point.Longitude < -180 || point.Longitude > 180 {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       field + ".longitude",
+			Description: "The " + field + ".longitude field must be between -180 and 180",
+		})
+	}
+	return violations
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateUUIDField returns a violation when the field is empty or not a uuid.
+func validateUUIDField(field string, value string) *epb.BadRequest_FieldViolation {
+	if value == "" {
+		return &epb.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "The " + field + " field is required",
+		}
+	} else if !utils.IsValidUUID(&value) {
+		return &epb.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "The " + field + " field is not a valid uuid v4",
+		}
+	}
+	return nil
+}
+
+func (m *OrderServer) ListBusinessOrder(ctx context.Context, req *pb.ListBusinessOrderRequest) (*pb.ListBusinessOrderResponse, error) {
+	var invalidTime *epb.BadRequest_FieldViolation
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	invalidBusinessId := validateUUIDField("businessId", req.BusinessId)
+	if req.StartTime != nil && req.EndTime != nil && !req.StartTime.AsTime().Before(req.EndTime.AsTime()) {
+		invalidTime = &epb.BadRequest_FieldViolation{
+			Field:       "endTime",
+			Description: "The endTime field must be after the startTime field",
+		}
+	}
+	if invalidBusinessId != nil || invalidTime != nil {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidBusinessId != nil {
+			st, _ = st.WithDetails(
+				invalidBusinessId,
+			)
+		}
+		if invalidTime != nil {
+			st, _ = st.WithDetails(
+				invalidTime,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.orderService.ListBusinessOrder(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *OrderServer) GetBusinessOrder(ctx context.Context, req *pb.GetBusinessOrderRequest) (*pb.Order, error) {
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	invalidBusinessId := validateUUIDField("businessId", req.BusinessId)
+	invalidId := validateUUIDField("id", req.Id)
+	if invalidBusinessId != nil || invalidId != nil {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidBusinessId != nil {
+			st, _ = st.WithDetails(
+				invalidBusinessId,
+			)
+		}
+		if invalidId != nil {
+			st, _ = st.WithDetails(
+				invalidId,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.orderService.GetBusinessOrder(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *OrderServer) UpdateBusinessOrder(ctx context.Context, req *pb.UpdateBusinessOrderRequest) (*pb.Order, error) {
+	var invalidStatus *epb.BadRequest_FieldViolation
+	var st *status.Status
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return nil, st.Err()
+	}
+	invalidBusinessId := validateUUIDField("businessId", req.BusinessId)
+	invalidId := validateUUIDField("id", req.Id)
+	if req.Status == pb.OrderStatusType_OrderStatusTypeUnspecified {
+		invalidStatus = &epb.BadRequest_FieldViolation{
+			Field:       "status",
+			Description: "The status field is required",
+		}
+	}
+	if invalidBusinessId != nil || invalidId != nil || invalidStatus != nil {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidBusinessId != nil {
+			st, _ = st.WithDetails(
+				invalidBusinessId,
+			)
+		}
+		if invalidId != nil {
+			st, _ = st.WithDetails(
+				invalidId,
+			)
+		}
+		if invalidStatus != nil {
+			st, _ = st.WithDetails(
+				invalidStatus,
+			)
+		}
+		return nil, st.Err()
+	}
+	res, err := m.orderService.UpdateBusinessOrder(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -2,7 +2,6 @@ package app
 
 import (
 	"context"
-	"strings"
 
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	utils "github.com/daniarmas/api_go/utils"
@@ -59,39 +58,7 @@ func (m *CartItemServer) EmptyAndAddCartItem(ctx context.Context, req *pb.EmptyA
 	}
 	res, err := m.cartItemService.EmptyAndAddCartItem(ctx, req, md)
 	if err != nil {
-		errorr := strings.Split(err.Error(), ":")
-		switch errorr[0] {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "item not found":
-			st = status.New(codes.NotFound, "Item not found")
-		case "no_availability":
-			st = status.New(codes.InvalidArgument, "No availability")
-			ds, _ := st.WithDetails(
-				&epb.QuotaFailure{
-					Violations: []*epb.QuotaFailure_Violation{{
-						Subject:     "Availability",
-						Description: errorr[2],
-					}},
-				},
-			)
-			st = ds
-		case "business is closed":
-			st = status.New(codes.FailedPrecondition, "Business is closed")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -105,23 +72,7 @@ func (m *CartItemServer) EmptyCartItem(ctx context.Context, req *gp.Empty) (*gp.
 	}
 	res, err := m.cartItemService.EmptyCartItem(ctx, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -135,23 +86,7 @@ func (m *CartItemServer) ListCartItem(ctx context.Context, req *pb.ListCartItemR
 	}
 	res, err := m.cartItemService.ListCartItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -203,41 +138,7 @@ func (m *CartItemServer) AddCartItem(ctx context.Context, req *pb.AddCartItemReq
 	}
 	res, err := m.cartItemService.AddCartItem(ctx, req, md)
 	if err != nil {
-		errorr := strings.Split(err.Error(), ":")
-		switch errorr[0] {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "item not found":
-			st = status.New(codes.NotFound, "Item not found")
-		case "business is closed":
-			st = status.New(codes.FailedPrecondition, "Business is closed")
-		case "no_availability":
-			st = status.New(codes.InvalidArgument, "No availability")
-			ds, _ := st.WithDetails(
-				&epb.QuotaFailure{
-					Violations: []*epb.QuotaFailure_Violation{{
-						Subject:     "Availability",
-						Description: errorr[2],
-					}},
-				},
-			)
-			st = ds
-		case "the items in the cart can only be from one business":
-			st = status.New(codes.InvalidArgument, "The items in the cart can only be from one business")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -292,26 +193,7 @@ func (m *CartItemServer) DeleteCartItem(ctx context.Context, req *pb.DeleteCartI
 	}
 	res, err := m.cartItemService.DeleteCartItem(ctx, req, md)
 	if err != nil {
-		errorr := strings.Split(err.Error(), ":")
-		switch errorr[0] {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "cart item not found":
-			st = status.New(codes.NotFound, "Cart item not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -325,24 +207,7 @@ func (m *CartItemServer) IsEmptyCartItem(ctx context.Context, req *gp.Empty) (*p
 	}
 	res, err := m.cartItemService.IsEmptyCartItem(ctx, req, md)
 	if err != nil {
-		errorr := strings.Split(err.Error(), ":")
-		switch errorr[0] {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	utils "github.com/daniarmas/api_go/utils"
@@ -11,6 +12,10 @@ import (
 	gp "google.golang.org/protobuf/types/known/emptypb"
 )
 
+// maxSuggestSearchPrefix bounds the prefix of the suggestions, the apps send
+// it on every keystroke.
+const maxSuggestSearchPrefix = 100
+
 func (m *ItemServer) ListItem(ctx context.Context, req *pb.ListItemRequest) (*pb.ListItemResponse, error) {
 	var invalidBusinessId, invalidBusinessCollectionId *epb.BadRequest_FieldViolation
 	var invalidArgs bool
@@ -50,17 +55,7 @@ func (m *ItemServer) ListItem(ctx context.Context, req *pb.ListItemRequest) (*pb
 	}
 	res, err := m.itemService.ListItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -96,19 +91,7 @@ func (m *ItemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.I
 	}
 	res, err := m.itemService.GetItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "item not found":
-			st = status.New(codes.NotFound, "Item not found")
-		default:
-			st = status.New(codes.Internal, err.Error())
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -234,125 +217,57 @@ func (m *ItemServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest)
 	}
 	res, err := m.itemService.UpdateItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "item in the cart":
-			st = status.New(codes.InvalidArgument, "Item in the cart")
-		case "item not found":
-			st = status.New(codes.NotFound, "Item not found")
-		case "HighQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "HighQualityPhotoObject missing")
-		case "LowQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "LowQualityPhotoObject missing")
-		case "ThumbnailObject missing":
-			st = status.New(codes.InvalidArgument, "ThumbnailObject missing")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
 
 func (m *ItemServer) SearchItem(ctx context.Context, req *pb.SearchItemRequest) (*pb.SearchItemResponse, error) {
-	var invalidProvinceId, invalidMunicipalityId, invalidName, invalidSearchMunicipalityType *epb.BadRequest_FieldViolation
-	var invalidArgs bool
-	var st *status.Status
+	var violations []*epb.BadRequest_FieldViolation
 	md := utils.GetMetadata(ctx)
-	if req.SearchMunicipalityType == pb.SearchMunicipalityType_SearchMunicipalityTypeUnspecified {
-		invalidArgs = true
-		invalidSearchMunicipalityType = &epb.BadRequest_FieldViolation{
-			Field:       "searchMunicipalityType",
-			Description: "The searchMunicipalityType field is required",
-		}
-	}
 	if req.Name == "" {
-		invalidArgs = true
-		invalidName = &epb.BadRequest_FieldViolation{
+		violations = append(violations, &epb.BadRequest_FieldViolation{
 			Field:       "name",
 			Description: "The name field is required",
-		}
+		})
 	}
 	if req.ProvinceId == "" {
-		invalidArgs = true
-		invalidProvinceId = &epb.BadRequest_FieldViolation{
+		violations = append(violations, &epb.BadRequest_FieldViolation{
 			Field:       "provinceId",
 			Description: "The provinceId field is required",
-		}
-	} else if req.ProvinceId != "" {
-		if !utils.IsValidUUID(&req.ProvinceId) {
-			invalidArgs = true
-			invalidProvinceId = &epb.BadRequest_FieldViolation{
-				Field:       "provinceId",
-				Description: "The provinceId field is not a valid uuid v4",
-			}
-		}
+		})
+	} else if !utils.IsValidUUID(&req.ProvinceId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is not a valid uuid v4",
+		})
 	}
-	if req.MunicipalityId == "" {
-		invalidArgs = true
-		invalidMunicipalityId = &epb.BadRequest_FieldViolation{
+	if req.MunicipalityId != "" && !utils.IsValidUUID(&req.MunicipalityId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
 			Field:       "municipalityId",
-			Description: "The municipalityId field is required",
-		}
-	} else if req.MunicipalityId != "" {
-		if !utils.IsValidUUID(&req.MunicipalityId) {
-			invalidArgs = true
-			invalidMunicipalityId = &epb.BadRequest_FieldViolation{
-				Field:       "municipalityId",
-				Description: "The municipalityId field is not a valid uuid v4",
-			}
-		}
+			Description: "The municipalityId field is not a valid uuid v4",
+		})
 	}
-	if invalidArgs {
-		st = status.New(codes.InvalidArgument, "Invalid Arguments")
-		if invalidProvinceId != nil {
-			st, _ = st.WithDetails(
-				invalidProvinceId,
-			)
+	if req.Location != nil {
+		if req.Location.Latitude < -90 || req.Location.Latitude > 90 {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       "location.latitude",
+				Description: "The location.latitude field must be between -90 and 90",
+			})
 		}
-		if invalidName != nil {
-			st, _ = st.WithDetails(
-				invalidName,
-			)
-		}
-		if invalidSearchMunicipalityType != nil {
-			st, _ = st.WithDetails(
-				invalidSearchMunicipalityType,
-			)
-		}
-		if invalidMunicipalityId != nil {
-			st, _ = st.WithDetails(
-				invalidMunicipalityId,
-			)
+		if req.Location.Longitude < -180 || req.Location.Longitude > 180 {
+			violations = append(violations, &epb.BadRequest_FieldViolation{
+				Field:       "location.longitude",
+				Description: "The location.longitude field must be between -180 and 180",
+			})
 		}
-		return nil, st.Err()
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
 	}
 	res, err := m.itemService.SearchItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -400,22 +315,53 @@ func (m *ItemServer) SearchItemByBusiness(ctx context.Context, req *pb.SearchIte
 	}
 	res, err := m.itemService.SearchItemByBusiness(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 
 }
 
+func (m *ItemServer) SuggestSearch(ctx context.Context, req *pb.SuggestSearchRequest) (*pb.SuggestSearchResponse, error) {
+	var violations []*epb.BadRequest_FieldViolation
+	md := utils.GetMetadata(ctx)
+	if req.Prefix == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "prefix",
+			Description: "The prefix field is required",
+		})
+	} else if len(req.Prefix) > maxSuggestSearchPrefix {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "prefix",
+			Description: fmt.Sprintf("The prefix field can't be longer than %d bytes", maxSuggestSearchPrefix),
+		})
+	}
+	if req.ProvinceId == "" {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is required",
+		})
+	} else if !utils.IsValidUUID(&req.ProvinceId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "provinceId",
+			Description: "The provinceId field is not a valid uuid v4",
+		})
+	}
+	if req.MunicipalityId != "" && !utils.IsValidUUID(&req.MunicipalityId) {
+		violations = append(violations, &epb.BadRequest_FieldViolation{
+			Field:       "municipalityId",
+			Description: "The municipalityId field is not a valid uuid v4",
+		})
+	}
+	if len(violations) != 0 {
+		return nil, invalidArgumentsError(violations)
+	}
+	res, err := m.itemService.SuggestSearch(ctx, req, md)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (m *ItemServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*gp.Empty, error) {
 	var invalidId *epb.BadRequest_FieldViolation
 	var invalidArgs bool
@@ -451,31 +397,7 @@ func (m *ItemServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest)
 	}
 	err := m.itemService.DeleteItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "item in the cart":
-			st = status.New(codes.InvalidArgument, "Item in the cart")
-		case "item not found":
-			st = status.New(codes.NotFound, "Item not found")
-		case "business is open":
-			st = status.New(codes.InvalidArgument, "Business is open")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return &gp.Empty{}, nil
 }
@@ -696,31 +618,7 @@ func (m *ItemServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest)
 	}
 	res, err := m.itemService.CreateItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "highQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "HighQualityPhotoObject missing")
-		case "lowQualityPhotoObject missing":
-			st = status.New(codes.InvalidArgument, "LowQualityPhotoObject missing")
-		case "thumbnailObject missing":
-			st = status.New(codes.InvalidArgument, "ThumbnailObject missing")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
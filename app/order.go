@@ -46,25 +46,7 @@ func (m *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderReques
 	}
 	res, err := m.orderService.CancelOrder(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated":
-			st = status.New(codes.Unauthenticated, "Unauthenticated")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "order not found":
-			st = status.New(codes.NotFound, "Order not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -130,27 +112,7 @@ func (m *OrderServer) GetCheckoutInfo(ctx context.Context, req *pb.GetCheckoutIn
 	}
 	res, err := m.orderService.GetCheckoutInfo(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "business not in range":
-			st = status.New(codes.InvalidArgument, "Business not in range")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "business not found":
-			st = status.New(codes.NotFound, "Business not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	if err != nil {
 		return nil, err
@@ -193,25 +155,7 @@ func (m *OrderServer) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*p
 	}
 	res, err := m.orderService.GetOrder(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated":
-			st = status.New(codes.Unauthenticated, "Unauthenticated")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "order not found":
-			st = status.New(codes.NotFound, "Order not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -225,23 +169,7 @@ func (m *OrderServer) ListOrder(ctx context.Context, req *pb.ListOrderRequest) (
 	}
 	res, err := m.orderService.ListOrder(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -330,35 +258,7 @@ func (m *OrderServer) CreateOrder(ctx context.Context, req *pb.CreateOrderReques
 	}
 	res, err := m.orderService.CreateOrder(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "not fulfilled the previous time of the business":
-			st = status.New(codes.InvalidArgument, "Not fulfilled the previous time of the business")
-		case "business closed":
-			st = status.New(codes.InvalidArgument, "Business closed")
-		case "cart items not found":
-			st = status.New(codes.InvalidArgument, "Cart items not found")
-		case "user address not found":
-			st = status.New(codes.NotFound, "User address not found")
-		case "business payment method not found":
-			st = status.New(codes.NotFound, "Business payment method not found")
-		case "business not in range":
-			st = status.New(codes.InvalidArgument, "Business not in range")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -410,27 +310,7 @@ func (m *OrderServer) UpdateOrder(ctx context.Context, req *pb.UpdateOrderReques
 	}
 	res, err := m.orderService.UpdateOrder(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "order not found":
-			st = status.New(codes.NotFound, "Order not found")
-		case "status error":
-			st = status.New(codes.InvalidArgument, "Invalid status value")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -470,23 +350,48 @@ func (m *OrderServer) ListOrderedItem(ctx context.Context, req *pb.ListOrderedIt
 	}
 	res, err := m.orderService.ListOrderedItemWithItem(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
+
+func (m *OrderServer) WatchOrder(req *pb.WatchOrderRequest, stream pb.OrderService_WatchOrderServer) error {
+	var invalidOrderId *epb.BadRequest_FieldViolation
+	var invalidArgs bool
+	var st *status.Status
+	ctx := stream.Context()
+	md := utils.GetMetadata(ctx)
+	if md.Authorization == nil {
+		st = status.New(codes.Unauthenticated, "Unauthenticated user")
+		return st.Err()
+	}
+	if req.OrderId == "" {
+		invalidArgs = true
+		invalidOrderId = &epb.BadRequest_FieldViolation{
+			Field:       "OrderId",
+			Description: "The OrderId field is required",
+		}
+	} else if req.OrderId != "" {
+		if !utils.IsValidUUID(&req.OrderId) {
+			invalidArgs = true
+			invalidOrderId = &epb.BadRequest_FieldViolation{
+				Field:       "OrderId",
+				Description: "The OrderId field is not a valid uuid v4",
+			}
+		}
+	}
+	if invalidArgs {
+		st = status.New(codes.InvalidArgument, "Invalid Arguments")
+		if invalidOrderId != nil {
+			st, _ = st.WithDetails(
+				invalidOrderId,
+			)
+		}
+		return st.Err()
+	}
+	err := m.orderService.WatchOrder(ctx, req, md, stream.Send)
+	if err != nil {
+		return err
+	}
+	return nil
+}
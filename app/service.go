@@ -50,6 +50,32 @@ type BusinessServer struct {
 	businessService usecase.BusinessService
 }
 
+type AnalyticsServer struct {
+	pb.UnimplementedAnalyticsServiceServer
+	analyticsService usecase.AnalyticsService
+}
+
+func NewAnalyticsServer(
+	analyticsService usecase.AnalyticsService,
+) *AnalyticsServer {
+	return &AnalyticsServer{
+		analyticsService: analyticsService,
+	}
+}
+
+type BanServer struct {
+	pb.UnimplementedBanServiceServer
+	banService usecase.BanService
+}
+
+func NewBanServer(
+	banService usecase.BanService,
+) *BanServer {
+	return &BanServer{
+		banService: banService,
+	}
+}
+
 type PermissionServer struct {
 	pb.UnimplementedPermissionServiceServer
 	permissionService usecase.PermissionService
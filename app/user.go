@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/notification"
 	"github.com/daniarmas/api_go/utils"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -20,7 +21,7 @@ func (m *UserServer) UpdateUserConfiguration(ctx context.Context, req *pb.Update
 		st = status.New(codes.Unauthenticated, "Unauthenticated user")
 		return nil, st.Err()
 	}
-	var invalidUserId *epb.BadRequest_FieldViolation
+	var invalidUserId, invalidLanguage *epb.BadRequest_FieldViolation
 	var invalidArgs bool
 	if req.UserId == "" {
 		invalidArgs = true
@@ -37,6 +38,13 @@ func (m *UserServer) UpdateUserConfiguration(ctx context.Context, req *pb.Update
 			}
 		}
 	}
+	if req.UserConfiguration != nil && req.UserConfiguration.Language != "" && !notification.SupportedLocale(req.UserConfiguration.Language) {
+		invalidArgs = true
+		invalidLanguage = &epb.BadRequest_FieldViolation{
+			Field:       "userConfiguration.language",
+			Description: "The userConfiguration.language field is not a supported language",
+		}
+	}
 	if invalidArgs {
 		st = status.New(codes.InvalidArgument, "Invalid Arguments")
 		if invalidUserId != nil {
@@ -44,29 +52,16 @@ func (m *UserServer) UpdateUserConfiguration(ctx context.Context, req *pb.Update
 				invalidUserId,
 			)
 		}
+		if invalidLanguage != nil {
+			st, _ = st.WithDetails(
+				invalidLanguage,
+			)
+		}
 		return nil, st.Err()
 	}
 	res, err := m.userService.UpdateUserConfiguration(ctx, req, meta)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "user configuration not found":
-			st = status.New(codes.NotFound, "User address not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -123,21 +118,7 @@ func (m *UserServer) GetAddressInfo(ctx context.Context, req *pb.GetAddressInfoR
 	}
 	res, err := m.userService.GetAddressInfo(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "municipality not found":
-			st = status.New(codes.InvalidArgument, "Location not available")
-		case "province not found":
-			st = status.New(codes.InvalidArgument, "Location not available")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -177,25 +158,7 @@ func (m *UserServer) GetUserAddress(ctx context.Context, req *pb.GetUserAddressR
 	}
 	res, err := m.userService.GetUserAddress(ctx, req, meta)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "user address not found":
-			st = status.New(codes.Unauthenticated, "User address not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -209,23 +172,7 @@ func (m *UserServer) GetUser(ctx context.Context, req *gp.Empty) (*pb.User, erro
 	}
 	res, err := m.userService.GetUser(ctx, meta)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -239,23 +186,7 @@ func (m *UserServer) ListUserAddress(ctx context.Context, req *gp.Empty) (*pb.Li
 	}
 	res, err := m.userService.ListUserAddress(ctx, req, meta)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -295,25 +226,7 @@ func (m *UserServer) DeleteUserAddress(ctx context.Context, req *pb.DeleteUserAd
 	}
 	res, err := m.userService.DeleteUserAddress(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "user address not found":
-			st = status.New(codes.NotFound, "User address not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -395,27 +308,7 @@ func (m *UserServer) CreateUserAddress(ctx context.Context, req *pb.CreateUserAd
 	}
 	res, err := m.userService.CreateUserAddress(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "only can have 10 user_address":
-			st = status.New(codes.ResourceExhausted, "UserAddress limit reached")
-		case "municipality not found":
-			st = status.New(codes.NotFound, "Municipality not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -483,27 +376,7 @@ func (m *UserServer) UpdateUserAddress(ctx context.Context, req *pb.UpdateUserAd
 	}
 	res, err := m.userService.UpdateUserAddress(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "user address not found":
-			st = status.New(codes.NotFound, "User address not found")
-		case "only can have 10 user_address":
-			st = status.New(codes.ResourceExhausted, "User address limit reached")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -566,38 +439,7 @@ func (m *UserServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	}
 	res, err := m.userService.UpdateUser(ctx, req, md)
 	if err != nil {
-		switch err.Error() {
-		case "unauthenticated application":
-			st = status.New(codes.Unauthenticated, "Unauthenticated application")
-		case "access token contains an invalid number of segments", "access token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Access token is invalid")
-		case "access token expired":
-			st = status.New(codes.Unauthenticated, "Access token is expired")
-		case "unauthenticated user":
-			st = status.New(codes.Unauthenticated, "Unauthenticated user")
-		case "authorization token expired":
-			st = status.New(codes.Unauthenticated, "Authorization token expired")
-		case "authorization token contains an invalid number of segments", "authorization token signature is invalid":
-			st = status.New(codes.Unauthenticated, "Authorization token invalid")
-		case "missing code":
-			invalidCode := &epb.BadRequest_FieldViolation{
-				Field:       "Code",
-				Description: "The code field is required for update the email",
-			}
-			st = status.New(codes.InvalidArgument, "Invalid arguments")
-			st, _ = st.WithDetails(
-				invalidCode,
-			)
-		case "user already exist":
-			st = status.New(codes.AlreadyExists, "User already exists")
-		case "permission denied":
-			st = status.New(codes.PermissionDenied, "Permission denied")
-		case "verification code not found":
-			st = status.New(codes.NotFound, "Verification code not found")
-		default:
-			st = status.New(codes.Internal, "Internal server error")
-		}
-		return nil, st.Err()
+		return nil, err
 	}
 	return res, nil
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -10,17 +11,26 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/daniarmas/api_go/config"
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/internal/usecase"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/geojson"
 	"gorm.io/gorm"
 )
 
 const (
 	unknownCommand     = "unknown command\nRun './main help' for usage."
 	commandHelpUnknown = `unknown help topic. Run './main help'.`
-	migrateHelp        = `usage: ./main migrate`
-	createAppHelp      = `usage: ./main createapp`
+	migrateHelp        = `usage: ./main migrate [-timezone name]`
+	createAppHelp      = `usage: ./main createapp [app create flags], an alias of ./main app create`
+	appHelp            = "usage: ./main app create [-name name] [-version version] [-description text] [-expiration duration] [-scopes service,...] [-max-sessions n]\n       ./main app list\n       ./main app rotate -id <app id> [-overlap duration]\n       ./main app revoke -id <app id> | -credential <credential id>\n       ./main app import -id <app id> -token <access token> [-expiration duration]"
+	expireOrdersHelp   = `usage: ./main expireorders`
+	suggestionsHelp    = `usage: ./main suggestions rebuild`
+	deliveryZonesHelp  = "usage: ./main deliveryzones import -file <zones.geojson>\n\nThe file is a GeoJSON FeatureCollection of Polygon or MultiPolygon features,\nthe businessId property or the id of a feature is its business."
+	outboxHelp         = "usage: ./main outbox list [-status pending|delivered|dead] [-limit n]\n       ./main outbox replay -id <outbox id> | -dead\n\nreplay queues again the dead messages and the pending ones that failed, the\ndelivered messages are never sent again."
 	help               = `This command-line tool is for administrative tasks
 
 Usage:
@@ -29,29 +39,51 @@ Usage:
 
 The commands are:
 
+	app               create, list, rotate, revoke and import the apps that consume the api
 	createapp         create app for consume the api
+	deliveryzones     import the delivery zones of the businesses
+	expireorders      expire the orders whose order window has passed
+	migrate           add the new tables and columns, convert the business schedules and set up the search
+	outbox            list and replay the outbox messages
+	suggestions       rebuild the search suggestions index
 
 Use "./main help <command>" for more information about a command.
 `
-	createApp = "createapp"
+	createApp    = "createapp"
+	appCommand   = "app"
+	expireOrders = "expireorders"
+	outbox       = "outbox"
+	migrate      = "migrate"
+	suggestions  = "suggestions"
 )
 
 func HandleCli(args []string, db *gorm.DB, config *config.Config, repositoryDao repository.Repository) {
 	if len(args) != 1 {
-		createAppCmd := flag.NewFlagSet("createapp", flag.ExitOnError)
 		flag.NewFlagSet("--config", flag.ExitOnError)
 		helpCmd := flag.NewFlagSet("help", flag.ExitOnError)
 		getHelpCommand := helpCmd.String("command", "", "Command")
 		switch args[1] {
 		case "help":
 			if len(args) == 2 {
-				fmt.Println(help)
+				fmt.Print(help)
 				os.Exit(0)
 			} else {
 				handleHelp(helpCmd, getHelpCommand)
 			}
 		case "createapp":
-			handleCreateApp(createAppCmd, repositoryDao, db)
+			handleApp(append([]string{"create"}, args[2:]...), repositoryDao, db)
+		case "app":
+			handleApp(args[2:], repositoryDao, db)
+		case "expireorders":
+			handleExpireOrders(repositoryDao, db, config)
+		case "outbox":
+			handleOutbox(args[2:], repositoryDao, db)
+		case "migrate":
+			handleMigrate(args[2:], db, config)
+		case "suggestions":
+			handleSuggestions(args[2:], repositoryDao, db)
+		case "deliveryzones":
+			handleDeliveryZones(args[2:], repositoryDao, db, config)
 		case "--config":
 		default:
 			fmt.Println(unknownCommand)
@@ -60,27 +92,249 @@ func HandleCli(args []string, db *gorm.DB, config *config.Config, repositoryDao
 	}
 }
 
-func handleCreateApp(getCreateAdmin *flag.FlagSet, repositoryDao repository.Repository, db *gorm.DB) {
-	err := db.Transaction(func(tx *gorm.DB) error {
-		expTime := time.Now().UTC().AddDate(0, 0, 1)
-		ctx := context.Background()
-		createAppRes, createAppErr := repositoryDao.NewApplicationRepository().CreateApplication(ctx, tx, &entity.Application{Name: "Initial", Version: "1.0.0", ExpirationTime: expTime})
-		if createAppErr != nil {
-			log.Error(createAppErr)
+func handleApp(args []string, repositoryDao repository.Repository, db *gorm.DB) {
+	if len(args) == 0 {
+		fmt.Println(appHelp)
+		os.Exit(0)
+	}
+	ctx := context.Background()
+	applicationService := usecase.NewApplicationService(repositoryDao, &sqldb.Sql{Gorm: db})
+	switch args[0] {
+	case "create":
+		createCmd := flag.NewFlagSet("app create", flag.ExitOnError)
+		name := createCmd.String("name", "Initial", "Name of the app")
+		version := createCmd.String("version", "1.0.0", "Version of the app")
+		description := createCmd.String("description", "", "Description of the app")
+		expiration := createCmd.Duration("expiration", 365*24*time.Hour, "Time until the app expires")
+		scopes := createCmd.String("scopes", "", "Comma separated services or methods the app may call, every method when empty")
+		maxSessions := createCmd.Int("max-sessions", 1, "Maximum of concurrent sessions of a user")
+		createCmd.Parse(args[1:])
+		app, accessToken, err := applicationService.RegisterApplication(ctx, &entity.Application{Name: *name, Version: *version, Description: *description, ExpirationTime: time.Now().UTC().Add(*expiration), Scopes: *scopes, MaxSessions: int32(*maxSessions)})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Info("Application: ", app.ID.String())
+		log.Info("AccessToken: ", accessToken)
+	case "list":
+		cursor := time.Now().UTC()
+		for {
+			apps, err := repositoryDao.NewApplicationRepository().ListApplication(ctx, db, &entity.Application{}, &cursor)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for _, item := range *apps {
+				credentials, err := repositoryDao.NewApplicationCredentialRepository().ListApplicationCredential(ctx, db, &entity.ApplicationCredential{ApplicationId: item.ID})
+				if err != nil {
+					log.Fatal(err)
+				}
+				var active int
+				for _, credential := range *credentials {
+					if credential.Active(time.Now().UTC()) {
+						active++
+					}
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\t%d active credentials\n", item.ID.String(), item.Name, item.Version, item.ExpirationTime.Format(time.RFC3339), item.Scopes, active)
+				cursor = item.CreateTime
+			}
+			if len(*apps) < 11 {
+				break
+			}
+		}
+	case "rotate":
+		rotateCmd := flag.NewFlagSet("app rotate", flag.ExitOnError)
+		id := rotateCmd.String("id", "", "Id of the app")
+		overlap := rotateCmd.Duration("overlap", 24*time.Hour, "Time the current access tokens keep working")
+		rotateCmd.Parse(args[1:])
+		appId, err := uuid.Parse(*id)
+		if err != nil {
+			fmt.Println(appHelp)
+			os.Exit(0)
+		}
+		accessToken, err := applicationService.RotateApplicationCredential(ctx, appId, *overlap)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Info("AccessToken: ", accessToken)
+	case "revoke":
+		revokeCmd := flag.NewFlagSet("app revoke", flag.ExitOnError)
+		id := revokeCmd.String("id", "", "Id of the app whose credentials are revoked")
+		credential := revokeCmd.String("credential", "", "Id of the credential to revoke")
+		revokeCmd.Parse(args[1:])
+		var where entity.ApplicationCredential
+		if *id != "" {
+			appId, err := uuid.Parse(*id)
+			if err != nil {
+				log.Fatal(err)
+			}
+			where.ApplicationId = &appId
+		} else if *credential != "" {
+			credentialId, err := uuid.Parse(*credential)
+			if err != nil {
+				log.Fatal(err)
+			}
+			where.ID = &credentialId
+		} else {
+			fmt.Println(appHelp)
 			os.Exit(0)
 		}
-		jwtAcessToken := datasource.JsonWebTokenMetadata{TokenId: createAppRes.ID}
-		jwtAcessTokenErr := repository.Datasource.NewJwtTokenDatasource().CreateJwtAuthorizationToken(&jwtAcessToken)
-		if jwtAcessTokenErr != nil {
-			log.Error(jwtAcessTokenErr)
+		revoked, err := applicationService.RevokeApplicationCredential(ctx, &where)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Info("Revoked credentials: ", revoked)
+	case "import":
+		importCmd := flag.NewFlagSet("app import", flag.ExitOnError)
+		id := importCmd.String("id", "", "Id of the app")
+		token := importCmd.String("token", "", "Access token issued before the credentials")
+		expiration := importCmd.Duration("expiration", 0, "Time until the access token expires, never when zero")
+		importCmd.Parse(args[1:])
+		appId, err := uuid.Parse(*id)
+		if err != nil || *token == "" {
+			fmt.Println(appHelp)
 			os.Exit(0)
 		}
-		log.Info("AccessToken: ", *jwtAcessToken.Token)
-		return nil
-	})
+		var expirationTime *time.Time
+		if *expiration > 0 {
+			value := time.Now().UTC().Add(*expiration)
+			expirationTime = &value
+		}
+		credentialId, err := applicationService.ImportApplicationCredential(ctx, appId, *token, expirationTime)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Info("Credential: ", credentialId.String())
+	default:
+		fmt.Println(appHelp)
+	}
+	os.Exit(0)
+}
+
+func handleExpireOrders(repositoryDao repository.Repository, db *gorm.DB, config *config.Config) {
+	orderService := usecase.NewOrderService(repositoryDao, &sqldb.Sql{Gorm: db}, config)
+	expired, err := orderService.ExpireOrders(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
+	log.Info("Expired orders: ", expired)
+	os.Exit(0)
+}
+
+func handleSuggestions(args []string, repositoryDao repository.Repository, db *gorm.DB) {
+	if len(args) == 0 || args[0] != "rebuild" {
+		fmt.Println(suggestionsHelp)
+		os.Exit(0)
+	}
+	indexed, err := repositoryDao.NewSearchSuggestionRepository().RebuildSearchSuggestion(context.Background(), db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("%d search suggestions indexed", indexed)
+	os.Exit(0)
+}
+
+// handleDeliveryZones imports every zone of the file in its own transaction,
+// the invalid ones are logged and skipped.
+func handleDeliveryZones(args []string, repositoryDao repository.Repository, db *gorm.DB, config *config.Config) {
+	if len(args) == 0 || args[0] != "import" {
+		fmt.Println(deliveryZonesHelp)
+		os.Exit(0)
+	}
+	importCmd := flag.NewFlagSet("deliveryzones import", flag.ExitOnError)
+	file := importCmd.String("file", "", "GeoJSON FeatureCollection of the zones")
+	importCmd.Parse(args[1:])
+	if *file == "" {
+		fmt.Println(deliveryZonesHelp)
+		os.Exit(0)
+	}
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var features geojson.FeatureCollection
+	err = json.Unmarshal(data, &features)
+	if err != nil {
+		log.Fatal(err)
+	}
+	stDb, err := db.DB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	businessService := usecase.NewBusinessService(repositoryDao, config, stDb, &sqldb.Sql{Gorm: db})
+	var imported int
+	for index, feature := range features.Features {
+		id := feature.ID
+		if businessId, ok := feature.Properties["businessId"].(string); ok {
+			id = businessId
+		}
+		businessId, err := uuid.Parse(id)
+		if err != nil {
+			log.Errorf("feature %d: invalid business id %q", index, id)
+			continue
+		}
+		_, err = businessService.ImportBusinessDeliveryZone(context.Background(), businessId, feature.Geometry)
+		if err != nil {
+			log.Errorf("feature %d, business %s: %v", index, businessId, err)
+			continue
+		}
+		imported++
+	}
+	log.Infof("%d of %d delivery zones imported", imported, len(features.Features))
+	if imported != len(features.Features) {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func handleOutbox(args []string, repositoryDao repository.Repository, db *gorm.DB) {
+	if len(args) == 0 {
+		fmt.Println(outboxHelp)
+		os.Exit(0)
+	}
+	switch args[0] {
+	case "list":
+		listCmd := flag.NewFlagSet("outbox list", flag.ExitOnError)
+		status := listCmd.String("status", "", "Only list the messages with this status")
+		limit := listCmd.Int("limit", 20, "Maximum number of messages")
+		listCmd.Parse(args[1:])
+		outboxRes, err := repositoryDao.NewOutboxRepository().ListOutbox(db, &entity.Outbox{Status: *status}, *limit)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, item := range *outboxRes {
+			fmt.Printf("%s\t%s\t%s\t%d\t%s\t%s\n", item.ID.String(), item.Channel, item.Status, item.Attempts, item.NextAttemptTime.Format(time.RFC3339), item.LastError)
+		}
+	case "replay":
+		replayCmd := flag.NewFlagSet("outbox replay", flag.ExitOnError)
+		id := replayCmd.String("id", "", "Id of the message to replay")
+		dead := replayCmd.Bool("dead", false, "Replay every dead message")
+		replayCmd.Parse(args[1:])
+		var where entity.Outbox
+		if *id != "" {
+			outboxId, err := uuid.Parse(*id)
+			if err != nil {
+				log.Fatal(err)
+			}
+			message, err := repositoryDao.NewOutboxRepository().GetOutbox(db, &entity.Outbox{ID: &outboxId})
+			if err != nil && !apperror.IsNotFound(err) {
+				log.Fatal(err)
+			} else if message != nil && message.Status == entity.OutboxStatusDelivered {
+				log.Fatalf("the outbox message %s was already delivered, it isn't sent again", outboxId)
+			}
+			where.ID = &outboxId
+		} else if *dead {
+			where.Status = entity.OutboxStatusDead
+		} else {
+			fmt.Println(outboxHelp)
+			os.Exit(0)
+		}
+		replayed, err := repositoryDao.NewOutboxRepository().ReplayOutbox(db, &where, time.Now().UTC())
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Infof("%d outbox messages replayed", replayed)
+	default:
+		fmt.Println(outboxHelp)
+	}
 	os.Exit(0)
 }
 
@@ -90,6 +344,24 @@ func handleHelp(getHelp *flag.FlagSet, command *string) {
 	case "createapp":
 		fmt.Println(createAppHelp)
 		os.Exit(0)
+	case "app":
+		fmt.Println(appHelp)
+		os.Exit(0)
+	case "expireorders":
+		fmt.Println(expireOrdersHelp)
+		os.Exit(0)
+	case "outbox":
+		fmt.Println(outboxHelp)
+		os.Exit(0)
+	case "migrate":
+		fmt.Println(migrateHelp)
+		os.Exit(0)
+	case "suggestions":
+		fmt.Println(suggestionsHelp)
+		os.Exit(0)
+	case "deliveryzones":
+		fmt.Println(deliveryZonesHelp)
+		os.Exit(0)
 	default:
 		fmt.Println(commandHelpUnknown)
 		os.Exit(0)
@@ -0,0 +1,299 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// legacyScheduleDay holds the columns of a weekday of the former schedule
+// model, two fixed intervals by weekday.
+type legacyScheduleDay struct {
+	BusinessScheduleId *uuid.UUID
+	FirstOpeningTime   *time.Time
+	FirstClosingTime   *time.Time
+	SecondOpeningTime  *time.Time
+	SecondClosingTime  *time.Time
+}
+
+// newTables are the tables of the entities added after the schema was
+// created, AutoMigrate creates the missing ones.
+var newTables = []interface{}{
+	&entity.Outbox{},
+	&entity.ApplicationCredential{},
+	&entity.BannedDevice{},
+	&entity.BannedUser{},
+	&entity.AnalyticsHourly{},
+	&entity.AnalyticsDaily{},
+}
+
+func handleMigrate(args []string, db *gorm.DB, config *config.Config) {
+	migrateCmd := flag.NewFlagSet("migrate", flag.ExitOnError)
+	timezone := migrateCmd.String("timezone", "America/Havana", "Timezone of the existing schedules")
+	migrateCmd.Parse(args)
+	_, err := time.LoadLocation(*timezone)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var migrated int
+	err = db.Transaction(func(tx *gorm.DB) error {
+		migrated, err = migrateBusinessSchedules(tx, *timezone)
+		if err != nil {
+			return err
+		}
+		err = tx.AutoMigrate(newTables...)
+		if err != nil {
+			return err
+		}
+		err = migrateCartItemExpiration(tx, config)
+		if err != nil {
+			return err
+		}
+		err = migrateVerificationCodeAttempts(tx, config)
+		if err != nil {
+			return err
+		}
+		for _, statements := range [][]string{refreshTokenFamilyStatements, sessionStatements, itemSearchStatements, businessFeedStatements, businessDeliveryZoneStatements, businessOrderStatements} {
+			err = execStatements(tx, statements)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("%d business schedules migrated", migrated)
+}
+
+// migrateCartItemExpiration adds the expiration of the cart items, the
+// existing ones expire the reservation ttl of their business after they were
+// added.
+func migrateCartItemExpiration(tx *gorm.DB, config *config.Config) error {
+	err := tx.Exec(`ALTER TABLE "cart_item" ADD COLUMN IF NOT EXISTS "expiration_time" timestamptz`).Error
+	if err != nil {
+		return err
+	}
+	var businessIds []uuid.UUID
+	err = tx.Table(entity.CartItemTableName).Where(`"expiration_time" IS NULL`).Distinct("business_id").Pluck("business_id", &businessIds).Error
+	if err != nil {
+		return err
+	}
+	for _, businessId := range businessIds {
+		ttl, err := config.CartItemReservationTtlFor(businessId.String())
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(`UPDATE "cart_item" SET "expiration_time" = "create_time" + make_interval(secs => ?) WHERE "business_id" = ? AND "expiration_time" IS NULL`, ttl.Seconds(), businessId).Error
+		if err != nil {
+			return err
+		}
+	}
+	err = tx.Exec(`ALTER TABLE "cart_item" ALTER COLUMN "expiration_time" SET NOT NULL`).Error
+	if err != nil {
+		return err
+	}
+	return tx.Exec(`CREATE INDEX IF NOT EXISTS "cart_item_expiration_time_idx" ON "cart_item" ("expiration_time")`).Error
+}
+
+// migrateVerificationCodeAttempts adds the attempts and the expiration of the
+// verification codes, the existing ones expire VERIFICATION_CODE_TTL after
+// they were sent.
+func migrateVerificationCodeAttempts(tx *gorm.DB, config *config.Config) error {
+	ttl, err := time.ParseDuration(config.VerificationCodeTtl)
+	if err != nil {
+		return err
+	}
+	err = tx.Exec(`ALTER TABLE "verification_code" ADD COLUMN IF NOT EXISTS "attempts" integer NOT NULL DEFAULT 0`).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Exec(`ALTER TABLE "verification_code" ADD COLUMN IF NOT EXISTS "expiration_time" timestamptz`).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Exec(`UPDATE "verification_code" SET "expiration_time" = "create_time" + make_interval(secs => ?) WHERE "expiration_time" IS NULL`, ttl.Seconds()).Error
+	if err != nil {
+		return err
+	}
+	return tx.Exec(`ALTER TABLE "verification_code" ALTER COLUMN "expiration_time" SET NOT NULL`).Error
+}
+
+// migrateBusinessSchedules creates the tables of the schedule intervals and
+// exceptions and converts the weekday columns of the schedules without
+// intervals. The weekday columns are kept, they can be dropped once the
+// schedules are checked.
+func migrateBusinessSchedules(tx *gorm.DB, timezone string) (int, error) {
+	err := tx.AutoMigrate(&entity.BusinessScheduleInterval{}, &entity.BusinessScheduleException{})
+	if err != nil {
+		return 0, err
+	}
+	err = tx.Exec(`ALTER TABLE "business_schedule" ADD COLUMN IF NOT EXISTS "timezone" text NOT NULL DEFAULT ''`).Error
+	if err != nil {
+		return 0, err
+	}
+	err = tx.Exec(`UPDATE "business_schedule" SET "timezone" = ? WHERE "timezone" = ''`, timezone).Error
+	if err != nil {
+		return 0, err
+	}
+	var done []uuid.UUID
+	err = tx.Model(&entity.BusinessScheduleInterval{}).Distinct("business_schedule_id").Pluck("business_schedule_id", &done).Error
+	if err != nil {
+		return 0, err
+	}
+	skip := make(map[uuid.UUID]bool, len(done))
+	for _, id := range done {
+		skip[id] = true
+	}
+	migrated := make(map[uuid.UUID]bool)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		day := strings.ToLower(weekday.String())
+		var rows []legacyScheduleDay
+		err = tx.Table(entity.BusinessScheduleTableName).Select(fmt.Sprintf(`"id" AS "business_schedule_id", "first_opening_time_%[1]s" AS "first_opening_time", "first_closing_time_%[1]s" AS "first_closing_time", "second_opening_time_%[1]s" AS "second_opening_time", "second_closing_time_%[1]s" AS "second_closing_time"`, day)).Where(`"delete_time" IS NULL`).Scan(&rows).Error
+		if err != nil {
+			return 0, err
+		}
+		var intervals []entity.BusinessScheduleInterval
+		for _, row := range rows {
+			if skip[*row.BusinessScheduleId] {
+				continue
+			}
+			migrated[*row.BusinessScheduleId] = true
+			for _, times := range [][2]*time.Time{{row.FirstOpeningTime, row.FirstClosingTime}, {row.SecondOpeningTime, row.SecondClosingTime}} {
+				opening, ok := legacyScheduleMinute(times[0])
+				if !ok {
+					continue
+				}
+				closing, ok := legacyScheduleMinute(times[1])
+				if !ok || opening == closing {
+					continue
+				}
+				intervals = append(intervals, entity.BusinessScheduleInterval{BusinessScheduleId: row.BusinessScheduleId, Weekday: weekday, OpeningMinute: opening, ClosingMinute: closing})
+			}
+		}
+		if len(intervals) == 0 {
+			continue
+		}
+		err = tx.Create(&intervals).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(migrated), nil
+}
+
+// refreshTokenFamilyStatements add the chain of refreshes of the refresh
+// tokens, every existing token starts its own family. They can run again.
+var refreshTokenFamilyStatements = []string{
+	`ALTER TABLE "refresh_token" ADD COLUMN IF NOT EXISTS "family_id" uuid`,
+	`ALTER TABLE "refresh_token" ADD COLUMN IF NOT EXISTS "parent_id" uuid`,
+	`ALTER TABLE "refresh_token" ADD COLUMN IF NOT EXISTS "used_time" timestamptz`,
+	`UPDATE "refresh_token" SET "family_id" = "id" WHERE "family_id" IS NULL`,
+	`CREATE INDEX IF NOT EXISTS "idx_refresh_token_family_id" ON "refresh_token" ("family_id")`,
+}
+
+// sessionStatements add the session limit of the applications and the last
+// activity of the sessions, the existing sessions were last seen when they
+// were created. They can run again.
+var sessionStatements = []string{
+	`ALTER TABLE "application" ADD COLUMN IF NOT EXISTS "max_sessions" integer NOT NULL DEFAULT 1`,
+	`ALTER TABLE "authorization_token" ADD COLUMN IF NOT EXISTS "last_seen_time" timestamptz`,
+	`ALTER TABLE "authorization_token" ADD COLUMN IF NOT EXISTS "last_seen_ip" text NOT NULL DEFAULT ''`,
+	`UPDATE "authorization_token" SET "last_seen_time" = "create_time" WHERE "last_seen_time" IS NULL`,
+}
+
+// itemSearchStatements create the text search configuration, the functions
+// and the indexes of the item and business search and of the map. They can
+// run again.
+var itemSearchStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS unaccent`,
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	// unaccent isn't immutable because its dictionary may change, the
+	// indexes need a function that is.
+	`CREATE OR REPLACE FUNCTION item_search_unaccent(text) RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$ SELECT public.unaccent('public.unaccent'::regdictionary, $1) $$`,
+	`DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'item_search') THEN
+			CREATE TEXT SEARCH CONFIGURATION item_search (COPY = simple);
+			ALTER TEXT SEARCH CONFIGURATION item_search ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+		END IF;
+	END $$`,
+	`CREATE INDEX IF NOT EXISTS item_search_document_idx ON item USING gin (to_tsvector('item_search', name || ' ' || coalesce(description, '')))`,
+	`CREATE INDEX IF NOT EXISTS item_search_name_trgm_idx ON item USING gin (item_search_unaccent(lower(name)) gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS business_search_document_idx ON business USING gin (to_tsvector('item_search', name))`,
+	`CREATE INDEX IF NOT EXISTS business_search_name_trgm_idx ON business USING gin (item_search_unaccent(lower(name)) gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS business_coordinates_idx ON business USING gist (coordinates)`,
+}
+
+// businessFeedStatements create the functions the feed orders by. They can
+// run again.
+var businessFeedStatements = []string{
+	// business_open_at is BusinessSchedule.IsOpenAt in SQL: the spans of the
+	// local date and of the day before, which may close after midnight, from
+	// the exceptions of the date or else from the intervals of its weekday.
+	`CREATE OR REPLACE FUNCTION business_open_at(business_id uuid, at timestamptz) RETURNS boolean LANGUAGE sql STABLE PARALLEL SAFE AS $$
+		WITH day AS (
+			SELECT business_schedule.id, (at AT TIME ZONE business_schedule.timezone) AS local_time, ((at AT TIME ZONE business_schedule.timezone)::date - offsets.days) AS date
+			FROM business_schedule, (VALUES (0), (1)) AS offsets(days)
+			WHERE business_schedule.business_id = $1 AND business_schedule.delete_time IS NULL
+		), span AS (
+			SELECT day.local_time, day.date, exception.opening_minute, exception.closing_minute
+			FROM day JOIN business_schedule_exception exception ON exception.business_schedule_id = day.id AND exception.date = day.date AND exception.delete_time IS NULL
+			WHERE NOT EXISTS (SELECT 1 FROM business_schedule_exception closed WHERE closed.business_schedule_id = day.id AND closed.date = day.date AND closed.closed AND closed.delete_time IS NULL)
+			UNION ALL
+			SELECT day.local_time, day.date, schedule_interval.opening_minute, schedule_interval.closing_minute
+			FROM day JOIN business_schedule_interval schedule_interval ON schedule_interval.business_schedule_id = day.id AND schedule_interval.weekday = extract(dow FROM day.date) AND schedule_interval.delete_time IS NULL
+			WHERE NOT EXISTS (SELECT 1 FROM business_schedule_exception exception WHERE exception.business_schedule_id = day.id AND exception.date = day.date AND exception.delete_time IS NULL)
+		)
+		SELECT EXISTS (
+			SELECT 1 FROM span
+			WHERE span.local_time >= span.date + make_interval(mins => span.opening_minute)
+			AND span.local_time < span.date + make_interval(days => CASE WHEN span.closing_minute <= span.opening_minute THEN 1 ELSE 0 END, mins => span.closing_minute)
+		)
+	$$`,
+	`CREATE INDEX IF NOT EXISTS order_business_create_time_idx ON "order" (business_id, create_time)`,
+}
+
+// businessDeliveryZoneStatements turn the delivery zones into multipolygons
+// and index them. They can run again.
+var businessDeliveryZoneStatements = []string{
+	`DO $$ BEGIN
+		IF (SELECT type FROM geometry_columns WHERE f_table_name = 'business' AND f_geometry_column = 'polygon') IS DISTINCT FROM 'MULTIPOLYGON' THEN
+			ALTER TABLE business ALTER COLUMN polygon TYPE geometry(MultiPolygon, 4326) USING ST_SetSRID(ST_Multi(polygon::geometry), 4326);
+		END IF;
+	END $$`,
+	`CREATE INDEX IF NOT EXISTS business_polygon_idx ON business USING gist (polygon)`,
+}
+
+// businessOrderStatements index the pages of the orders of a business. They
+// can run again.
+var businessOrderStatements = []string{
+	`CREATE INDEX IF NOT EXISTS order_business_create_time_id_idx ON "order" (business_id, create_time DESC, id DESC)`,
+}
+
+func execStatements(tx *gorm.DB, statements []string) error {
+	for _, statement := range statements {
+		err := tx.Exec(statement).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyScheduleMinute returns the minutes since midnight of a time of the
+// former model, whose unset times are null or zero.
+func legacyScheduleMinute(t *time.Time) (int32, bool) {
+	if t == nil || t.IsZero() {
+		return 0, false
+	}
+	hour, minute, _ := t.Clock()
+	return int32(hour*60 + minute), true
+}
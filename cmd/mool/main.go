@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	// "net/http"
+	"net/http"
 	"os"
 	"time"
 
@@ -16,12 +16,18 @@ import (
 	"github.com/daniarmas/api_go/internal/repository"
 	"github.com/daniarmas/api_go/internal/usecase"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/keyring"
+	"github.com/daniarmas/api_go/pkg/notification"
+	"github.com/daniarmas/api_go/pkg/ratelimit"
 	"github.com/daniarmas/api_go/pkg/rdb"
 	"github.com/daniarmas/api_go/pkg/s3"
+	"github.com/daniarmas/api_go/pkg/scheduler"
 	"github.com/daniarmas/api_go/pkg/sqldb"
+	interceptors "github.com/daniarmas/api_go/serverinterceptor"
 	"github.com/daniarmas/api_go/tlscert"
 	"github.com/daniarmas/api_go/utils"
 	"github.com/getsentry/sentry-go"
+	"github.com/go-redis/redis/v9"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	// "github.com/rs/cors"
@@ -121,7 +127,7 @@ func main() {
 	// 		log.Fatalf("Error connecting to minio: %v", err)
 	// 	}
 	// 	// Datasource
-	// 	datasource := datasource.New(sqlDb.Gorm, cfg, s3)
+	// 	datasource := datasource.New(sqlDb.Gorm, cfg, s3, keyring)
 	// 	// Repository
 	// 	repository := repository.New(sqlDb.Gorm, cfg, datasource, rdb)
 	// 	itemService := usecase.NewItemService(repository, cfg, stDb, sqlDb)
@@ -174,11 +180,55 @@ func main() {
 	if err != nil {
 		log.Fatal("cannot load config:", err)
 	}
+	// Redis
+	rdb := rdb.New(cfg)
+	// Standard Library Database Connection
+	stDb, err := sql.Open("pgx",
+		cfg.DBDsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Database GORM
+	sqlDb, err := sqldb.New(cfg)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+		return
+	}
+	// ObjectStorageServer
+	s3, err := s3.New(cfg)
+	if err != nil {
+		log.Fatalf("Error connecting to minio: %v", err)
+	}
+	// Json web token signing keys
+	keyring, err := keyring.New(cfg)
+	if err != nil {
+		log.Fatalf("Error loading the jwt keys: %v", err)
+	}
+	// Datasource
+	datasource := datasource.New(sqlDb.Gorm, cfg, s3, keyring)
+	// Repository
+	repository := repository.New(sqlDb.Gorm, cfg, datasource, rdb)
+	// Handle the cli
+	cli.HandleCli(os.Args, sqlDb.Gorm, cfg, repository)
+	// The caller and the rate limits of every request
+	authenticator := usecase.NewAuthenticator(repository, sqlDb, app.AuthPolicies)
+	limiter, err := ratelimit.New(cfg, rdb)
+	if err != nil {
+		log.Fatalf("invalid RATE_LIMIT_POLICIES: %v", err)
+	}
+	rateLimiter := usecase.NewRateLimiter(limiter)
+	trustedProxies, err := interceptors.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
+	// Background jobs, they are added by serviceRegister and stopped before
+	// the connections they use are closed.
+	jobs := scheduler.New(rdb)
 	// Starting gRPC server
 	//if we crash the go code, we get the file name and line number
 	// log.SetFlags(log.LstdFlags | log.Lshortfile)
 	builder := utils.GrpcServerBuilder{}
-	addInterceptors(&builder)
+	addInterceptors(&builder, authenticator, rateLimiter, trustedProxies)
 	if cfg.Environment == "development" {
 		builder.EnableReflection(true)
 	}
@@ -186,28 +236,54 @@ func main() {
 		builder.SetTlsCert(&tlscert.Cert)
 	}
 	s := builder.Build()
-	s.RegisterService(serviceRegister)
+	s.RegisterService(func(sv *grpc.Server) {
+		serviceRegister(sv, cfg, rdb, stDb, sqlDb, repository, jobs)
+	})
 	grpcServerAddress := fmt.Sprintf("0.0.0.0:%s", cfg.ApiPort)
 	startErr := s.Start(grpcServerAddress)
 	if startErr != nil {
 		log.Fatalf("%v", startErr)
 	}
+	// The gateway isn't served, the public keys that verify the tokens are
+	// published on their well known path by a plain http server.
+	mux := http.NewServeMux()
+	mux.Handle("/.well-known/jwks.json", keyring)
+	restServer := &http.Server{Addr: fmt.Sprintf(":%s", cfg.ApiRestPort), Handler: mux}
+	go func() {
+		log.Infof("Rest Server started on %s ", cfg.ApiRestPort)
+		if err := restServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Error serving the jwks: %v", err)
+		}
+	}()
+	jobs.Start()
 	s.AwaitTermination(func() {
 		log.Print("Shutting down the server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := restServer.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("shutting down the rest server: %v", err)
+		}
+		log.Info("Waiting for the running jobs")
+		jobs.Stop()
+		log.Info("Closing the database and redis connections")
+		if err := stDb.Close(); err != nil {
+			log.Errorf("closing the database: %v", err)
+		}
+		if err := sqlDb.SqlDb.Close(); err != nil {
+			log.Errorf("closing the database: %v", err)
+		}
+		if err := rdb.Close(); err != nil {
+			log.Errorf("closing redis: %v", err)
+		}
 	})
 }
 
-func serviceRegister(sv *grpc.Server) {
+func serviceRegister(sv *grpc.Server, cfg *config.Config, rdb *redis.Client, stDb *sql.DB, sqlDb *sqldb.Sql, repository repository.Repository, jobs *scheduler.Scheduler) {
 	if _, err := os.Stat("mool-for-shopping-firebase-adminsdk-4vkol-f4cc371851.json"); err == nil {
 		fmt.Printf("File exists\n")
 	} else {
 		fmt.Printf("File does not exist\n")
 	}
-	// Configurations
-	cfg, err := config.New()
-	if err != nil {
-		log.Fatal("cannot load config:", err)
-	}
 	// Mool for shopping - Firebase Client
 	// opt := []option.ClientOption{option.WithCredentialsJSON([]byte(cfg.MoolShoppingFirebase))}
 	opt := option.WithCredentialsFile("mool-for-shopping-firebase-adminsdk-4vkol-f4cc371851.json")
@@ -221,32 +297,6 @@ func serviceRegister(sv *grpc.Server) {
 	if err != nil && cfg.Environment != "development" {
 		log.Fatalf("error getting Mool Shopping Firebase Messaging Client: %v\n", err)
 	}
-	// Redis
-	rdb := rdb.New(cfg)
-	// Standard Library Database Connection
-	stDb, err := sql.Open("pgx",
-		cfg.DBDsn)
-	if err != nil {
-		log.Fatal(err)
-	}
-	// defer stDb.Close()
-	// Database GORM
-	sqlDb, err := sqldb.New(cfg)
-	if err != nil {
-		log.Fatalf("Error connecting to database: %v", err)
-		return
-	}
-	// ObjectStorageServer
-	s3, err := s3.New(cfg)
-	if err != nil {
-		log.Fatalf("Error connecting to minio: %v", err)
-	}
-	// Datasource
-	datasource := datasource.New(sqlDb.Gorm, cfg, s3)
-	// Repository
-	repository := repository.New(sqlDb.Gorm, cfg, datasource, rdb)
-	// Handle the cli
-	cli.HandleCli(os.Args, sqlDb.Gorm, cfg, repository)
 	itemService := usecase.NewItemService(repository, cfg, stDb, sqlDb)
 	authenticationService := usecase.NewAuthenticationService(repository, cfg, sqlDb, moolShoppingClient)
 	businessService := usecase.NewBusinessService(repository, cfg, stDb, sqlDb)
@@ -257,7 +307,20 @@ func serviceRegister(sv *grpc.Server) {
 	applicationService := usecase.NewApplicationService(repository, sqlDb)
 	paymentMethodService := usecase.NewPaymentMethodService(repository, cfg, rdb, sqlDb)
 	permissionService := usecase.NewPermissionService(repository, sqlDb)
+	banService := usecase.NewBanService(repository, sqlDb)
+	analyticsMaxDelay, err := time.ParseDuration(cfg.AnalyticsMaxDelay)
+	if err != nil {
+		log.Fatalf("invalid ANALYTICS_MAX_DELAY: %v", err)
+	}
+	analyticsService := usecase.NewAnalyticsService(repository, sqlDb, analyticsMaxDelay)
+	emailNotifier, err := notification.NewSMTPNotifier(cfg)
+	if err != nil {
+		log.Fatalf("Error configuring the smtp notifier: %v", err)
+	}
+	outboxService := usecase.NewOutboxService(repository, cfg, sqlDb, emailNotifier, notification.NewFCMNotifier(moolShoppingClient))
 	pb.RegisterPermissionServiceServer(sv, app.NewPermissionServer(permissionService))
+	pb.RegisterBanServiceServer(sv, app.NewBanServer(banService))
+	pb.RegisterAnalyticsServiceServer(sv, app.NewAnalyticsServer(analyticsService))
 	pb.RegisterItemServiceServer(sv, app.NewItemServer(
 		itemService,
 	))
@@ -283,9 +346,65 @@ func serviceRegister(sv *grpc.Server) {
 	pb.RegisterPaymentMethodServiceServer(sv, app.NewPaymentMethodServer(
 		paymentMethodService,
 	))
+	// Background jobs
+	orderExpirationInterval, err := time.ParseDuration(cfg.OrderExpirationInterval)
+	if err != nil {
+		log.Fatalf("invalid ORDER_EXPIRATION_INTERVAL: %v", err)
+	}
+	cartItemSweepInterval, err := time.ParseDuration(cfg.CartItemSweepInterval)
+	if err != nil {
+		log.Fatalf("invalid CART_ITEM_SWEEP_INTERVAL: %v", err)
+	}
+	outboxDispatchInterval, err := time.ParseDuration(cfg.OutboxDispatchInterval)
+	if err != nil {
+		log.Fatalf("invalid OUTBOX_DISPATCH_INTERVAL: %v", err)
+	}
+	verificationCodeSweepInterval, err := time.ParseDuration(cfg.VerificationCodeSweepInterval)
+	if err != nil {
+		log.Fatalf("invalid VERIFICATION_CODE_SWEEP_INTERVAL: %v", err)
+	}
+	analyticsRollupInterval, err := time.ParseDuration(cfg.AnalyticsRollupInterval)
+	if err != nil {
+		log.Fatalf("invalid ANALYTICS_ROLLUP_INTERVAL: %v", err)
+	}
+	jobs.Add(scheduler.Job{Name: "expire_orders", Interval: orderExpirationInterval, Run: func(ctx context.Context) error {
+		expired, err := orderService.ExpireOrders(ctx)
+		if expired != 0 {
+			log.Infof("%d orders expired", expired)
+		}
+		return err
+	}})
+	jobs.Add(scheduler.Job{Name: "sweep_cart_items", Interval: cartItemSweepInterval, Run: func(ctx context.Context) error {
+		swept, err := cartItemService.SweepExpiredCartItems(ctx)
+		if swept != 0 {
+			log.Infof("%d expired cart items released", swept)
+		}
+		return err
+	}})
+	jobs.Add(scheduler.Job{Name: "dispatch_outbox", Interval: outboxDispatchInterval, Run: func(ctx context.Context) error {
+		delivered, err := outboxService.DispatchOutbox(ctx)
+		if delivered != 0 {
+			log.Infof("%d outbox messages delivered", delivered)
+		}
+		return err
+	}})
+	jobs.Add(scheduler.Job{Name: "purge_verification_codes", Interval: verificationCodeSweepInterval, Run: func(ctx context.Context) error {
+		purged, err := authenticationService.PurgeVerificationCodes(ctx)
+		if purged != 0 {
+			log.Infof("%d verification codes purged", purged)
+		}
+		return err
+	}})
+	jobs.Add(scheduler.Job{Name: "rollup_analytics", Interval: analyticsRollupInterval, Run: func(ctx context.Context) error {
+		rolledUp, err := analyticsService.RollupAnalytics(ctx)
+		if rolledUp != 0 {
+			log.Infof("%d analytics hours rolled up", rolledUp)
+		}
+		return err
+	}})
 }
 
-func addInterceptors(s *utils.GrpcServerBuilder) {
-	s.SetUnaryInterceptors(utils.GetDefaultUnaryServerInterceptors())
-	s.SetStreamInterceptors(utils.GetDefaultStreamServerInterceptors())
+func addInterceptors(s *utils.GrpcServerBuilder, authenticator usecase.Authenticator, rateLimiter usecase.RateLimiter, trustedProxies interceptors.TrustedProxies) {
+	s.SetUnaryInterceptors(append(utils.GetDefaultUnaryServerInterceptors(app.IsPublicMethod, trustedProxies), interceptors.UnaryPrincipalInterceptor(authenticator.Authenticate), interceptors.UnaryRateLimitInterceptor(rateLimiter.Limit)))
+	s.SetStreamInterceptors(append(utils.GetDefaultStreamServerInterceptors(app.IsPublicMethod, trustedProxies), interceptors.StreamPrincipalInterceptor(authenticator.Authenticate)))
 }
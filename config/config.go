@@ -1,6 +1,11 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type Config struct {
 	Environment                        string `mapstructure:"ENVIRONMENT"`
@@ -14,7 +19,10 @@ type Config struct {
 	ApiPort                            string `mapstructure:"API_PORT"`
 	ApiRestPort                        string `mapstructure:"API_REST_PORT"`
 	PrometheusPushgateway              string `mapstructure:"PROMETHEUS_PUSHGATEWAY"`
-	JwtSecret                          string `mapstructure:"JWT_SECRET"`
+	JwtKeysDir                         string `mapstructure:"JWT_KEYS_DIR"`
+	JwtActiveKeyId                     string `mapstructure:"JWT_ACTIVE_KEY_ID"`
+	JwtLegacySecret                    string `mapstructure:"JWT_LEGACY_SECRET"`
+	JwtLegacyUntil                     string `mapstructure:"JWT_LEGACY_UNTIL"`
 	ObjectStorageServerUseSsl          string `mapstructure:"OBJECT_STORAGE_SERVER_USE_SSL"`
 	ObjectStorageServerAccessKeyId     string `mapstructure:"OBJECT_STORAGE_SERVER_ACCESS_KEY_ID"`
 	ObjectStorageServerSecretAccessKey string `mapstructure:"OBJECT_STORAGE_SERVER_SECRET_ACCESS_KEY"`
@@ -34,6 +42,21 @@ type Config struct {
 	RedisPort                          string `mapstructure:"REIDS_PORT"`
 	RedisPassword                      string `mapstructure:"REDIS_PASSWORD"`
 	RedisDb                            int    `mapstructure:"REDIS_DB"`
+	OrderExpirationInterval            string `mapstructure:"ORDER_EXPIRATION_INTERVAL"`
+	CartItemReservationTtl             string `mapstructure:"CART_ITEM_RESERVATION_TTL"`
+	CartItemReservationTtlByBusiness   string `mapstructure:"CART_ITEM_RESERVATION_TTL_BY_BUSINESS"`
+	CartItemSweepInterval              string `mapstructure:"CART_ITEM_SWEEP_INTERVAL"`
+	OutboxDispatchInterval             string `mapstructure:"OUTBOX_DISPATCH_INTERVAL"`
+	OutboxRetryBaseDelay               string `mapstructure:"OUTBOX_RETRY_BASE_DELAY"`
+	OutboxMaxAttempts                  int    `mapstructure:"OUTBOX_MAX_ATTEMPTS"`
+	RateLimitPolicies                  string `mapstructure:"RATE_LIMIT_POLICIES"`
+	TrustedProxies                     string `mapstructure:"TRUSTED_PROXIES"`
+	VerificationCodeTtl                string `mapstructure:"VERIFICATION_CODE_TTL"`
+	VerificationCodeMaxAttempts        int    `mapstructure:"VERIFICATION_CODE_MAX_ATTEMPTS"`
+	VerificationCodeLockout            string `mapstructure:"VERIFICATION_CODE_LOCKOUT"`
+	VerificationCodeSweepInterval      string `mapstructure:"VERIFICATION_CODE_SWEEP_INTERVAL"`
+	AnalyticsRollupInterval            string `mapstructure:"ANALYTICS_ROLLUP_INTERVAL"`
+	AnalyticsMaxDelay                  string `mapstructure:"ANALYTICS_MAX_DELAY"`
 }
 
 func New() (*Config, error) {
@@ -54,3 +77,17 @@ func New() (*Config, error) {
 	}
 	return &cfg, nil
 }
+
+// CartItemReservationTtlFor returns how long the items added to the cart stay
+// reserved for a business. CART_ITEM_RESERVATION_TTL_BY_BUSINESS overrides the
+// global CART_ITEM_RESERVATION_TTL with a comma separated list of
+// <business id>=<duration> pairs.
+func (c *Config) CartItemReservationTtlFor(businessId string) (time.Duration, error) {
+	for _, pair := range strings.Split(c.CartItemReservationTtlByBusiness, ",") {
+		keyValue := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(keyValue) == 2 && keyValue[0] == businessId {
+			return time.ParseDuration(keyValue[1])
+		}
+	}
+	return time.ParseDuration(c.CartItemReservationTtl)
+}
@@ -0,0 +1,104 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AnalyticsDatasource interface {
+	UpsertAnalyticsHourly(tx *gorm.DB, data *[]entity.AnalyticsHourly) error
+	RollupAnalyticsDaily(tx *gorm.DB, day time.Time) error
+	ListBusinessAnalytics(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error)
+	SumItemAnalytics(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error)
+	CountBusinessOrder(tx *gorm.DB, filter *AnalyticsFilter) (int64, error)
+	CountItemOrder(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error)
+}
+
+// AnalyticsFilter selects the counts of a business between StartTime and
+// EndTime. Daily reads the daily table instead of the hourly one.
+type AnalyticsFilter struct {
+	BusinessId *uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+	Daily      bool
+}
+
+// AnalyticsCount is a row of an aggregate query, the fields that the query
+// doesn't group by are empty.
+type AnalyticsCount struct {
+	ResourceId *uuid.UUID
+	Type       string
+	Time       time.Time
+	Count      int64
+}
+
+type analyticsDatasource struct{}
+
+// UpsertAnalyticsHourly replaces the counts of the hours, so rolling up the
+// same buffer twice doesn't count the views twice.
+func (i *analyticsDatasource) UpsertAnalyticsHourly(tx *gorm.DB, data *[]entity.AnalyticsHourly) error {
+	if len(*data) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "resource"}, {Name: "resource_id"}, {Name: "type"}, {Name: "time"}},
+		DoUpdates: clause.AssignmentColumns([]string{"business_id", "count"}),
+	}).Create(data).Error
+}
+
+// RollupAnalyticsDaily recomputes the daily counts of the UTC day from the
+// hourly ones.
+func (i *analyticsDatasource) RollupAnalyticsDaily(tx *gorm.DB, day time.Time) error {
+	return tx.Exec(`INSERT INTO "analytics_daily" ("resource", "resource_id", "type", "time", "business_id", "count") SELECT "resource", "resource_id", "type", ?, "business_id", SUM("count") FROM "analytics_hourly" WHERE "time" >= ? AND "time" < ? GROUP BY "resource", "resource_id", "type", "business_id" ON CONFLICT ("resource", "resource_id", "type", "time") DO UPDATE SET "business_id" = excluded."business_id", "count" = excluded."count"`, day, day, day.AddDate(0, 0, 1)).Error
+}
+
+func (i *analyticsDatasource) table(filter *AnalyticsFilter) string {
+	if filter.Daily {
+		return entity.AnalyticsDailyTableName
+	}
+	return entity.AnalyticsHourlyTableName
+}
+
+func (i *analyticsDatasource) ListBusinessAnalytics(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error) {
+	var res []AnalyticsCount
+	result := tx.Table(i.table(filter)).Select(`"type", "time", "count"`).Where(`"resource" = ? AND "resource_id" = ? AND "time" >= ? AND "time" < ?`, entity.AnalyticsResourceBusiness, filter.BusinessId, filter.StartTime, filter.EndTime).Order(`"time"`).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+func (i *analyticsDatasource) SumItemAnalytics(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error) {
+	var res []AnalyticsCount
+	result := tx.Table(i.table(filter)).Select(`"resource_id", "type", SUM("count") AS "count"`).Where(`"resource" = ? AND "business_id" = ? AND "time" >= ? AND "time" < ?`, entity.AnalyticsResourceItem, filter.BusinessId, filter.StartTime, filter.EndTime).Group(`"resource_id", "type"`).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+// CountBusinessOrder counts the orders placed in the business, whatever their
+// status.
+func (i *analyticsDatasource) CountBusinessOrder(tx *gorm.DB, filter *AnalyticsFilter) (int64, error) {
+	var res int64
+	result := tx.Model(&entity.Order{}).Where("business_id = ? AND create_time >= ? AND create_time < ?", filter.BusinessId, filter.StartTime, filter.EndTime).Count(&res)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return res, nil
+}
+
+// CountItemOrder counts by item the orders placed in the business that
+// contain the item.
+func (i *analyticsDatasource) CountItemOrder(tx *gorm.DB, filter *AnalyticsFilter) (*[]AnalyticsCount, error) {
+	var res []AnalyticsCount
+	result := tx.Raw(`SELECT "ordered_item"."item_id" AS "resource_id", COUNT(DISTINCT "order"."id") AS "count" FROM "order" JOIN "union_order_and_ordered_item" ON "union_order_and_ordered_item"."order_id" = "order"."id" AND "union_order_and_ordered_item"."delete_time" IS NULL JOIN "ordered_item" ON "ordered_item"."id" = "union_order_and_ordered_item"."ordered_item_id" AND "ordered_item"."delete_time" IS NULL WHERE "order"."business_id" = ? AND "order"."create_time" >= ? AND "order"."create_time" < ? AND "order"."delete_time" IS NULL GROUP BY "ordered_item"."item_id"`, filter.BusinessId, filter.StartTime, filter.EndTime).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
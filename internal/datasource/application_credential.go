@@ -0,0 +1,63 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ApplicationCredentialDatasource interface {
+	CreateApplicationCredential(tx *gorm.DB, data *entity.ApplicationCredential) (*entity.ApplicationCredential, error)
+	GetApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential) (*entity.ApplicationCredential, error)
+	ListApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error)
+	UpdateApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential, data *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error)
+}
+
+type applicationCredentialDatasource struct{}
+
+func (i *applicationCredentialDatasource) CreateApplicationCredential(tx *gorm.DB, data *entity.ApplicationCredential) (*entity.ApplicationCredential, error) {
+	result := tx.Create(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return data, nil
+}
+
+func (i *applicationCredentialDatasource) GetApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential) (*entity.ApplicationCredential, error) {
+	var res *entity.ApplicationCredential
+	result := tx.Where(where).Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (i *applicationCredentialDatasource) ListApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error) {
+	var res []entity.ApplicationCredential
+	result := tx.Where(where).Order("create_time desc").Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+// UpdateApplicationCredential updates the active credentials that match, the
+// revoked ones are left as they are.
+func (i *applicationCredentialDatasource) UpdateApplicationCredential(tx *gorm.DB, where *entity.ApplicationCredential, data *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error) {
+	var res []entity.ApplicationCredential
+	data.UpdateTime = time.Now().UTC()
+	result := tx.Model(&res).Clauses(clause.Returning{}).Where(where).Where("revoke_time IS NULL").Updates(data)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
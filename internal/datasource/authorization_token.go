@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -11,13 +10,24 @@ import (
 
 type AuthorizationTokenDatasource interface {
 	GetAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken) (*entity.AuthorizationToken, error)
+	ListAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken) (*[]entity.AuthorizationToken, error)
 	CreateAuthorizationToken(tx *gorm.DB, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error)
 	DeleteAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error)
 	DeleteAuthorizationTokenByRefreshTokenIds(tx *gorm.DB, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error)
+	UpdateAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error)
 }
 
 type authorizationTokenDatasource struct{}
 
+func (v *authorizationTokenDatasource) ListAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken) (*[]entity.AuthorizationToken, error) {
+	var res []entity.AuthorizationToken
+	result := tx.Where(where).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
 func (v *authorizationTokenDatasource) CreateAuthorizationToken(tx *gorm.DB, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error) {
 	result := tx.Create(&data)
 	if result.Error != nil {
@@ -37,7 +47,7 @@ func (r *authorizationTokenDatasource) DeleteAuthorizationToken(tx *gorm.DB, whe
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -48,7 +58,7 @@ func (r *authorizationTokenDatasource) DeleteAuthorizationTokenByRefreshTokenIds
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -57,11 +67,21 @@ func (v *authorizationTokenDatasource) GetAuthorizationToken(tx *gorm.DB, where
 	var res *entity.AuthorizationToken
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	}
 	return res, nil
 }
+
+func (r *authorizationTokenDatasource) UpdateAuthorizationToken(tx *gorm.DB, where *entity.AuthorizationToken, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error) {
+	result := tx.Model(&entity.AuthorizationToken{}).Where(where).Updates(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return data, nil
+}
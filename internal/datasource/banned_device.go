@@ -0,0 +1,52 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BannedDeviceDatasource interface {
+	CreateBannedDevice(tx *gorm.DB, data *entity.BannedDevice) (*entity.BannedDevice, error)
+	GetActiveBannedDevice(tx *gorm.DB, where *entity.BannedDevice, now time.Time) (*entity.BannedDevice, error)
+	DeleteBannedDevice(tx *gorm.DB, where *entity.BannedDevice) (*[]entity.BannedDevice, error)
+}
+
+type bannedDeviceDatasource struct{}
+
+func (i *bannedDeviceDatasource) CreateBannedDevice(tx *gorm.DB, data *entity.BannedDevice) (*entity.BannedDevice, error) {
+	result := tx.Create(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return data, nil
+}
+
+// GetActiveBannedDevice returns the ban that hasn't expired yet, the one that
+// lasts longer when there are several.
+func (i *bannedDeviceDatasource) GetActiveBannedDevice(tx *gorm.DB, where *entity.BannedDevice, now time.Time) (*entity.BannedDevice, error) {
+	var res *entity.BannedDevice
+	result := tx.Where(where).Where("expiration_time IS NULL OR expiration_time > ?", now).Order("expiration_time desc nulls first").Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (i *bannedDeviceDatasource) DeleteBannedDevice(tx *gorm.DB, where *entity.BannedDevice) (*[]entity.BannedDevice, error) {
+	var res *[]entity.BannedDevice
+	result := tx.Clauses(clause.Returning{}).Where(where).Delete(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BannedUserDatasource interface {
+	CreateBannedUser(tx *gorm.DB, data *entity.BannedUser) (*entity.BannedUser, error)
+	GetActiveBannedUser(tx *gorm.DB, where *entity.BannedUser, now time.Time) (*entity.BannedUser, error)
+	DeleteBannedUser(tx *gorm.DB, where *entity.BannedUser) (*[]entity.BannedUser, error)
+}
+
+type bannedUserDatasource struct{}
+
+func (i *bannedUserDatasource) CreateBannedUser(tx *gorm.DB, data *entity.BannedUser) (*entity.BannedUser, error) {
+	result := tx.Create(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return data, nil
+}
+
+// GetActiveBannedUser returns the ban that hasn't expired yet, the one that
+// lasts longer when there are several.
+func (i *bannedUserDatasource) GetActiveBannedUser(tx *gorm.DB, where *entity.BannedUser, now time.Time) (*entity.BannedUser, error) {
+	var res *entity.BannedUser
+	result := tx.Where(where).Where("expiration_time IS NULL OR expiration_time > ?", now).Order("expiration_time desc nulls first").Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (i *bannedUserDatasource) DeleteBannedUser(tx *gorm.DB, where *entity.BannedUser) (*[]entity.BannedUser, error) {
+	var res *[]entity.BannedUser
+	result := tx.Clauses(clause.Returning{}).Where(where).Delete(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return res, nil
+}
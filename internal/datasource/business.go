@@ -1,11 +1,10 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"github.com/twpayne/go-geom/encoding/ewkb"
 	"gorm.io/gorm"
@@ -13,7 +12,10 @@ import (
 )
 
 type BusinessDatasource interface {
-	Feed(tx *gorm.DB, coordinates ewkb.Point, limit int32, provinceId string, municipalityId string, cursor int32, municipalityNotEqual bool, homeDelivery bool, toPickUp bool) (*[]entity.Business, error)
+	Feed(tx *gorm.DB, feed *BusinessFeed) (*[]BusinessFeedResult, error)
+	CheckBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*BusinessDeliveryZoneCheck, error)
+	UpdateBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*BusinessDeliveryZone, error)
+	GetBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID) (*BusinessDeliveryZone, error)
 	GetBusiness(tx *gorm.DB, where *entity.Business) (*entity.Business, error)
 	GetBusinessPreloadSchedule(tx *gorm.DB, where *entity.Business) (*entity.Business, error)
 	CreateBusiness(tx *gorm.DB, data *entity.Business) (*entity.Business, error)
@@ -22,17 +24,23 @@ type BusinessDatasource interface {
 	GetBusinessWithDistance(tx *gorm.DB, where *entity.Business, userCoordinates ewkb.Point) (*entity.Business, error)
 	GetBusinessDistance(tx *gorm.DB, where *entity.Business, userCoordinates ewkb.Point) (*entity.Business, error)
 	BusinessIsInRange(tx *gorm.DB, coordinates ewkb.Point, businessId *uuid.UUID) (*bool, error)
+	SearchBusiness(tx *gorm.DB, search *BusinessSearch) (*[]BusinessSearchResult, error)
+	ListBusinessInBounds(tx *gorm.DB, bounds *BusinessBounds, limit int) (*[]entity.Business, error)
+	ClusterBusinessInBounds(tx *gorm.DB, bounds *BusinessBounds, cellSize float64) (*[]BusinessCluster, error)
 }
 
 type businessDatasource struct{}
 
+// businessColumns is the SELECT column list of a business, qualified so it
+// can be used in joins.
+var businessColumns = []string{"business.id", "business.name", "business.delivery_price_cup", "business.open_flag", "business.address", "business.high_quality_photo", "business.low_quality_photo", "business.thumbnail", "business.blurhash", "business.time_margin_order_month", "business.time_margin_order_day", "business.time_margin_order_hour", "business.time_margin_order_minute", "business.to_pick_up", "business.home_delivery", "ST_AsEWKB(business.coordinates) AS coordinates", "business.province_id", "business.municipality_id", "business.business_brand_id", "business.enabled_flag", "business.create_time", "business.update_time", "business.cursor"}
+
 func (b *businessDatasource) GetBusinessPreloadSchedule(tx *gorm.DB, where *entity.Business) (*entity.Business, error) {
 	var res *entity.Business
-	selectField := &[]string{"id", "name", "delivery_price_cup", "address", "high_quality_photo", "low_quality_photo", "thumbnail", "blurhash", "time_margin_order_month", "time_margin_order_day", "time_margin_order_hour", "time_margin_order_minute", "delivery_price_cup", "to_pick_up", "home_delivery", "ST_AsEWKB(coordinates) AS coordinates", "province_id", "municipality_id", "business_brand_id", "enabled_flag", "create_time", "update_time", "cursor"}
-	result := tx.Preload("BusinessSchedule").Select(*selectField).Where(where).Take(&res)
+	result := tx.Preload("BusinessSchedule").Select(businessColumns).Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -45,11 +53,14 @@ func (b *businessDatasource) BusinessIsInRange(tx *gorm.DB, coordinates ewkb.Poi
 		IsInRange bool `gorm:"column:is_in_range;not null"`
 	}
 	var res *IsInRange
-	p := fmt.Sprintf("ST_Contains(business.polygon, ST_GeomFromText('POINT(%v %v)', 4326)) as is_in_range", coordinates.Point.Coords()[1], coordinates.Point.Coords()[0])
-	result := tx.Model(&entity.Business{}).Select(p).Where("id = ?", businessId).Take(&res)
+	point, err := pointWKT(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	result := tx.Model(&entity.Business{}).Select("ST_Contains(business.polygon, "+stPoint+") AS is_in_range", point).Where("business.id = ?", businessId).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -59,11 +70,10 @@ func (b *businessDatasource) BusinessIsInRange(tx *gorm.DB, coordinates ewkb.Poi
 
 func (b *businessDatasource) GetBusiness(tx *gorm.DB, where *entity.Business) (*entity.Business, error) {
 	var res *entity.Business
-	selectField := &[]string{"id", "name", "delivery_price_cup", "open_flag", "address", "high_quality_photo", "low_quality_photo", "thumbnail", "blurhash", "time_margin_order_month", "time_margin_order_day", "time_margin_order_hour", "time_margin_order_minute", "delivery_price_cup", "to_pick_up", "home_delivery", "ST_AsEWKB(coordinates) AS coordinates", "province_id", "municipality_id", "business_brand_id", "enabled_flag", "create_time", "update_time", "cursor"}
-	result := tx.Select(*selectField).Where(where).Take(&res)
+	result := tx.Select(businessColumns).Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -72,7 +82,10 @@ func (b *businessDatasource) GetBusiness(tx *gorm.DB, where *entity.Business) (*
 }
 
 func (b *businessDatasource) CreateBusiness(tx *gorm.DB, data *entity.Business) (*entity.Business, error) {
-	point := fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+	point, err := pointWKT(data.Coordinates)
+	if err != nil {
+		return nil, err
+	}
 	var time = time.Now().UTC()
 	var res entity.Business
 	var countRes []entity.Business
@@ -87,8 +100,8 @@ func (b *businessDatasource) CreateBusiness(tx *gorm.DB, data *entity.Business)
 func (b *businessDatasource) UpdateBusiness(tx *gorm.DB, data *entity.Business, where *entity.Business) (*entity.Business, error) {
 	result := tx.Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "name"}, {Name: "description"}, {Name: "address"}, {Name: "high_quality_photo"}, {Name: "high_quality_photo_blurhash"}, {Name: "low_quality_photo"}, {Name: "low_quality_photo_blurhash"}, {Name: "thumbnail"}, {Name: "thumbnail_blurhash"}, {Name: "time_margin_order_month"}, {Name: "time_margin_order_day"}, {Name: "time_margin_order_hour"}, {Name: "time_margin_order_minute"}, {Name: "delivery_price_cup"}, {Name: "to_pick_up"}, {Name: "home_delivery"}, {Name: "home_delivery"}, {Name: "province_id"}, {Name: "municipality_id"}, {Name: "business_brand_id"}, {Name: "create_time"}, {Name: "update_time"}}}).Where(where).Updates(&data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -97,7 +110,10 @@ func (b *businessDatasource) UpdateBusiness(tx *gorm.DB, data *entity.Business,
 }
 
 func (b *businessDatasource) UpdateBusinessCoordinate(tx *gorm.DB, data *entity.Business, where *entity.Business) error {
-	point := fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+	point, err := pointWKT(data.Coordinates)
+	if err != nil {
+		return err
+	}
 	var time = time.Now().UTC()
 	var response entity.Business
 	result := tx.Raw(`UPDATE "business" SET coordinates = ST_GeomFromText(?, 4326), update_time = ? WHERE id = ?`, point, time, where.ID).Scan(&response)
@@ -107,50 +123,37 @@ func (b *businessDatasource) UpdateBusinessCoordinate(tx *gorm.DB, data *entity.
 	return nil
 }
 
-func (b *businessDatasource) Feed(tx *gorm.DB, coordinates ewkb.Point, limit int32, provinceId string, municipalityId string, cursor int32, municipalityNotEqual bool, homeDelivery bool, toPickUp bool) (*[]entity.Business, error) {
-	var businessResult *[]entity.Business
-	var delivery string
-	if homeDelivery {
-		delivery = "business.home_delivery = true"
-	} else {
-		delivery = "business.to_pick_up = true"
-	}
-	var where string
-	if municipalityNotEqual {
-		where = fmt.Sprintf("WHERE cursor > %v AND province_id = '%v' AND municipality_id != '%v' AND %v", cursor, provinceId, municipalityId, delivery)
-	} else {
-		where = fmt.Sprintf("WHERE cursor > %v AND province_id = '%v' AND municipality_id = '%v' AND %v", cursor, provinceId, municipalityId, delivery)
-	}
-	query := fmt.Sprintf("SELECT id, name, delivery_price_cup, address, open_flag, high_quality_photo, low_quality_photo, blurhash, delivery_price_cup, home_delivery, to_pick_up, business_brand_id, province_id, municipality_id, cursor FROM business %v ORDER BY cursor asc LIMIT 6;", where)
-	err := tx.Raw(query).Scan(&businessResult).Error
-	if err != nil {
-		return nil, err
-	}
-	return businessResult, nil
-}
-
 func (b *businessDatasource) GetBusinessWithDistance(tx *gorm.DB, where *entity.Business, userCoordinates ewkb.Point) (*entity.Business, error) {
 	var businessResult *entity.Business
-	distance := fmt.Sprintf(`ST_Distance("coordinates", ST_GeomFromText('POINT(%v %v)', 4326)) AS "distance"`, userCoordinates.Point.Coords()[1], userCoordinates.Point.Coords()[0])
-	query := fmt.Sprintf("SELECT business.id, business.name, business.delivery_price_cup, business.open_flag, business_category.name as business_category, business.business_brand_id, business.municipality_id, business.province_id, business.thumbnail, business.blurhash, business.address, business.high_quality_photo, business.time_margin_order_month, business.time_margin_order_day, business.time_margin_order_hour, business.time_margin_order_minute, business.low_quality_photo, business.delivery_price_cup, business.home_delivery, business.to_pick_up, business.cursor, ST_AsEWKB(business.coordinates) AS coordinates, %v FROM business INNER JOIN business_category ON business.business_category_id=business_category.id WHERE business.id = '%v' ORDER BY business.cursor asc LIMIT 1;", distance, where.ID)
-	err := tx.Raw(query).Scan(&businessResult).Error
+	point, err := pointWKT(userCoordinates)
 	if err != nil {
 		return nil, err
-	} else if businessResult == nil {
-		return nil, errors.New("record not found")
+	}
+	selectField := selectColumns(businessColumns, []string{"business_category.name AS business_category", stDistance("business.coordinates")})
+	result := tx.Model(&entity.Business{}).Select(selectField, point).Joins("INNER JOIN business_category ON business.business_category_id = business_category.id").Where("business.id = ?", where.ID).Take(&businessResult)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
 	}
 	return businessResult, nil
 }
 
 func (b *businessDatasource) GetBusinessDistance(tx *gorm.DB, where *entity.Business, userCoordinates ewkb.Point) (*entity.Business, error) {
 	var businessResult *entity.Business
-	distance := fmt.Sprintf(`ST_Distance("coordinates", ST_GeomFromText('POINT(%v %v)', 4326)) AS "distance"`, userCoordinates.Point.Coords()[1], userCoordinates.Point.Coords()[0])
-	query := fmt.Sprintf("SELECT %v FROM business WHERE business.id = '%v' LIMIT 1;", distance, where.ID)
-	err := tx.Raw(query).Scan(&businessResult).Error
+	point, err := pointWKT(userCoordinates)
 	if err != nil {
 		return nil, err
-	} else if businessResult == nil {
-		return nil, errors.New("record not found")
+	}
+	result := tx.Model(&entity.Business{}).Select(stDistance("business.coordinates"), point).Where("business.id = ?", where.ID).Take(&businessResult)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
 	}
 	return businessResult, nil
 }
@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -30,16 +29,16 @@ func (i *businessCategoryDatasource) ListBusinessCategory(tx *gorm.DB, where *en
 func (v *businessCategoryDatasource) CreateBusinessCategory(tx *gorm.DB, data *entity.BusinessCategory) (*entity.BusinessCategory, error) {
 	var existBusinessCategory *entity.BusinessCategory
 	existResult := tx.Where("name = ?", data.Name).Select("id").Take(&existBusinessCategory)
-	if existResult.Error != nil && existResult.Error.Error() != "record not found" {
+	if existResult.Error != nil && !apperror.IsNotFound(existResult.Error) {
 		return nil, existResult.Error
 	}
-	if existResult.Error.Error() == "record not found" {
+	if apperror.IsNotFound(existResult.Error) {
 		result := tx.Create(&data)
 		if result.Error != nil {
 			return nil, result.Error
 		}
 	} else {
-		return nil, errors.New("record exists")
+		return nil, apperror.AlreadyExists("record exists")
 	}
 	return data, nil
 }
@@ -48,8 +47,8 @@ func (v *businessCategoryDatasource) GetBusinessCategory(tx *gorm.DB, BusinessCa
 	var res *entity.BusinessCategory
 	result := tx.Where(BusinessCategory).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -68,7 +67,7 @@ func (v *businessCategoryDatasource) DeleteBusinessCategory(tx *gorm.DB, where *
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"gorm.io/gorm"
 )
 
@@ -27,8 +26,8 @@ func (i *businessCollectionDatasource) GetBusinessCollection(tx *gorm.DB, where
 	var businessBusinessCollection *entity.BusinessCollection
 	result := tx.Where(where).Take(&businessBusinessCollection)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
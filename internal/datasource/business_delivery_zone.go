@@ -0,0 +1,71 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BusinessDeliveryZone is the area a business delivers to, a GeoJSON
+// MultiPolygon. BusinessIsInRange checks the points against it.
+type BusinessDeliveryZone struct {
+	BusinessId *uuid.UUID `gorm:"column:id"`
+	GeoJson    string     `gorm:"column:geo_json"`
+	UpdateTime time.Time  `gorm:"column:update_time"`
+}
+
+// BusinessDeliveryZoneCheck tells whether a zone is a valid geometry, why
+// not, and whether the municipalities served by the business cover it.
+type BusinessDeliveryZoneCheck struct {
+	Valid   bool   `gorm:"column:valid"`
+	Reason  string `gorm:"column:reason"`
+	Covered bool   `gorm:"column:covered"`
+}
+
+// CheckBusinessDeliveryZone checks the zone, a GeoJSON bound to stGeoJSON.
+// The coverage is only checked on a valid zone, the invalid geometries may
+// fail the spatial predicates.
+func (b *businessDatasource) CheckBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*BusinessDeliveryZoneCheck, error) {
+	var res BusinessDeliveryZoneCheck
+	err := tx.Raw("SELECT ST_IsValid(zone.geom) AS valid, ST_IsValidReason(zone.geom) AS reason FROM (SELECT "+stGeoJSON+" AS geom) AS zone", zone).Scan(&res).Error
+	if err != nil || !res.Valid {
+		return &res, err
+	}
+	var coverage BusinessDeliveryZoneCheck
+	err = tx.Raw(`SELECT coalesce(ST_CoveredBy(zone.geom, served.geom), false) AS covered FROM (SELECT `+stGeoJSON+` AS geom) AS zone, (
+		SELECT ST_Union(municipality.polygon) AS geom FROM union_business_and_municipality
+		JOIN municipality ON municipality.id = union_business_and_municipality.municipality_id
+		WHERE union_business_and_municipality.business_id = ? AND union_business_and_municipality.delete_time IS NULL) AS served`, zone, businessId).Scan(&coverage).Error
+	if err != nil {
+		return nil, err
+	}
+	res.Covered = coverage.Covered
+	return &res, nil
+}
+
+func (b *businessDatasource) UpdateBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*BusinessDeliveryZone, error) {
+	result := tx.Model(&entity.Business{}).Where("business.id = ?", businessId).Updates(map[string]interface{}{"polygon": gorm.Expr(stGeoJSON, zone), "update_time": time.Now().UTC()})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return b.GetBusinessDeliveryZone(tx, businessId)
+}
+
+// GetBusinessDeliveryZone returns ErrNotFound when the business has no zone.
+func (b *businessDatasource) GetBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID) (*BusinessDeliveryZone, error) {
+	var res []BusinessDeliveryZone
+	result := tx.Model(&entity.Business{}).Select("business.id, ST_AsGeoJSON(business.polygon) AS geo_json, business.update_time").Where("business.id = ? AND business.polygon IS NOT NULL", businessId).Limit(1).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res[0], nil
+}
@@ -0,0 +1,116 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BusinessFeedOrder is the order of the feed, the nearest businesses come
+// first among those of the same rank.
+type BusinessFeedOrder int
+
+const (
+	BusinessFeedNearest BusinessFeedOrder = iota
+	// BusinessFeedOpenNow ranks the businesses open at the time of the feed
+	// first, by their schedule.
+	BusinessFeedOpenNow
+	// BusinessFeedPopularity ranks the businesses by their orders in the
+	// window before the time of the feed.
+	BusinessFeedPopularity
+)
+
+// businessFeedPopularityWindow is how far back the orders are counted.
+const businessFeedPopularityWindow = 30 * 24 * time.Hour
+
+// BusinessFeed filters and orders a page of the feed. Time is the time of its
+// first page, the ranks of every page are computed at it so the pages don't
+// overlap when a business opens or gets orders meanwhile.
+type BusinessFeed struct {
+	Order              BusinessFeedOrder
+	Location           ewkb.Point
+	ProvinceId         *uuid.UUID
+	MunicipalityId     *uuid.UUID
+	BusinessCategoryId *uuid.UUID
+	HomeDelivery       bool
+	ToPickUp           bool
+	Time               time.Time
+	// OrderStatuses are the statuses of the orders counted by the popularity.
+	OrderStatuses []string
+	Cursor        *FeedCursor
+	Limit         int
+}
+
+// FeedCursor is the position of a business in the feed: rank, nearness and
+// id. The nearness is the planar distance the spatial index orders by.
+type FeedCursor struct {
+	Rank     int64     `json:"r"`
+	Nearness float64   `json:"n"`
+	Id       uuid.UUID `json:"i"`
+}
+
+// BusinessFeedResult is a business of the feed with its distance in meters to
+// the location.
+type BusinessFeedResult struct {
+	entity.Business
+	Rank     int64   `gorm:"column:feed_rank"`
+	Nearness float64 `gorm:"column:nearness"`
+}
+
+// Cursor returns the position of the business in the feed.
+func (i *BusinessFeedResult) Cursor() FeedCursor {
+	return FeedCursor{Rank: i.Rank, Nearness: i.Nearness, Id: *i.ID}
+}
+
+func (b *businessDatasource) Feed(tx *gorm.DB, feed *BusinessFeed) (*[]BusinessFeedResult, error) {
+	var res []BusinessFeedResult
+	point, err := pointWKT(feed.Location)
+	if err != nil {
+		return nil, err
+	}
+	// The order and the cursor use the expressions rather than a derived
+	// table, so the nearest order stays a scan of the spatial index.
+	nearness := "business.coordinates <-> " + stPoint
+	rank, rankArgs := "0::bigint", []interface{}{}
+	switch feed.Order {
+	case BusinessFeedOpenNow:
+		rank, rankArgs = "CASE WHEN business_open_at(business.id, ?) THEN 0 ELSE 1 END", []interface{}{feed.Time}
+	case BusinessFeedPopularity:
+		rank = `-(SELECT count(*) FROM "order" WHERE "order".business_id = business.id AND "order".create_time >= ? AND "order".create_time < ? AND "order".status IN ? AND "order".delete_time IS NULL)`
+		rankArgs = []interface{}{feed.Time.Add(-businessFeedPopularityWindow), feed.Time, feed.OrderStatuses}
+	}
+	args := append(append([]interface{}{}, rankArgs...), point, point)
+	query := tx.Model(&entity.Business{}).Select(selectColumns(businessColumns, []string{rank + " AS feed_rank", nearness + " AS nearness", "ST_Distance(business.coordinates::geography, " + stPoint + "::geography) AS distance"}), args...).Where("business.province_id = ?", feed.ProvinceId)
+	if feed.MunicipalityId != nil {
+		query = query.Where("business.municipality_id = ?", feed.MunicipalityId)
+	}
+	if feed.BusinessCategoryId != nil {
+		query = query.Where("business.business_category_id = ?", feed.BusinessCategoryId)
+	}
+	if feed.HomeDelivery {
+		query = query.Where("business.home_delivery = true")
+	}
+	if feed.ToPickUp {
+		query = query.Where("business.to_pick_up = true")
+	}
+	order := clause.Expr{SQL: nearness + ", business.id", Vars: []interface{}{point}}
+	if feed.Order == BusinessFeedNearest {
+		if feed.Cursor != nil {
+			query = query.Where("("+nearness+", business.id) > (?, ?)", point, feed.Cursor.Nearness, feed.Cursor.Id)
+		}
+	} else {
+		order = clause.Expr{SQL: rank + ", " + order.SQL, Vars: append(append([]interface{}{}, rankArgs...), point)}
+		if feed.Cursor != nil {
+			query = query.Where("("+rank+", "+nearness+", business.id) > (?, ?, ?)", append(append([]interface{}{}, rankArgs...), point, feed.Cursor.Rank, feed.Cursor.Nearness, feed.Cursor.Id)...)
+		}
+	}
+	err = query.Clauses(clause.OrderBy{Expression: order}).Limit(feed.Limit).Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
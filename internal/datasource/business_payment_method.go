@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -49,10 +48,10 @@ func (i *businessPaymentMethodDatasource) CreateBusinessPaymentMethod(tx *gorm.D
 func (i *businessPaymentMethodDatasource) UpdateBusinessPaymentMethod(tx *gorm.DB, where *entity.BusinessPaymentMethod, data *entity.BusinessPaymentMethod) (*entity.BusinessPaymentMethod, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	} else if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -64,8 +63,8 @@ func (i *businessPaymentMethodDatasource) GetBusinessPaymentMethod(tx *gorm.DB,
 	var res *entity.BusinessPaymentMethod
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -84,7 +83,7 @@ func (v *businessPaymentMethodDatasource) DeleteBusinessPaymentMethod(tx *gorm.D
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -1,10 +1,10 @@
 package datasource
 
 import (
-	"errors"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -25,7 +25,7 @@ func (v *businessRoleDatasource) UpdateBusinessRole(tx *gorm.DB, where *entity.B
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return data, nil
 }
@@ -49,7 +49,7 @@ func (v *businessRoleDatasource) DeleteBusinessRole(tx *gorm.DB, where *entity.B
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -67,8 +67,8 @@ func (i *businessRoleDatasource) GetBusinessRole(tx *gorm.DB, where *entity.Busi
 	var res *entity.BusinessRole
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -0,0 +1,135 @@
+package datasource
+
+import (
+	"strconv"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+	"gorm.io/gorm"
+)
+
+const (
+	// businessSearchMatch matches the name of the business or of its
+	// category, or a name close to the term.
+	businessSearchMatch = `(to_tsvector('item_search', business.name) @@ search_query.tsquery
+		OR to_tsvector('item_search', coalesce(business_category.name, '')) @@ search_query.tsquery
+		OR search_query.term <% item_search_unaccent(lower(business.name)))`
+	// businessSearchScore weighs the name over the category, plus the
+	// similarity of the name to the term.
+	businessSearchScore = `round((ts_rank(setweight(to_tsvector('item_search', business.name), 'A')
+		|| setweight(to_tsvector('item_search', coalesce(business_category.name, '')), 'B'), search_query.tsquery)
+		+ word_similarity(search_query.term, item_search_unaccent(lower(business.name)))) * 1000000)::bigint AS score`
+	// businessSearchAvailability puts the open businesses first.
+	businessSearchAvailability = "CASE WHEN business.open_flag THEN 0 ELSE 1 END AS availability_rank"
+	// businessBounds is the envelope of a BusinessBounds bound as west,
+	// south, east and north.
+	businessBounds = "business.coordinates && ST_MakeEnvelope(?, ?, ?, ?, 4326)"
+)
+
+// BusinessSearch filters and orders a search of businesses, like ItemSearch.
+type BusinessSearch struct {
+	Query              string
+	ProvinceId         *uuid.UUID
+	MunicipalityId     *uuid.UUID
+	BusinessCategoryId *uuid.UUID
+	HomeDelivery       bool
+	ToPickUp           bool
+	Open               bool
+	Location           *ewkb.Point
+	Cursor             *SearchCursor
+	Limit              int
+}
+
+// BusinessSearchResult is a business found by a search. Its proximity is the
+// distance in meters to the location of the search, when it has one.
+type BusinessSearchResult struct {
+	entity.Business
+	Score            int64 `gorm:"column:score"`
+	Proximity        int64 `gorm:"column:proximity"`
+	AvailabilityRank int64 `gorm:"column:availability_rank"`
+}
+
+// Cursor returns the position of the business in the search.
+func (i *BusinessSearchResult) Cursor() SearchCursor {
+	return SearchCursor{Score: i.Score, Proximity: i.Proximity, Availability: i.AvailabilityRank, Id: *i.ID}
+}
+
+// BusinessBounds is a map viewport in degrees. It doesn't cross the
+// antimeridian, west is less than east.
+type BusinessBounds struct {
+	West  float64
+	South float64
+	East  float64
+	North float64
+}
+
+// BusinessCluster is a group of businesses close to each other at the zoom of
+// the map, with the centroid and the extent of their coordinates.
+type BusinessCluster struct {
+	Count      int64      `gorm:"column:count"`
+	Longitude  float64    `gorm:"column:longitude"`
+	Latitude   float64    `gorm:"column:latitude"`
+	West       float64    `gorm:"column:west"`
+	South      float64    `gorm:"column:south"`
+	East       float64    `gorm:"column:east"`
+	North      float64    `gorm:"column:north"`
+	BusinessId *uuid.UUID `gorm:"column:business_id"`
+}
+
+func (b *businessDatasource) SearchBusiness(tx *gorm.DB, search *BusinessSearch) (*[]BusinessSearchResult, error) {
+	var res []BusinessSearchResult
+	proximity, proximityArgs, err := searchProximity(search.Location, search.MunicipalityId, "business.municipality_id")
+	if err != nil {
+		return nil, err
+	}
+	query := tx.Model(&entity.Business{}).Select(selectColumns(businessColumns, []string{businessSearchScore, proximity, businessSearchAvailability}), proximityArgs...).Joins("LEFT JOIN business_category ON business_category.id = business.business_category_id").Joins(searchQueryJoin, searchTsquery(search.Query), search.Query).Where(businessSearchMatch)
+	if search.ProvinceId != nil {
+		query = query.Where("business.province_id = ?", search.ProvinceId)
+	}
+	if search.BusinessCategoryId != nil {
+		query = query.Where("business.business_category_id = ?", search.BusinessCategoryId)
+	}
+	if search.HomeDelivery {
+		query = query.Where("business.home_delivery = true")
+	}
+	if search.ToPickUp {
+		query = query.Where("business.to_pick_up = true")
+	}
+	if search.Open {
+		query = query.Where("business.open_flag = true")
+	}
+	result := tx.Table("(?) AS search", query).Select("search.*")
+	if search.Cursor != nil {
+		result = result.Where("(-search.score, search.proximity, search.availability_rank, search.id) > (?, ?, ?, ?)", -search.Cursor.Score, search.Cursor.Proximity, search.Cursor.Availability, search.Cursor.Id)
+	}
+	err = result.Order("search.score desc, search.proximity, search.availability_rank, search.id").Limit(search.Limit).Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (b *businessDatasource) ListBusinessInBounds(tx *gorm.DB, bounds *BusinessBounds, limit int) (*[]entity.Business, error) {
+	var res []entity.Business
+	result := tx.Select(businessColumns).Where(businessBounds, bounds.West, bounds.South, bounds.East, bounds.North).Order("business.id").Limit(limit).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+// ClusterBusinessInBounds groups the businesses of the bounds by the cells of
+// a grid of cellSize degrees.
+func (b *businessDatasource) ClusterBusinessInBounds(tx *gorm.DB, bounds *BusinessBounds, cellSize float64) (*[]BusinessCluster, error) {
+	var res []BusinessCluster
+	result := tx.Model(&entity.Business{}).Select(`count(*) AS count,
+		ST_X(ST_Centroid(ST_Collect(business.coordinates))) AS longitude, ST_Y(ST_Centroid(ST_Collect(business.coordinates))) AS latitude,
+		ST_XMin(ST_Extent(business.coordinates)) AS west, ST_YMin(ST_Extent(business.coordinates)) AS south,
+		ST_XMax(ST_Extent(business.coordinates)) AS east, ST_YMax(ST_Extent(business.coordinates)) AS north,
+		CASE WHEN count(*) = 1 THEN (array_agg(business.id))[1] END AS business_id`).Where(businessBounds, bounds.West, bounds.South, bounds.East, bounds.North).Group("ST_SnapToGrid(business.coordinates, " + strconv.FormatFloat(cellSize, 'g', -1, 64) + ")").Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -29,8 +28,8 @@ func (v *businessUserDatasource) GetBusinessUser(tx *gorm.DB, where *entity.Busi
 	var res *entity.BusinessUser
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -49,7 +48,7 @@ func (v *businessUserDatasource) DeleteBusinessUser(tx *gorm.DB, where *entity.B
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
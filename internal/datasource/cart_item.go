@@ -1,10 +1,10 @@
 package datasource
 
 import (
-	"errors"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -19,10 +19,22 @@ type CartItemDatasource interface {
 	GetCartItem(tx *gorm.DB, where *entity.CartItem) (*entity.CartItem, error)
 	DeleteCartItem(tx *gorm.DB, where *entity.CartItem, ids *[]uuid.UUID) (*[]entity.CartItem, error)
 	CartItemIsEmpty(tx *gorm.DB, where *entity.CartItem) (*bool, error)
+	ListExpiredCartItem(tx *gorm.DB, now time.Time, limit int) (*[]entity.CartItem, error)
 }
 
 type cartItemDatasource struct{}
 
+// ListExpiredCartItem locks the cart items whose reservation expired before
+// now. Rows locked by another transaction are skipped.
+func (i *cartItemDatasource) ListExpiredCartItem(tx *gorm.DB, now time.Time, limit int) (*[]entity.CartItem, error) {
+	var res []entity.CartItem
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where("cart_item.expiration_time < ?", now).Order("cart_item.expiration_time").Limit(limit).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
 func (i *cartItemDatasource) ListCartItemInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.CartItem, error) {
 	var cartItems []entity.CartItem
 	result := tx.Where("id IN ?", ids).Find(&cartItems)
@@ -75,8 +87,8 @@ func (i *cartItemDatasource) CreateCartItem(tx *gorm.DB, data *entity.CartItem)
 func (v *cartItemDatasource) UpdateCartItem(tx *gorm.DB, where *entity.CartItem, data *entity.CartItem) (*entity.CartItem, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -95,7 +107,7 @@ func (v *cartItemDatasource) DeleteCartItem(tx *gorm.DB, where *entity.CartItem,
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -104,8 +116,8 @@ func (v *cartItemDatasource) GetCartItem(tx *gorm.DB, where *entity.CartItem) (*
 	var res *entity.CartItem
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
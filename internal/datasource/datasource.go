@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/pkg/keyring"
 	"github.com/minio/minio-go/v7"
 	"gorm.io/gorm"
 )
@@ -36,6 +37,11 @@ type Datasource interface {
 	NewOrderedItemDatasource() OrderedItemDatasource
 	NewUnionOrderAndOrderedItemDatasource() UnionOrderAndOrderedItemDatasource
 	NewApplicationDatasource() ApplicationDatasource
+	NewApplicationCredentialDatasource() ApplicationCredentialDatasource
+	NewBannedDeviceDatasource() BannedDeviceDatasource
+	NewBannedUserDatasource() BannedUserDatasource
+	NewAnalyticsDatasource() AnalyticsDatasource
+	NewSearchSuggestionDatasource() SearchSuggestionDatasource
 	NewBusinessScheduleDatasource() BusinessScheduleDatasource
 	NewOrderLifecycleDatasource() OrderLifecycleDatasource
 	NewBusinessCategoryDatasource() BusinessCategoryDatasource
@@ -46,19 +52,22 @@ type Datasource interface {
 	NewUnionBusinessRoleAndUserDatasource() UnionBusinessRoleAndUserDatasource
 	NewPaymentMethodDatasource() PaymentMethodDatasource
 	NewBusinessPaymentMethodDatasource() BusinessPaymentMethodDatasource
+	NewOutboxDatasource() OutboxDatasource
 }
 
 type datasource struct {
-	Gorm   *gorm.DB
-	Config *config.Config
-	Minio  *minio.Client
+	Gorm    *gorm.DB
+	Config  *config.Config
+	Minio   *minio.Client
+	Keyring *keyring.Keyring
 }
 
-func New(db *gorm.DB, config *config.Config, minio *minio.Client) Datasource {
+func New(db *gorm.DB, config *config.Config, minio *minio.Client, keyring *keyring.Keyring) Datasource {
 	return &datasource{
-		Gorm:   db,
-		Config: config,
-		Minio:  minio,
+		Gorm:    db,
+		Config:  config,
+		Minio:   minio,
+		Keyring: keyring,
 	}
 }
 
@@ -90,6 +99,26 @@ func (d *datasource) NewApplicationDatasource() ApplicationDatasource {
 	return &applicationDatasource{}
 }
 
+func (d *datasource) NewApplicationCredentialDatasource() ApplicationCredentialDatasource {
+	return &applicationCredentialDatasource{}
+}
+
+func (d *datasource) NewBannedDeviceDatasource() BannedDeviceDatasource {
+	return &bannedDeviceDatasource{}
+}
+
+func (d *datasource) NewBannedUserDatasource() BannedUserDatasource {
+	return &bannedUserDatasource{}
+}
+
+func (d *datasource) NewAnalyticsDatasource() AnalyticsDatasource {
+	return &analyticsDatasource{}
+}
+
+func (d *datasource) NewSearchSuggestionDatasource() SearchSuggestionDatasource {
+	return &searchSuggestionDatasource{}
+}
+
 func (d *datasource) NewUserAddressDatasource() UserAddressDatasource {
 	return &userAddressDatasource{}
 }
@@ -112,7 +141,7 @@ func (d *datasource) NewUnionBusinessRoleAndUserDatasource() UnionBusinessRoleAn
 
 func (d *datasource) NewJwtTokenDatasource() JwtTokenDatasource {
 	return &jwtTokenDatasource{
-		Config: d.Config,
+		Keyring: d.Keyring,
 	}
 }
 
@@ -191,3 +220,7 @@ func (d *datasource) NewBusinessDatasource() BusinessDatasource {
 func (d *datasource) NewCartItemDatasource() CartItemDatasource {
 	return &cartItemDatasource{}
 }
+
+func (d *datasource) NewOutboxDatasource() OutboxDatasource {
+	return &outboxDatasource{}
+}
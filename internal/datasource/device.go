@@ -1,9 +1,9 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -12,6 +12,7 @@ type DeviceDatasource interface {
 	GetDevice(tx *gorm.DB, where *entity.Device) (*entity.Device, error)
 	CreateDevice(tx *gorm.DB, data *entity.Device) (*entity.Device, error)
 	UpdateDevice(tx *gorm.DB, where *entity.Device, data *entity.Device) (*entity.Device, error)
+	ListDeviceInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.Device, error)
 }
 
 type deviceDatasource struct{}
@@ -20,8 +21,8 @@ func (i *deviceDatasource) GetDevice(tx *gorm.DB, where *entity.Device) (*entity
 	var res *entity.Device
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -40,11 +41,20 @@ func (v *deviceDatasource) CreateDevice(tx *gorm.DB, data *entity.Device) (*enti
 func (v *deviceDatasource) UpdateDevice(tx *gorm.DB, where *entity.Device, data *entity.Device) (*entity.Device, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	}
 	return data, nil
 }
+
+func (i *deviceDatasource) ListDeviceInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.Device, error) {
+	var res []entity.Device
+	result := tx.Where("id IN ?", ids).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
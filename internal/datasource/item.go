@@ -1,10 +1,10 @@
 package datasource
 
 import (
-	"errors"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -15,11 +15,12 @@ type ItemDatasource interface {
 	ListItem(tx *gorm.DB, where *entity.ItemBusiness, cursor time.Time) (*[]entity.ItemBusiness, error)
 	ListItemInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.ItemBusiness, error)
 	CreateItem(tx *gorm.DB, data *entity.ItemBusiness) (*entity.ItemBusiness, error)
-	SearchItem(tx *gorm.DB, name string, provinceId string, municipalityId string, cursor int64, municipalityNotEqual bool, limit int64) (*[]entity.ItemBusiness, error)
-	SearchItemByBusiness(tx *gorm.DB, name string, cursor int64, businessId string) (*[]entity.ItemBusiness, error)
+	SearchItem(tx *gorm.DB, search *ItemSearch) (*[]ItemSearchResult, error)
 	UpdateItem(tx *gorm.DB, where *entity.ItemBusiness, data *entity.ItemBusiness) (*entity.ItemBusiness, error)
 	UpdateItems(tx *gorm.DB, data *[]entity.ItemBusiness) (*[]entity.ItemBusiness, error)
 	DeleteItem(tx *gorm.DB, where *entity.ItemBusiness, ids *[]uuid.UUID) (*[]entity.ItemBusiness, error)
+	RestockItem(tx *gorm.DB, id uuid.UUID, quantity int64) error
+	GetItemAvailabilityForUpdate(tx *gorm.DB, id uuid.UUID) (int64, error)
 }
 
 type itemDatasource struct{}
@@ -35,7 +36,7 @@ func (v *itemDatasource) DeleteItem(tx *gorm.DB, where *entity.ItemBusiness, ids
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -70,8 +71,8 @@ func (i *itemDatasource) GetItem(tx *gorm.DB, where *entity.ItemBusiness) (*enti
 	var res *entity.ItemBusiness
 	result := tx.Model(entity.Item{}).Select("item.id, item.name, business.name as business_name, item.description,  item.province_id, item.municipality_id, item.business_collection_id, item.business_id, item.availability, item.enabled_flag, item.available_flag, business.open_flag, item.profit_usd, item.cost_usd, item.price_usd, item.profit_cup, item.cost_cup, item.price_cup, item.high_quality_photo, item.low_quality_photo, item.thumbnail, item.blurhash, item.cursor, item.create_time, item.update_time").Joins("left join business on business.id = item.business_id").Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -106,49 +107,49 @@ func (i *itemDatasource) GetItem(tx *gorm.DB, where *entity.ItemBusiness) (*enti
 	// DeleteTime           gorm.DeletedAt `gorm:"index;column:delete_time"`
 }
 
-func (i *itemDatasource) SearchItem(tx *gorm.DB, name string, provinceId string, municipalityId string, cursor int64, municipalityNotEqual bool, limit int64) (*[]entity.ItemBusiness, error) {
-	var items []entity.ItemBusiness
-	var result *gorm.DB
-	where := "%" + name + "%"
-	if municipalityNotEqual {
-		result = tx.Limit(int(limit+1)).Where("LOWER(unaccent(item.name)) LIKE LOWER(unaccent(?)) AND municipality_id != ? AND province_id = ? AND cursor > ?", where, municipalityId, provinceId, cursor).Order("cursor asc").Find(&items)
-	} else {
-		result = tx.Limit(int(limit+1)).Where("LOWER(unaccent(item.name)) LIKE LOWER(unaccent(?)) AND municipality_id = ? AND province_id = ? AND cursor > ?", where, municipalityId, provinceId, cursor).Order("cursor asc").Find(&items)
-	}
+func (v *itemDatasource) UpdateItem(tx *gorm.DB, where *entity.ItemBusiness, data *entity.ItemBusiness) (*entity.ItemBusiness, error) {
+	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.Error != nil {
-		return nil, result.Error
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
 	}
-	return &items, nil
+	return data, nil
 }
 
-func (i *itemDatasource) SearchItemByBusiness(tx *gorm.DB, name string, cursor int64, businessId string) (*[]entity.ItemBusiness, error) {
-	var items []entity.ItemBusiness
-	var result *gorm.DB
-	where := "%" + name + "%"
-	result = tx.Limit(10).Where("LOWER(unaccent(item.name)) LIKE LOWER(unaccent(?)) AND cursor > ? AND business_id = ?", where, cursor, businessId).Order("cursor asc").Find(&items)
+// RestockItem adds the quantity to the availability of the item in the
+// database, so it doesn't overwrite a concurrent change. An exhausted item
+// starts from zero.
+func (v *itemDatasource) RestockItem(tx *gorm.DB, id uuid.UUID, quantity int64) error {
+	result := tx.Exec(`UPDATE "item" SET "availability" = GREATEST("availability", 0) + ?, "update_time" = ? WHERE "id" = ? AND "delete_time" IS NULL`, quantity, time.Now().UTC(), id)
 	if result.Error != nil {
-		return nil, result.Error
+		return result.Error
+	} else if result.RowsAffected == 0 {
+		return apperror.ErrNotFound
 	}
-	return &items, nil
+	return nil
 }
 
-func (v *itemDatasource) UpdateItem(tx *gorm.DB, where *entity.ItemBusiness, data *entity.ItemBusiness) (*entity.ItemBusiness, error) {
-	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
+// GetItemAvailabilityForUpdate returns the availability of the item and locks
+// its row until the end of the transaction.
+func (v *itemDatasource) GetItemAvailabilityForUpdate(tx *gorm.DB, id uuid.UUID) (int64, error) {
+	var availability []int64
+	result := tx.Model(&entity.Item{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Pluck("availability", &availability)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
-		} else {
-			return nil, result.Error
-		}
+		return 0, result.Error
+	} else if len(availability) == 0 {
+		return 0, apperror.ErrNotFound
 	}
-	return data, nil
+	return availability[0], nil
 }
 
 func (v *itemDatasource) UpdateItems(tx *gorm.DB, data *[]entity.ItemBusiness) (*[]entity.ItemBusiness, error) {
 	result := tx.Clauses(clause.Returning{}).Updates(data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -0,0 +1,92 @@
+package datasource
+
+import (
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+	"gorm.io/gorm"
+)
+
+// The item search runs on the item_search text search configuration, the
+// simple one without accents, and on the trigrams of the item names for the
+// typos. `./main migrate` creates both and their indexes.
+const (
+	// itemSearchMatch matches the name and description of the item, the
+	// indexed document, its business and collection names, or a name close
+	// to the term.
+	itemSearchMatch = `(to_tsvector('item_search', item.name || ' ' || coalesce(item.description, '')) @@ search_query.tsquery
+		OR to_tsvector('item_search', business.name || ' ' || business_collection.name) @@ search_query.tsquery
+		OR search_query.term <% item_search_unaccent(lower(item.name)))`
+	// itemSearchScore weighs the name over the business and collection names
+	// over the description, plus the similarity of the name to the term. It
+	// is an integer so the cursors compare it exactly.
+	itemSearchScore = `round((ts_rank(setweight(to_tsvector('item_search', item.name), 'A')
+		|| setweight(to_tsvector('item_search', business.name || ' ' || business_collection.name), 'B')
+		|| setweight(to_tsvector('item_search', coalesce(item.description, '')), 'C'), search_query.tsquery)
+		+ word_similarity(search_query.term, item_search_unaccent(lower(item.name)))) * 1000000)::bigint AS score`
+	// itemSearchAvailability puts the items that can be ordered first.
+	itemSearchAvailability = "CASE WHEN item.available_flag AND item.availability > 0 AND business.open_flag THEN 0 ELSE 1 END AS availability_rank"
+	// itemSearchHeadline highlights the matched words with <b> tags.
+	itemSearchHeadline = "ts_headline('item_search', search.name, search.tsquery, 'HighlightAll=true') AS name_highlight, ts_headline('item_search', search.description, search.tsquery, 'MaxWords=20, MinWords=8, MaxFragments=2') AS description_highlight"
+)
+
+var itemSearchColumns = []string{"item.id", "item.name", "coalesce(item.description, '') AS description", "business.name AS business_name", "item.business_id", "item.price_cup", "item.thumbnail", "item.blurhash", "search_query.tsquery"}
+
+// ItemSearch filters and orders a search of items. The items of the
+// municipality, or the nearest to the location when it is set, come first
+// among the items of the same relevance.
+type ItemSearch struct {
+	Query          string
+	ProvinceId     *uuid.UUID
+	MunicipalityId *uuid.UUID
+	BusinessId     *uuid.UUID
+	Location       *ewkb.Point
+	Cursor         *SearchCursor
+	Limit          int
+}
+
+// ItemSearchResult is an item found by a search, with its name and
+// description highlighted.
+type ItemSearchResult struct {
+	ID                   *uuid.UUID `gorm:"column:id"`
+	Name                 string     `gorm:"column:name"`
+	BusinessName         string     `gorm:"column:business_name"`
+	BusinessId           *uuid.UUID `gorm:"column:business_id"`
+	PriceCup             string     `gorm:"column:price_cup"`
+	Thumbnail            string     `gorm:"column:thumbnail"`
+	BlurHash             string     `gorm:"column:blurhash"`
+	NameHighlight        string     `gorm:"column:name_highlight"`
+	DescriptionHighlight string     `gorm:"column:description_highlight"`
+	Score                int64      `gorm:"column:score"`
+	Proximity            int64      `gorm:"column:proximity"`
+	AvailabilityRank     int64      `gorm:"column:availability_rank"`
+}
+
+// Cursor returns the position of the item in the search.
+func (i *ItemSearchResult) Cursor() SearchCursor {
+	return SearchCursor{Score: i.Score, Proximity: i.Proximity, Availability: i.AvailabilityRank, Id: *i.ID}
+}
+
+func (i *itemDatasource) SearchItem(tx *gorm.DB, search *ItemSearch) (*[]ItemSearchResult, error) {
+	var res []ItemSearchResult
+	proximity, proximityArgs, err := searchProximity(search.Location, search.MunicipalityId, "item.municipality_id")
+	if err != nil {
+		return nil, err
+	}
+	query := tx.Table(entity.ItemTableName).Select(selectColumns(itemSearchColumns, []string{itemSearchScore, proximity, itemSearchAvailability}), proximityArgs...).Joins("JOIN business ON business.id = item.business_id").Joins("JOIN business_collection ON business_collection.id = item.business_collection_id").Joins(searchQueryJoin, searchTsquery(search.Query), search.Query).Where("item.delete_time IS NULL").Where(itemSearchMatch)
+	if search.ProvinceId != nil {
+		query = query.Where("item.province_id = ?", search.ProvinceId)
+	}
+	if search.BusinessId != nil {
+		query = query.Where("item.business_id = ?", search.BusinessId)
+	}
+	result := tx.Table("(?) AS search", query).Select("search.id, search.name, search.business_name, search.business_id, search.price_cup, search.thumbnail, search.blurhash, search.score, search.proximity, search.availability_rank, " + itemSearchHeadline)
+	if search.Cursor != nil {
+		result = result.Where("(-search.score, search.proximity, search.availability_rank, search.id) > (?, ?, ?, ?)", -search.Cursor.Score, search.Cursor.Proximity, search.Cursor.Availability, search.Cursor.Id)
+	}
+	err = result.Order("search.score desc, search.proximity, search.availability_rank, search.id").Limit(search.Limit).Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
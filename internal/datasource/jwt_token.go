@@ -5,62 +5,69 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/keyring"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 )
 
 type JwtTokenDatasource interface {
-	CreateJwtAccessToken(tokenMetadata *JsonWebTokenMetadata, expirationTime *time.Time) error
 	CreateJwtRefreshToken(tokenMetadata *JsonWebTokenMetadata) error
 	CreateJwtAuthorizationToken(tokenMetadata *JsonWebTokenMetadata) error
 	ParseJwtRefreshToken(tokenMetadata *JsonWebTokenMetadata) error
 	ParseJwtAuthorizationToken(tokenMetadata *JsonWebTokenMetadata) error
+	ListJwk() []keyring.JWK
 }
 
 type jwtTokenDatasource struct {
-	Config *config.Config
+	Keyring *keyring.Keyring
 }
 
-func (v *jwtTokenDatasource) CreateJwtAccessToken(tokenMetadata *JsonWebTokenMetadata, expirationTime *time.Time) error {
-	hmacSecret := []byte(v.Config.JwtSecret)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
-		// ExpiresAt: time.Now().Add(time.Hour * 8760).Unix(),
-		ExpiresAt: expirationTime.Unix(),
-		Subject:   tokenMetadata.TokenId.String(),
-	})
-	// Sign and get the complete encoded token as a string using the secret
-	tokenString, err := token.SignedString(hmacSecret)
-	if err != nil {
-		return err
-	}
-	tokenMetadata.Token = &tokenString
-	return nil
+// jwtTokenKind tells apart the kinds of tokens through the typ header and the
+// audience, so a token of one kind can't be used as another.
+type jwtTokenKind struct {
+	name     string
+	typ      string
+	audience string
 }
 
+var (
+	refreshTokenKind       = jwtTokenKind{name: "refresh", typ: "refresh+jwt", audience: "mool:refresh"}
+	authorizationTokenKind = jwtTokenKind{name: "authorization", typ: "authorization+jwt", audience: "mool:authorization"}
+)
+
 func (v *jwtTokenDatasource) CreateJwtRefreshToken(tokenMetadata *JsonWebTokenMetadata) error {
-	hmacSecret := []byte(v.Config.JwtSecret)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
-		ExpiresAt: time.Now().Add(time.Hour * 720).Unix(),
-		Subject:   tokenMetadata.TokenId.String(),
-	})
-	// Sign and get the complete encoded token as a string using the secret
-	tokenString, err := token.SignedString(hmacSecret)
-	if err != nil {
-		return err
-	}
-	tokenMetadata.Token = &tokenString
-	return nil
+	return v.createJwtToken(refreshTokenKind, tokenMetadata, time.Now().Add(time.Hour*720))
 }
 
 func (r *jwtTokenDatasource) CreateJwtAuthorizationToken(tokenMetadata *JsonWebTokenMetadata) error {
-	hmacSecret := []byte(r.Config.JwtSecret)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
-		ExpiresAt: time.Now().Add(time.Hour * 24).Unix(),
+	return r.createJwtToken(authorizationTokenKind, tokenMetadata, time.Now().Add(time.Hour*24))
+}
+
+func (r *jwtTokenDatasource) ParseJwtRefreshToken(tokenMetadata *JsonWebTokenMetadata) error {
+	return r.parseJwtToken(refreshTokenKind, tokenMetadata)
+}
+
+func (r *jwtTokenDatasource) ParseJwtAuthorizationToken(tokenMetadata *JsonWebTokenMetadata) error {
+	return r.parseJwtToken(authorizationTokenKind, tokenMetadata)
+}
+
+func (r *jwtTokenDatasource) ListJwk() []keyring.JWK {
+	return r.Keyring.JWKS()
+}
+
+// createJwtToken signs the token with the active key of the keyring.
+func (r *jwtTokenDatasource) createJwtToken(kind jwtTokenKind, tokenMetadata *JsonWebTokenMetadata, expirationTime time.Time) error {
+	key := r.Keyring.Active()
+	token := jwt.NewWithClaims(key.Method, jwt.StandardClaims{
+		Audience:  kind.audience,
+		ExpiresAt: expirationTime.Unix(),
+		IssuedAt:  time.Now().Unix(),
 		Subject:   tokenMetadata.TokenId.String(),
 	})
-	// Sign and get the complete encoded token as a string using the secret
-	tokenString, err := token.SignedString(hmacSecret)
+	token.Header["kid"] = key.Id
+	token.Header["typ"] = kind.typ
+	tokenString, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		return err
 	}
@@ -68,58 +75,64 @@ func (r *jwtTokenDatasource) CreateJwtAuthorizationToken(tokenMetadata *JsonWebT
 	return nil
 }
 
-func (r *jwtTokenDatasource) ParseJwtRefreshToken(tokenMetadata *JsonWebTokenMetadata) error {
-	hmacSecret := []byte(r.Config.JwtSecret)
-	// Parse takes the token string and a function for looking up the key. The latter is especially
-	// useful if you use multiple keys for your application.  The standard is to use 'kid' in the
-	// head of the token to identify which key to use, but the parsed token (head and claims) is provided
-	// to the callback, providing flexibility.
-	token, err := jwt.Parse(*tokenMetadata.Token, func(token *jwt.Token) (interface{}, error) {
+// parseJwtToken verifies the token with the key named by its kid header, sets
+// the id of the token and converts the parse failures into unauthenticated
+// errors named after the kind of token, e.g. "refresh token expired". The
+// HS256 tokens signed before the keyring have neither kid, typ nor audience,
+// they are verified with the legacy secret while the keyring accepts it.
+func (r *jwtTokenDatasource) parseJwtToken(kind jwtTokenKind, tokenMetadata *JsonWebTokenMetadata) error {
+	var claims jwt.StandardClaims
+	var legacy bool
+	token, err := jwt.ParseWithClaims(*tokenMetadata.Token, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" && token.Method == jwt.SigningMethodHS256 {
+			secret, ok := r.Keyring.LegacySecret(time.Now())
+			if !ok {
+				return nil, errors.New("legacy tokens are no longer accepted")
+			}
+			legacy = true
+			return secret, nil
+		}
+		key, ok := r.Keyring.Key(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
 		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			tokenErr := fmt.Sprintf("Unexpected signing method: %v", token.Header["alg"])
-			return nil, errors.New(tokenErr)
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-
-		// hmacSampleSecret is a []byte containing your secret, e.g. []byte("my_secret_key")
-		return hmacSecret, nil
+		return key.PublicKey, nil
 	})
 	if err != nil {
-		return err
-	} else if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		data := fmt.Sprintf("%s", claims["sub"])
-		tokenIdValue := uuid.MustParse(data)
-		tokenMetadata.TokenId = &tokenIdValue
-		return nil
-	} else {
-		return err
+		return jwtTokenError(kind.name, err)
+	}
+	if !token.Valid {
+		return apperror.Unauthenticated(kind.name + " token is invalid")
+	}
+	if typ, _ := token.Header["typ"].(string); !legacy && (typ != kind.typ || !claims.VerifyAudience(kind.audience, true)) {
+		return apperror.Unauthenticated(kind.name + " token is invalid")
 	}
+	tokenIdValue, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return apperror.Unauthenticated(kind.name + " token is invalid").Wrap(err)
+	}
+	tokenMetadata.TokenId = &tokenIdValue
+	return nil
 }
 
-func (r *jwtTokenDatasource) ParseJwtAuthorizationToken(tokenMetadata *JsonWebTokenMetadata) error {
-	hmacSecret := []byte(r.Config.JwtSecret)
-	// Parse takes the token string and a function for looking up the key. The latter is especially
-	// useful if you use multiple keys for your application.  The standard is to use 'kid' in the
-	// head of the token to identify which key to use, but the parsed token (head and claims) is provided
-	// to the callback, providing flexibility.
-	token, err := jwt.Parse(*tokenMetadata.Token, func(token *jwt.Token) (interface{}, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			tokenErr := fmt.Sprintf("Unexpected signing method: %v", token.Header["alg"])
-			return nil, errors.New(tokenErr)
-		}
-
-		// hmacSampleSecret is a []byte containing your secret, e.g. []byte("my_secret_key")
-		return hmacSecret, nil
-	})
-	if err != nil {
-		return err
-	} else if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		data := fmt.Sprintf("%s", claims["sub"])
-		tokenIdValue := uuid.MustParse(data)
-		tokenMetadata.TokenId = &tokenIdValue
-		return nil
-	} else {
-		return err
+func jwtTokenError(kind string, err error) error {
+	var validationErr *jwt.ValidationError
+	if !errors.As(err, &validationErr) {
+		return apperror.Unauthenticated(kind + " token is invalid").Wrap(err)
+	}
+	switch {
+	case validationErr.Errors&jwt.ValidationErrorExpired != 0:
+		return apperror.Unauthenticated(kind + " token expired").Wrap(err)
+	case validationErr.Errors&jwt.ValidationErrorSignatureInvalid != 0:
+		return apperror.Unauthenticated(kind + " token signature is invalid").Wrap(err)
+	case validationErr.Errors&jwt.ValidationErrorMalformed != 0:
+		return apperror.Unauthenticated(kind + " token contains an invalid number of segments").Wrap(err)
+	default:
+		return apperror.Unauthenticated(kind + " token is invalid").Wrap(err)
 	}
 }
@@ -0,0 +1,83 @@
+package datasource
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/daniarmas/api_go/pkg/keyring"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+func newTestJwtTokenDatasource(t *testing.T, activeId string, keys ...*keyring.Key) *jwtTokenDatasource {
+	keys = append(keys, newTestKey(t, activeId))
+	ring, err := keyring.NewKeyring(activeId, keys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &jwtTokenDatasource{Keyring: ring}
+}
+
+func newTestKey(t *testing.T, id string) *keyring.Key {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &keyring.Key{Id: id, Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey, PublicKey: publicKey}
+}
+
+func TestJwtTokenKinds(t *testing.T) {
+	keys := newTestJwtTokenDatasource(t, "active")
+	tokenId := uuid.New()
+	refreshToken := &JsonWebTokenMetadata{TokenId: &tokenId}
+	if err := keys.CreateJwtRefreshToken(refreshToken); err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.ParseJwtAuthorizationToken(&JsonWebTokenMetadata{Token: refreshToken.Token}); err == nil {
+		t.Fatal("expected a refresh token not to be accepted as authorization token")
+	}
+	parsed := &JsonWebTokenMetadata{Token: refreshToken.Token}
+	if err := keys.ParseJwtRefreshToken(parsed); err != nil || *parsed.TokenId != tokenId {
+		t.Fatalf("ParseJwtRefreshToken() = %v, %v", parsed.TokenId, err)
+	}
+}
+
+func TestJwtTokenRotation(t *testing.T) {
+	retired := newTestJwtTokenDatasource(t, "retired")
+	tokenId := uuid.New()
+	token := &JsonWebTokenMetadata{TokenId: &tokenId}
+	if err := retired.CreateJwtAuthorizationToken(token); err != nil {
+		t.Fatal(err)
+	}
+	retiredKey := retired.Keyring.Active()
+	rotated := newTestJwtTokenDatasource(t, "active", &keyring.Key{Id: retiredKey.Id, Method: retiredKey.Method, PublicKey: retiredKey.PublicKey})
+	if err := rotated.ParseJwtAuthorizationToken(&JsonWebTokenMetadata{Token: token.Token}); err != nil {
+		t.Fatalf("expected the retired key to verify its tokens, got %v", err)
+	}
+	if err := newTestJwtTokenDatasource(t, "other").ParseJwtAuthorizationToken(&JsonWebTokenMetadata{Token: token.Token}); err == nil {
+		t.Fatal("expected an error for an unknown key")
+	}
+}
+
+func TestJwtTokenLegacy(t *testing.T) {
+	keys := newTestJwtTokenDatasource(t, "active")
+	tokenId := uuid.New()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour).Unix(), Subject: tokenId.String()}).SignedString([]byte("legacy"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.ParseJwtAuthorizationToken(&JsonWebTokenMetadata{Token: &token}); err == nil {
+		t.Fatal("expected a legacy token not to be accepted without the legacy secret")
+	}
+	keys.Keyring.SetLegacySecret([]byte("legacy"), time.Now().Add(time.Hour))
+	parsed := &JsonWebTokenMetadata{Token: &token}
+	if err := keys.ParseJwtAuthorizationToken(parsed); err != nil || *parsed.TokenId != tokenId {
+		t.Fatalf("ParseJwtAuthorizationToken() = %v, %v", parsed.TokenId, err)
+	}
+	keys.Keyring.SetLegacySecret([]byte("legacy"), time.Now().Add(-time.Minute))
+	if err := keys.ParseJwtAuthorizationToken(&JsonWebTokenMetadata{Token: &token}); err == nil {
+		t.Fatal("expected a legacy token not to be accepted after the transition")
+	}
+}
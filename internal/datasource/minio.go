@@ -2,12 +2,19 @@ package datasource
 
 import (
 	"context"
-	"errors"
 	"time"
 
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/minio/minio-go/v7"
 )
 
+var (
+	ErrPathInsufficientPermission = apperror.PermissionDenied("PathInsufficientPermission")
+	ErrBucketDoesNotExist         = apperror.NotFound("BucketDoesNotExist")
+	ErrBucketInvalid              = apperror.InvalidArgument("BucketInvalid")
+	ErrObjectMissing              = apperror.NotFound("ObjectMissing")
+)
+
 type ObjectStorageDatasource interface {
 	BucketExists(ctx context.Context, bucketName string) (*bool, error)
 	ObjectExists(ctx context.Context, bucketName string, objectName string) (*bool, error)
@@ -52,16 +59,16 @@ func (m *objectStorageDatasource) ObjectExists(ctx context.Context, bucketName s
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "AccessDenied" {
-			return nil, errors.New("PathInsufficientPermission")
+			return nil, ErrPathInsufficientPermission
 		}
 		if errResponse.Code == "NoSuchBucket" {
-			return nil, errors.New("BucketDoesNotExist")
+			return nil, ErrBucketDoesNotExist
 		}
 		if errResponse.Code == "InvalidBucketName" {
-			return nil, errors.New("BucketInvalid")
+			return nil, ErrBucketInvalid
 		}
 		if errResponse.Code == "NoSuchKey" {
-			return nil, errors.New("ObjectMissing")
+			return nil, ErrObjectMissing
 		}
 		return nil, err
 	}
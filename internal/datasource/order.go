@@ -1,11 +1,10 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"github.com/teris-io/shortid"
 	"gorm.io/gorm"
@@ -19,10 +18,57 @@ type OrderDatasource interface {
 	UpdateOrder(tx *gorm.DB, where *entity.Order, data *entity.Order) (*entity.Order, error)
 	GetOrder(tx *gorm.DB, where *entity.Order) (*entity.Order, error)
 	ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*bool, error)
+	ListExpiredOrder(tx *gorm.DB, now time.Time, limit int) (*[]entity.Order, error)
+	ListBusinessOrder(tx *gorm.DB, filter *BusinessOrderFilter) (*[]entity.Order, error)
+}
+
+// BusinessOrderFilter selects the orders of a business after Cursor, the
+// newest first. The empty fields don't filter.
+type BusinessOrderFilter struct {
+	BusinessId *uuid.UUID
+	Statuses   []string
+	OrderType  string
+	StartTime  *time.Time
+	EndTime    *time.Time
+	Cursor     *BusinessOrderCursor
+	Limit      int
+}
+
+// BusinessOrderCursor is the position of an order in the list of the orders
+// of its business, the id orders the ones created at the same time.
+type BusinessOrderCursor struct {
+	CreateTime time.Time `json:"t"`
+	Id         uuid.UUID `json:"i"`
 }
 
 type orderDatasource struct{}
 
+func (i *orderDatasource) ListBusinessOrder(tx *gorm.DB, filter *BusinessOrderFilter) (*[]entity.Order, error) {
+	var res []entity.Order
+	selectFields := &[]string{"id", "delivery_price_cup", "business_thumbnail", "status", "business_name", "short_id", "cancel_reasons", "items_quantity", "order_type", "price_cup", "number", "address", "instructions", "phone", "payment_method_type", "business_id", "authorization_token_id", "user_id", "start_order_time", "end_order_time", "create_time", "update_time", "delete_time", "ST_AsEWKB(coordinates) AS coordinates"}
+	query := tx.Limit(filter.Limit).Select(*selectFields).Where("business_id = ?", filter.BusinessId)
+	if filter.Cursor != nil {
+		query = query.Where("(create_time, id) < (?, ?)", filter.Cursor.CreateTime, filter.Cursor.Id)
+	}
+	if len(filter.Statuses) != 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.OrderType != "" {
+		query = query.Where("order_type = ?", filter.OrderType)
+	}
+	if filter.StartTime != nil {
+		query = query.Where("create_time >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		query = query.Where("create_time < ?", *filter.EndTime)
+	}
+	result := query.Order("create_time desc, id desc").Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
 func (i *orderDatasource) ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*bool, error) {
 	var res []entity.OrderBusiness
 	var ret bool
@@ -31,7 +77,7 @@ func (i *orderDatasource) ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*
 		return nil, result.Error
 	}
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
+		if apperror.IsNotFound(result.Error) {
 			return &ret, nil
 		} else {
 			return nil, result.Error
@@ -45,14 +91,25 @@ func (i *orderDatasource) ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*
 	}
 }
 
+// ListExpiredOrder locks the pending orders whose order window ended before now.
+// Rows locked by another transaction are skipped.
+func (i *orderDatasource) ListExpiredOrder(tx *gorm.DB, now time.Time, limit int) (*[]entity.Order, error) {
+	var res []entity.Order
+	result := tx.Raw(`SELECT "id", "short_id", "business_name", "items_quantity", "status", "order_type", "business_id", "user_id", "authorization_token_id", "start_order_time", "end_order_time", "create_time", "update_time" FROM "order" WHERE (status = 'OrderStatusTypePendingPayment' OR status = 'OrderStatusTypeOrdered') AND "end_order_time" < ? AND "delete_time" IS NULL ORDER BY "end_order_time" LIMIT ? FOR UPDATE SKIP LOCKED`, now, limit).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
 func (i *orderDatasource) GetOrder(tx *gorm.DB, where *entity.Order) (*entity.Order, error) {
 	var res entity.Order
 	result := tx.Raw(`SELECT "id", "delivery_price_cup", "short_id", "business_name", "business_thumbnail", "items_quantity", "status", "order_type", "price_cup", "number", "address", "business_id", ST_AsEWKB(coordinates) AS coordinates, "user_id", "authorization_token_id", "start_order_time", "end_order_time", "create_time", "update_time", "instructions", "cancel_reasons" FROM "order" WHERE id = ? LIMIT 1`, where.ID).Scan(&res)
 	if res.ID == nil {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	} else if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -63,12 +120,19 @@ func (i *orderDatasource) GetOrder(tx *gorm.DB, where *entity.Order) (*entity.Or
 func (i *orderDatasource) UpdateOrder(tx *gorm.DB, where *entity.Order, data *entity.Order) (*entity.Order, error) {
 	var res entity.Order
 	var time = time.Now().UTC()
-	result := tx.Raw(`UPDATE "order" SET "status"=?,"update_time"=?,"cancel_reasons"=? WHERE "order"."id" = ? AND "order"."delete_time" IS NULL RETURNING "id", "short_id", "items_quantity", "status", "order_type", "price_cup", "number", "address", "business_id", ST_AsEWKB(coordinates) AS coordinates, "user_id", "authorization_token_id", "start_order_time", "end_order_time", "create_time", "update_time", "instructions", "cancel_reasons", "business_name"`, data.Status, time, data.CancelReasons, where.ID).Scan(&res)
+	// The status of the where, when set, guards the update against a
+	// concurrent change of the status.
+	query, args := `UPDATE "order" SET "status"=?,"update_time"=?,"cancel_reasons"=? WHERE "order"."id" = ? AND "order"."delete_time" IS NULL`, []interface{}{data.Status, time, data.CancelReasons, where.ID}
+	if where.Status != "" {
+		query += ` AND "order"."status" = ?`
+		args = append(args, where.Status)
+	}
+	result := tx.Raw(query+` RETURNING "id", "short_id", "items_quantity", "status", "order_type", "price_cup", "number", "address", "business_id", ST_AsEWKB(coordinates) AS coordinates, "user_id", "authorization_token_id", "start_order_time", "end_order_time", "create_time", "update_time", "instructions", "cancel_reasons", "business_name"`, args...).Scan(&res)
 	if res.ID == nil {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	} else if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -78,8 +142,12 @@ func (i *orderDatasource) UpdateOrder(tx *gorm.DB, where *entity.Order, data *en
 
 func (i *orderDatasource) CreateOrder(tx *gorm.DB, data *entity.Order) (*entity.Order, error) {
 	var point string
+	var err error
 	if data.Coordinates.Point != nil {
-		point = fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+		point, err = pointWKT(data.Coordinates)
+		if err != nil {
+			return nil, err
+		}
 	}
 	var time = time.Now().UTC()
 	shortId, err := shortid.Generate()
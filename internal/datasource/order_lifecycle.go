@@ -7,6 +7,7 @@ import (
 
 type OrderLifecycleDatasource interface {
 	CreateOrderLifecycle(tx *gorm.DB, data *entity.OrderLifecycle) (*entity.OrderLifecycle, error)
+	ListOrderLifecycle(tx *gorm.DB, where *entity.OrderLifecycle) (*[]entity.OrderLifecycle, error)
 }
 
 type orderLifecycleDatasource struct{}
@@ -18,3 +19,12 @@ func (i *orderLifecycleDatasource) CreateOrderLifecycle(tx *gorm.DB, data *entit
 	}
 	return data, nil
 }
+
+func (i *orderLifecycleDatasource) ListOrderLifecycle(tx *gorm.DB, where *entity.OrderLifecycle) (*[]entity.OrderLifecycle, error) {
+	var res []entity.OrderLifecycle
+	result := tx.Select("id", "status", "order_id", "create_time", "update_time").Where("order_id = ?", where.OrderId).Order("create_time asc").Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
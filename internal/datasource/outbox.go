@@ -0,0 +1,88 @@
+package datasource
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxDatasource interface {
+	CreateOutbox(tx *gorm.DB, data *entity.Outbox) (*entity.Outbox, error)
+	GetOutbox(tx *gorm.DB, where *entity.Outbox) (*entity.Outbox, error)
+	ListOutbox(tx *gorm.DB, where *entity.Outbox, limit int) (*[]entity.Outbox, error)
+	ClaimOutbox(tx *gorm.DB, now time.Time, leaseTime time.Time, limit int) (*[]entity.Outbox, error)
+	UpdateOutbox(tx *gorm.DB, where *entity.Outbox, data *entity.Outbox) (*entity.Outbox, error)
+	ReplayOutbox(tx *gorm.DB, where *entity.Outbox, now time.Time) (int64, error)
+}
+
+type outboxDatasource struct{}
+
+func (i *outboxDatasource) CreateOutbox(tx *gorm.DB, data *entity.Outbox) (*entity.Outbox, error) {
+	result := tx.Create(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return data, nil
+}
+
+func (i *outboxDatasource) GetOutbox(tx *gorm.DB, where *entity.Outbox) (*entity.Outbox, error) {
+	var res *entity.Outbox
+	result := tx.Where(where).Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (i *outboxDatasource) ListOutbox(tx *gorm.DB, where *entity.Outbox, limit int) (*[]entity.Outbox, error) {
+	var res []entity.Outbox
+	result := tx.Where(where).Order("create_time desc").Limit(limit).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+// ClaimOutbox leases the pending messages that are due until leaseTime, by
+// moving their next attempt to it, so they are delivered out of any
+// transaction. The rows locked by another dispatcher are skipped, and the
+// messages of a dispatcher that died are due again once the lease passes.
+func (i *outboxDatasource) ClaimOutbox(tx *gorm.DB, now time.Time, leaseTime time.Time, limit int) (*[]entity.Outbox, error) {
+	var res []entity.Outbox
+	result := tx.Raw(`UPDATE "outbox" SET "next_attempt_time" = ?, "update_time" = ? WHERE "id" IN (
+		SELECT "id" FROM "outbox" WHERE "status" = ? AND "next_attempt_time" <= ? AND "delete_time" IS NULL ORDER BY "next_attempt_time" LIMIT ? FOR UPDATE SKIP LOCKED
+	) RETURNING *`, leaseTime, now, entity.OutboxStatusPending, now, limit).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
+// UpdateOutbox writes the delivery state of the message, zero values included.
+func (i *outboxDatasource) UpdateOutbox(tx *gorm.DB, where *entity.Outbox, data *entity.Outbox) (*entity.Outbox, error) {
+	result := tx.Model(data).Clauses(clause.Returning{}).Where(where).Select("status", "attempts", "last_error", "next_attempt_time", "deliver_time", "update_time").Updates(data)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return data, nil
+}
+
+// ReplayOutbox queues again the dead messages and the pending ones that
+// failed, with their attempts reset, and returns how many it queued. The
+// delivered messages are never sent again.
+func (i *outboxDatasource) ReplayOutbox(tx *gorm.DB, where *entity.Outbox, now time.Time) (int64, error) {
+	result := tx.Model(&entity.Outbox{}).Where(where).Where("status = ? OR (status = ? AND attempts > 0)", entity.OutboxStatusDead, entity.OutboxStatusPending).Updates(map[string]interface{}{"status": entity.OutboxStatusPending, "attempts": 0, "next_attempt_time": now, "update_time": now})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -1,11 +1,10 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -32,7 +31,7 @@ func (r *partnerApplicationDatasource) DeletePartnerApplication(tx *gorm.DB, whe
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -41,13 +40,13 @@ func (i *partnerApplicationDatasource) GetPartnerApplication(tx *gorm.DB, where
 	var res entity.PartnerApplication
 	result := tx.Raw(`SELECT "id", "business_name", "description", "status", ST_AsEWKB(coordinates) AS coordinates, "user_id", "province_id", "municipality_id", "create_time", "update_time" FROM "partner_application" WHERE id = ? LIMIT 1`, where.ID).Scan(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return &res, nil
 }
@@ -57,8 +56,8 @@ func (i *partnerApplicationDatasource) UpdatePartnerApplication(tx *gorm.DB, whe
 	var time = time.Now().UTC()
 	result := tx.Raw(`UPDATE "partner_application" SET "status"=?,"update_time"=? WHERE "partner_application"."id" = ? AND "partner_application"."delete_time" IS NULL RETURNING "id", "business_name", "description", "status", ST_AsEWKB(coordinates) AS coordinates, "province_id", "municipality_id", "user_id", "create_time", "update_time"`, data.Status, time, where.ID).Scan(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -67,7 +66,10 @@ func (i *partnerApplicationDatasource) UpdatePartnerApplication(tx *gorm.DB, whe
 }
 
 func (i *partnerApplicationDatasource) CreatePartnerApplication(tx *gorm.DB, data *entity.PartnerApplication) (*entity.PartnerApplication, error) {
-	point := fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+	point, err := pointWKT(data.Coordinates)
+	if err != nil {
+		return nil, err
+	}
 	var time = time.Now().UTC()
 	var res entity.PartnerApplication
 	result := tx.Raw(`INSERT INTO "partner_application" ("user_id", "business_name", "description", "coordinates", "province_id", "municipality_id", "create_time", "update_time") VALUES (?, ?, ?, ST_GeomFromText(?, 4326), ?, ?, ?, ?) RETURNING "id", "business_name", "description", "status", ST_AsEWKB(coordinates) AS coordinates, "province_id", "municipality_id", "user_id", "create_time", "update_time"`, data.UserId, data.BusinessName, data.Description, point, data.ProvinceId, data.MunicipalityId, time, time).Scan(&res)
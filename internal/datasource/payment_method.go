@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -39,10 +38,10 @@ func (i *paymentMethodDatasource) CreatePaymentMethod(tx *gorm.DB, data *entity.
 func (i *paymentMethodDatasource) UpdatePaymentMethod(tx *gorm.DB, where *entity.PaymentMethod, data *entity.PaymentMethod) (*entity.PaymentMethod, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	} else if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -54,8 +53,8 @@ func (i *paymentMethodDatasource) GetPaymentMethod(tx *gorm.DB, where *entity.Pa
 	var res *entity.PaymentMethod
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -74,7 +73,7 @@ func (v *paymentMethodDatasource) DeletePaymentMethod(tx *gorm.DB, where *entity
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
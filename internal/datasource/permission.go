@@ -1,10 +1,10 @@
 package datasource
 
 import (
-	"errors"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -60,8 +60,8 @@ func (i *permissionDatasource) GetPermission(tx *gorm.DB, where *entity.Permissi
 	var res *entity.Permission
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -80,7 +80,7 @@ func (i *permissionDatasource) DeletePermission(tx *gorm.DB, where *entity.Permi
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -0,0 +1,48 @@
+package datasource
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+)
+
+// stPoint is the geometry of a point bound as a WKT parameter.
+const stPoint = "ST_GeomFromText(?, 4326)"
+
+// stGeoJSON is the MultiPolygon of a polygon or multipolygon bound as a
+// GeoJSON parameter.
+const stGeoJSON = "ST_SetSRID(ST_Multi(ST_GeomFromGeoJSON(?)), 4326)"
+
+var errInvalidCoordinates = apperror.InvalidArgument("invalid coordinates")
+
+// pointWKT validates the coordinates of the point and returns its WKT, to be
+// bound to stPoint. The points of the api keep the latitude first while WKT
+// expects the longitude first.
+func pointWKT(point ewkb.Point) (string, error) {
+	if point.Point == nil || len(point.Point.Coords()) < 2 {
+		return "", errInvalidCoordinates
+	}
+	latitude, longitude := point.Point.Coords()[0], point.Point.Coords()[1]
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 || math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return "", errInvalidCoordinates.WithMetadata("latitude", fmt.Sprint(latitude)).WithMetadata("longitude", fmt.Sprint(longitude))
+	}
+	return fmt.Sprintf("POINT(%v %v)", longitude, latitude), nil
+}
+
+// stDistance selects the distance from the geometry column to a point bound
+// as a WKT parameter.
+func stDistance(column string) string {
+	return fmt.Sprintf("ST_Distance(%s, %s) AS distance", column, stPoint)
+}
+
+// selectColumns joins column lists into a single SELECT expression.
+func selectColumns(columns ...[]string) string {
+	var res []string
+	for _, v := range columns {
+		res = append(res, v...)
+	}
+	return strings.Join(res, ", ")
+}
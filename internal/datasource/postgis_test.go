@@ -0,0 +1,23 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+)
+
+func TestPointWKT(t *testing.T) {
+	point := func(latitude, longitude float64) ewkb.Point {
+		return ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{latitude, longitude}).SetSRID(4326)}
+	}
+	got, err := pointWKT(point(23.1136, -82.3666))
+	if err != nil || got != "POINT(-82.3666 23.1136)" {
+		t.Fatalf("pointWKT() = %q, %v", got, err)
+	}
+	for _, p := range []ewkb.Point{{}, point(91, 0), point(0, -181)} {
+		if _, err := pointWKT(p); err == nil {
+			t.Errorf("expected an error for %v", p.Point)
+		}
+	}
+}
@@ -1,10 +1,8 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/twpayne/go-geom/encoding/ewkb"
 	"gorm.io/gorm"
 )
@@ -18,11 +16,14 @@ type provinceDatasource struct{}
 
 func (i *provinceDatasource) ProvinceByCoordinate(tx *gorm.DB, coordinate ewkb.Point) (*entity.Province, error) {
 	var provinceResult *entity.Province
-	p := fmt.Sprintf("'POINT(%v %v)'", coordinate.Point.Coords()[1], coordinate.Point.Coords()[0])
-	result := tx.Where("ST_Contains(province.polygon, ST_GeomFromText(%s, 4326)) as is_contained", p).Take(&provinceResult)
+	p, err := pointWKT(coordinate)
+	if err != nil {
+		return nil, err
+	}
+	result := tx.Where("ST_Contains(province.polygon, "+stPoint+")", p).Take(&provinceResult)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -34,8 +35,8 @@ func (i *provinceDatasource) GetProvince(tx *gorm.DB, where *entity.Province) (*
 	var res *entity.Province
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
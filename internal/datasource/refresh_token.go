@@ -1,9 +1,10 @@
 package datasource
 
 import (
-	"errors"
+	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -11,6 +12,9 @@ import (
 
 type RefreshTokenDatasource interface {
 	GetRefreshToken(tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error)
+	GetRefreshTokenForUpdate(tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error)
+	UpdateRefreshToken(tx *gorm.DB, where *entity.RefreshToken, data *entity.RefreshToken) (*entity.RefreshToken, error)
+	DeleteRefreshTokenFamily(tx *gorm.DB, familyId *uuid.UUID) (*[]entity.RefreshToken, error)
 	CreateRefreshToken(tx *gorm.DB, data *entity.RefreshToken) (*entity.RefreshToken, error)
 	DeleteRefreshToken(tx *gorm.DB, where *entity.RefreshToken, ids *[]uuid.UUID) (*[]entity.RefreshToken, error)
 	DeleteRefreshTokenDeviceIdNotEqual(tx *gorm.DB, where *entity.RefreshToken, ids *[]uuid.UUID) (*[]entity.RefreshToken, error)
@@ -37,7 +41,7 @@ func (r *refreshTokenDatasource) DeleteRefreshToken(tx *gorm.DB, where *entity.R
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -53,7 +57,7 @@ func (r *refreshTokenDatasource) DeleteRefreshTokenDeviceIdNotEqual(tx *gorm.DB,
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -62,11 +66,50 @@ func (v *refreshTokenDatasource) GetRefreshToken(tx *gorm.DB, where *entity.Refr
 	var res *entity.RefreshToken
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("refresh token not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.Unauthenticated("refresh token not found")
 		} else {
 			return nil, result.Error
 		}
 	}
 	return res, nil
 }
+
+// GetRefreshTokenForUpdate locks the token until the transaction ends, so
+// concurrent refreshes of the same token run one after the other.
+func (v *refreshTokenDatasource) GetRefreshTokenForUpdate(tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error) {
+	var res *entity.RefreshToken
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(where).Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (v *refreshTokenDatasource) UpdateRefreshToken(tx *gorm.DB, where *entity.RefreshToken, data *entity.RefreshToken) (*entity.RefreshToken, error) {
+	data.UpdateTime = time.Now().UTC()
+	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return data, nil
+}
+
+// DeleteRefreshTokenFamily deletes the tokens of the family, including the
+// first one, whose family_id is empty when it was issued before the families.
+func (r *refreshTokenDatasource) DeleteRefreshTokenFamily(tx *gorm.DB, familyId *uuid.UUID) (*[]entity.RefreshToken, error) {
+	var res *[]entity.RefreshToken
+	result := tx.Clauses(clause.Returning{}).Where(`family_id = ? OR id = ?`, familyId, familyId).Delete(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package datasource
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+)
+
+// searchQueryJoin binds the query of a search once for the whole statement,
+// as a prefix tsquery and as an unaccented term for the trigrams.
+const searchQueryJoin = "CROSS JOIN (SELECT to_tsquery('item_search', ?) AS tsquery, item_search_unaccent(lower(?)) AS term) AS search_query"
+
+// SearchCursor is the position of a result in the order of a search: score
+// desc, proximity, availability rank and id. The availability ranks the items
+// that can be ordered, or the open businesses, first.
+type SearchCursor struct {
+	Score        int64     `json:"s"`
+	Proximity    int64     `json:"p"`
+	Availability int64     `json:"a"`
+	Id           uuid.UUID `json:"i"`
+}
+
+// searchProximity selects the distance in meters from the business to the
+// location, or whether the municipality column is another municipality.
+func searchProximity(location *ewkb.Point, municipalityId *uuid.UUID, municipalityColumn string) (string, []interface{}, error) {
+	if location != nil {
+		point, err := pointWKT(*location)
+		if err != nil {
+			return "", nil, err
+		}
+		return "ST_Distance(business.coordinates::geography, " + stPoint + "::geography)::bigint AS proximity", []interface{}{point}, nil
+	}
+	if municipalityId != nil {
+		return "CASE WHEN " + municipalityColumn + " = ? THEN 0 ELSE 1 END AS proximity", []interface{}{municipalityId}, nil
+	}
+	return "0::bigint AS proximity", nil, nil
+}
+
+// searchTsquery turns the words of the query into a tsquery that matches the
+// words starting with every one of them, so the results show up while the
+// last word is typed. Everything but letters and digits is dropped, the
+// tsquery syntax included.
+func searchTsquery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for index, word := range words {
+		words[index] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
@@ -0,0 +1,25 @@
+package datasource
+
+import (
+	"github.com/daniarmas/api_go/internal/entity"
+	"gorm.io/gorm"
+)
+
+type SearchSuggestionDatasource interface {
+	ListSearchSuggestion(tx *gorm.DB) (*[]entity.SearchSuggestion, error)
+}
+
+type searchSuggestionDatasource struct{}
+
+// ListSearchSuggestion returns the names of the enabled items, the businesses
+// and the categories, to rebuild the suggestions index.
+func (i *searchSuggestionDatasource) ListSearchSuggestion(tx *gorm.DB) (*[]entity.SearchSuggestion, error) {
+	var res []entity.SearchSuggestion
+	result := tx.Raw(`SELECT ? AS type, id, name AS text, province_id, municipality_id FROM item WHERE delete_time IS NULL AND enabled_flag
+		UNION ALL SELECT ?, id, name, province_id, municipality_id FROM business WHERE delete_time IS NULL
+		UNION ALL SELECT ?, id, name, province_id, municipality_id FROM business_category WHERE delete_time IS NULL`, entity.SearchSuggestionTypeItem, entity.SearchSuggestionTypeBusiness, entity.SearchSuggestionTypeCategory).Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
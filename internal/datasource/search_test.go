@@ -0,0 +1,17 @@
+package datasource
+
+import "testing"
+
+func TestSearchTsquery(t *testing.T) {
+	tests := map[string]string{
+		"pizza":               "pizza:*",
+		"  Café con leche ":   "Café:* & con:* & leche:*",
+		"pollo & !frito | (a": "pollo:* & frito:* & a:*",
+		"':* <->":             "",
+	}
+	for query, want := range tests {
+		if got := searchTsquery(query); got != want {
+			t.Errorf("searchTsquery(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
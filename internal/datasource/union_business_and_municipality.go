@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"gorm.io/gorm"
 )
 
@@ -19,8 +18,8 @@ func (v *unionBusinessAndMunicipalityDatasource) GetUnionBusinessAndMunicipality
 	var res *entity.UnionBusinessAndMunicipality
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("refreshtoken not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -1,10 +1,8 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -24,14 +22,13 @@ func (u *userDatasource) GetUserWithAddress(tx *gorm.DB, where *entity.User) (*e
 	var userAddressErr error
 	result := tx.Preload("UserPermissions").Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
+		if apperror.IsNotFound(result.Error) {
 			return res, nil
 		} else {
 			return nil, result.Error
 		}
 	}
-	query := fmt.Sprintf("SELECT id, selected, name, address, instructions, number, user_id, province_id, municipality_id, create_time, update_time, ST_AsEWKB(coordinates) AS coordinates FROM user_address WHERE user_address.user_id = '%v' AND user_address.delete_time IS NULL;", res.ID)
-	userAddressErr = tx.Raw(query).Scan(&userAddressResult).Error
+	userAddressErr = tx.Raw("SELECT id, selected, name, address, instructions, number, user_id, province_id, municipality_id, create_time, update_time, ST_AsEWKB(coordinates) AS coordinates FROM user_address WHERE user_address.user_id = ? AND user_address.delete_time IS NULL;", res.ID).Scan(&userAddressResult).Error
 	if userAddressErr != nil {
 		return nil, userAddressErr
 	}
@@ -43,8 +40,8 @@ func (u *userDatasource) GetUser(tx *gorm.DB, where *entity.User) (*entity.User,
 	var res *entity.User
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -55,16 +52,16 @@ func (u *userDatasource) GetUser(tx *gorm.DB, where *entity.User) (*entity.User,
 func (u *userDatasource) CreateUser(tx *gorm.DB, data *entity.User) (*entity.User, error) {
 	var existUser *entity.User
 	existResult := tx.Where("email = ?", data.Email).Select("id").Take(&existUser)
-	if existResult.Error != nil && existResult.Error.Error() != "record not found" {
+	if existResult.Error != nil && !apperror.IsNotFound(existResult.Error) {
 		return nil, existResult.Error
 	}
-	if existResult.Error.Error() == "record not found" {
+	if apperror.IsNotFound(existResult.Error) {
 		result := tx.Create(&data)
 		if result.Error != nil {
 			return nil, result.Error
 		}
 	} else {
-		return nil, errors.New("record exists")
+		return nil, apperror.AlreadyExists("record exists")
 	}
 	return data, nil
 }
@@ -72,8 +69,8 @@ func (u *userDatasource) CreateUser(tx *gorm.DB, data *entity.User) (*entity.Use
 func (v *userDatasource) UpdateUser(tx *gorm.DB, where *entity.User, data *entity.User) (*entity.User, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
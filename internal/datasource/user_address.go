@@ -1,11 +1,10 @@
 package datasource
 
 import (
-	"errors"
-	"fmt"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -34,7 +33,10 @@ func (i *userAddressDatasource) ListUserAddress(tx *gorm.DB, where *entity.UserA
 }
 
 func (i *userAddressDatasource) CreateUserAddress(tx *gorm.DB, data *entity.UserAddress) (*entity.UserAddress, error) {
-	point := fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+	point, err := pointWKT(data.Coordinates)
+	if err != nil {
+		return nil, err
+	}
 	var time = time.Now().UTC()
 	var res entity.UserAddress
 	result := tx.Raw(`INSERT INTO "user_address" ("name", "selected", "address", "number", "coordinates", "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time") VALUES (?, ?, ?, ?, ST_GeomFromText(?, 4326), ?, ?, ?, ?, ?, ?) RETURNING "id", "selected", "name", "address", "number", ST_AsEWKB(coordinates) AS coordinates, "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time"`, data.Name, data.Selected, data.Address, data.Number, point, data.Instructions, data.UserId, data.ProvinceId, data.MunicipalityId, time, time).Scan(&res)
@@ -49,13 +51,13 @@ func (i *userAddressDatasource) UpdateUserAddressSelected(tx *gorm.DB, where *en
 	var time = time.Now().UTC()
 	result := tx.Raw(`UPDATE "user_address" SET "selected"='true',"update_time"=? WHERE "user_address"."id" = ? AND "user_address"."delete_time" IS NULL RETURNING "id", "name", "selected", "address", "number", ST_AsEWKB(coordinates) AS coordinates, "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time"`, time, where.ID).Scan(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return &res, nil
 }
@@ -63,20 +65,23 @@ func (i *userAddressDatasource) UpdateUserAddressSelected(tx *gorm.DB, where *en
 func (i *userAddressDatasource) UpdateUserAddress(tx *gorm.DB, where *entity.UserAddress, data *entity.UserAddress) (*entity.UserAddress, error) {
 	var point *string
 	if data.Coordinates.Point != nil {
-		value := fmt.Sprintf("POINT(%v %v)", data.Coordinates.Point.Coords()[1], data.Coordinates.Point.Coords()[0])
+		value, err := pointWKT(data.Coordinates)
+		if err != nil {
+			return nil, err
+		}
 		point = &value
 	}
 	var res entity.UserAddress
 	var time = time.Now().UTC()
 	result := tx.Raw(`UPDATE "user_address" SET "selected"=?,"name"=?,"address"=?,"number"=?,"coordinates"=ST_GeomFromText(?, 4326),"instructions"=?,"user_id"=?,"province_id"=?,"municipality_id"=?,"create_time"=?,"update_time"=? WHERE "user_address"."id" = ? AND "user_address"."delete_time" IS NULL RETURNING "id", "name", "selected", "address", "number", ST_AsEWKB(coordinates) AS coordinates, "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time"`, data.Selected, data.Name, data.Address, data.Number, point, data.Instructions, data.UserId, data.ProvinceId, data.MunicipalityId, time, time, where.ID).Scan(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return &res, nil
 }
@@ -86,13 +91,13 @@ func (i *userAddressDatasource) UpdateUserAddressByUserId(tx *gorm.DB, where *en
 	var time = time.Now().UTC()
 	result := tx.Raw(`UPDATE "user_address" SET "selected"='false',"update_time"=? WHERE "user_address"."user_id" = ? AND "user_address"."delete_time" IS NULL RETURNING "id", "name", "selected", "address", "number", ST_AsEWKB(coordinates) AS coordinates, "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time"`, time, where.UserId).Scan(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return &res, nil
 }
@@ -101,10 +106,10 @@ func (i *userAddressDatasource) GetUserAddress(tx *gorm.DB, where *entity.UserAd
 	var res entity.UserAddress
 	result := tx.Raw(`SELECT "id", "name", "selected", "address", "number", ST_AsEWKB(coordinates) AS coordinates, "instructions", "user_id", "province_id", "municipality_id", "create_time", "update_time" FROM "user_address" WHERE id = ? LIMIT 1`, where.ID).Scan(&res)
 	if res.ID == nil {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	} else if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -123,7 +128,7 @@ func (v *userAddressDatasource) DeleteUserAddress(tx *gorm.DB, where *entity.Use
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
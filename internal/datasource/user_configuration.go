@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -39,8 +38,8 @@ func (i *userConfigurationDatasource) CreateUserConfiguration(tx *gorm.DB, data
 func (i *userConfigurationDatasource) UpdateUserConfiguration(tx *gorm.DB, where *entity.UserConfiguration, data *entity.UserConfiguration) (*entity.UserConfiguration, error) {
 	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -52,8 +51,8 @@ func (i *userConfigurationDatasource) GetUserConfiguration(tx *gorm.DB, where *e
 	var res *entity.UserConfiguration
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -72,7 +71,7 @@ func (v *userConfigurationDatasource) DeleteUserConfiguration(tx *gorm.DB, where
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -1,9 +1,8 @@
 package datasource
 
 import (
-	"errors"
-
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -12,6 +11,7 @@ import (
 type UserPermissionDatasource interface {
 	CreateUserPermission(tx *gorm.DB, data *[]entity.UserPermission) (*[]entity.UserPermission, error)
 	GetUserPermission(tx *gorm.DB, where *entity.UserPermission) (*entity.UserPermission, error)
+	ListUserPermission(tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error)
 	DeleteUserPermission(tx *gorm.DB, where *entity.UserPermission, ids *[]uuid.UUID) (*[]entity.UserPermission, error)
 	DeleteUserPermissionByPermissionId(tx *gorm.DB, permissionIds *[]uuid.UUID) (*[]entity.UserPermission, error)
 	DeleteUserPermissionByBusinessRoleId(tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error)
@@ -19,13 +19,22 @@ type UserPermissionDatasource interface {
 
 type userPermissionDatasource struct{}
 
+func (i *userPermissionDatasource) ListUserPermission(tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error) {
+	var res []entity.UserPermission
+	result := tx.Where(where).Find(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &res, nil
+}
+
 func (i *userPermissionDatasource) DeleteUserPermissionByBusinessRoleId(tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error) {
 	var res *[]entity.UserPermission
 	result := tx.Clauses(clause.Returning{}).Where(`business_role_id = ?`, where.BusinessRoleId).Delete(&res)
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -42,8 +51,8 @@ func (i *userPermissionDatasource) GetUserPermission(tx *gorm.DB, where *entity.
 	var res *entity.UserPermission
 	result := tx.Where(where).Take(&res)
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
 		} else {
 			return nil, result.Error
 		}
@@ -57,7 +66,7 @@ func (i *userPermissionDatasource) DeleteUserPermissionByPermissionId(tx *gorm.D
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -73,7 +82,7 @@ func (v *userPermissionDatasource) DeleteUserPermission(tx *gorm.DB, where *enti
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
@@ -1,18 +1,21 @@
 package datasource
 
 import (
-	"errors"
+	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type VerificationCodeDatasource interface {
-	GetVerificationCode(tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error)
 	CreateVerificationCode(tx *gorm.DB, data *entity.VerificationCode) (*entity.VerificationCode, error)
 	DeleteVerificationCode(tx *gorm.DB, where *entity.VerificationCode, ids *[]uuid.UUID) (*[]entity.VerificationCode, error)
+	GetVerificationCodeForUpdate(tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error)
+	UpdateVerificationCode(tx *gorm.DB, where *entity.VerificationCode, data *entity.VerificationCode) (*entity.VerificationCode, error)
+	PurgeVerificationCode(tx *gorm.DB, now time.Time) (int64, error)
 }
 
 type verificationCodeDatasource struct{}
@@ -25,19 +28,6 @@ func (v *verificationCodeDatasource) CreateVerificationCode(tx *gorm.DB, data *e
 	return data, nil
 }
 
-func (v *verificationCodeDatasource) GetVerificationCode(tx *gorm.DB, verificationCode *entity.VerificationCode) (*entity.VerificationCode, error) {
-	var res *entity.VerificationCode
-	result := tx.Where(verificationCode).Take(&res)
-	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
-			return nil, errors.New("record not found")
-		} else {
-			return nil, result.Error
-		}
-	}
-	return res, nil
-}
-
 func (v *verificationCodeDatasource) DeleteVerificationCode(tx *gorm.DB, where *entity.VerificationCode, ids *[]uuid.UUID) (*[]entity.VerificationCode, error) {
 	var res *[]entity.VerificationCode
 	var result *gorm.DB
@@ -49,7 +39,43 @@ func (v *verificationCodeDatasource) DeleteVerificationCode(tx *gorm.DB, where *
 	if result.Error != nil {
 		return nil, result.Error
 	} else if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+		return nil, apperror.ErrNotFound
 	}
 	return res, nil
 }
+
+// GetVerificationCodeForUpdate locks the last code sent that matches, so
+// concurrent guesses of the same code are counted one after the other.
+func (v *verificationCodeDatasource) GetVerificationCodeForUpdate(tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error) {
+	var res *entity.VerificationCode
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(where).Order("create_time DESC").Take(&res)
+	if result.Error != nil {
+		if apperror.IsNotFound(result.Error) {
+			return nil, apperror.ErrNotFound
+		} else {
+			return nil, result.Error
+		}
+	}
+	return res, nil
+}
+
+func (v *verificationCodeDatasource) UpdateVerificationCode(tx *gorm.DB, where *entity.VerificationCode, data *entity.VerificationCode) (*entity.VerificationCode, error) {
+	data.UpdateTime = time.Now().UTC()
+	result := tx.Clauses(clause.Returning{}).Where(where).Updates(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return data, nil
+}
+
+// PurgeVerificationCode permanently deletes the codes expired before now and
+// the codes already used, and returns how many were deleted.
+func (v *verificationCodeDatasource) PurgeVerificationCode(tx *gorm.DB, now time.Time) (int64, error) {
+	result := tx.Unscoped().Where("expiration_time < ? OR delete_time IS NOT NULL", now).Delete(&entity.VerificationCode{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
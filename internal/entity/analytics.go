@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AnalyticsHourlyTableName = "analytics_hourly"
+	AnalyticsDailyTableName  = "analytics_daily"
+)
+
+// The resources whose views are counted.
+const (
+	AnalyticsResourceBusiness = "business"
+	AnalyticsResourceItem     = "item"
+)
+
+// The kinds of views.
+const (
+	AnalyticsTypeView       = "view"
+	AnalyticsTypeDetailView = "detail_view"
+)
+
+func (AnalyticsHourly) TableName() string {
+	return AnalyticsHourlyTableName
+}
+
+// AnalyticsHourly counts the views of a business or an item in the hour that
+// starts at Time. BusinessId is the business of the item for the items.
+type AnalyticsHourly struct {
+	Resource   string     `gorm:"primaryKey;column:resource"`
+	ResourceId *uuid.UUID `gorm:"primaryKey;type:uuid;column:resource_id"`
+	Type       string     `gorm:"primaryKey;column:type"`
+	Time       time.Time  `gorm:"primaryKey;column:time"`
+	BusinessId *uuid.UUID `gorm:"type:uuid;index;column:business_id;not null"`
+	Count      int64      `gorm:"column:count;not null"`
+}
+
+func (AnalyticsDaily) TableName() string {
+	return AnalyticsDailyTableName
+}
+
+// AnalyticsDaily is the sum of the hourly counts of the UTC day that starts at
+// Time.
+type AnalyticsDaily struct {
+	Resource   string     `gorm:"primaryKey;column:resource"`
+	ResourceId *uuid.UUID `gorm:"primaryKey;type:uuid;column:resource_id"`
+	Type       string     `gorm:"primaryKey;column:type"`
+	Time       time.Time  `gorm:"primaryKey;column:time"`
+	BusinessId *uuid.UUID `gorm:"type:uuid;index;column:business_id;not null"`
+	Count      int64      `gorm:"column:count;not null"`
+}
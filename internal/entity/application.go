@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,14 +15,20 @@ func (Application) TableName() string {
 }
 
 type Application struct {
-	ID             *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
-	Name           string         `gorm:"column:name;not null"`
-	Version        string         `gorm:"column:version;not null"`
-	Description    string         `gorm:"column:description"`
-	ExpirationTime time.Time      `gorm:"column:expiration_time;not null"`
-	CreateTime     time.Time      `gorm:"column:create_time;not null"`
-	UpdateTime     time.Time      `gorm:"column:update_time;not null"`
-	DeleteTime     gorm.DeletedAt `gorm:"index;column:delete_time"`
+	ID             *uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4()"`
+	Name           string     `gorm:"column:name;not null"`
+	Version        string     `gorm:"column:version;not null"`
+	Description    string     `gorm:"column:description"`
+	ExpirationTime time.Time  `gorm:"column:expiration_time;not null"`
+	// MaxSessions is the maximum of concurrent sessions of a user in the app.
+	MaxSessions int32 `gorm:"column:max_sessions;not null;default:1"`
+	// Scopes is a comma separated list of the services, e.g.
+	// "main.BusinessService", or the full method names the app may call. An
+	// app without scopes may call every method.
+	Scopes     string         `gorm:"column:scopes;not null;default:''"`
+	CreateTime time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime gorm.DeletedAt `gorm:"index;column:delete_time"`
 }
 
 func (i *Application) BeforeCreate(tx *gorm.DB) (err error) {
@@ -35,3 +42,25 @@ func (u *Application) BeforeUpdate(tx *gorm.DB) (err error) {
 	u.UpdateTime = time.Now().UTC()
 	return
 }
+
+// SessionLimit returns MaxSessions, at least one session is always allowed.
+func (i *Application) SessionLimit() int {
+	if i.MaxSessions < 1 {
+		return 1
+	}
+	return int(i.MaxSessions)
+}
+
+// Allows reports whether the scopes of the app include the full method name.
+func (i *Application) Allows(method string) bool {
+	if strings.TrimSpace(i.Scopes) == "" {
+		return true
+	}
+	for _, scope := range strings.Split(i.Scopes, ",") {
+		scope = strings.TrimSpace(scope)
+		if scope == method || strings.HasPrefix(method, "/"+scope+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const ApplicationCredentialTableName = "application_credential"
+
+func (ApplicationCredential) TableName() string {
+	return ApplicationCredentialTableName
+}
+
+// ApplicationCredential is an access token of an application. Only the hash
+// of its secret is stored.
+type ApplicationCredential struct {
+	ID            *uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4()"`
+	ApplicationId *uuid.UUID  `gorm:"type:uuid;index;column:application_id;not null"`
+	Application   Application `gorm:"foreignKey:ApplicationId"`
+	SecretHash    string      `gorm:"column:secret_hash;not null"`
+	// ExpirationTime is set when the credential is rotated, so the clients
+	// have an overlap window to move to the new one.
+	ExpirationTime *time.Time     `gorm:"column:expiration_time"`
+	RevokeTime     *time.Time     `gorm:"column:revoke_time"`
+	CreateTime     time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime     time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime     gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *ApplicationCredential) BeforeCreate(tx *gorm.DB) (err error) {
+	if i.ID == nil {
+		id := uuid.New()
+		i.ID = &id
+	}
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+func (i *ApplicationCredential) BeforeUpdate(tx *gorm.DB) (err error) {
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+// Active reports whether the credential is neither revoked nor expired.
+func (i *ApplicationCredential) Active(now time.Time) bool {
+	return i.RevokeTime == nil && (i.ExpirationTime == nil || now.Before(*i.ExpirationTime))
+}
@@ -27,6 +27,8 @@ type AuthorizationToken struct {
 	Device         Device         `gorm:"foreignKey:DeviceId"`
 	App            *string         `gorm:"column:app;not null"`
 	AppVersion     *string         `gorm:"column:app_version;not null"`
+	LastSeenTime   *time.Time      `gorm:"column:last_seen_time"`
+	LastSeenIp     string          `gorm:"column:last_seen_ip"`
 	CreateTime     time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime     time.Time      `gorm:"column:update_time;not null"`
 	DeleteTime     gorm.DeletedAt `gorm:"index;column:delete_time"`
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const BannedDeviceTableName = "banned_device"
+
+func (BannedDevice) TableName() string {
+	return BannedDeviceTableName
+}
+
+// BannedDevice is a ban of a device. The ban is permanent when ExpirationTime
+// is nil, and it's lifted by deleting it.
+type BannedDevice struct {
+	ID               *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
+	DeviceIdentifier string         `gorm:"index;column:device_identifier;not null"`
+	Reason           string         `gorm:"column:reason;not null"`
+	ModeratorId      *uuid.UUID     `gorm:"type:uuid;column:moderator_id;not null"`
+	ExpirationTime   *time.Time     `gorm:"column:expiration_time"`
+	CreateTime       time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime       time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime       gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *BannedDevice) BeforeCreate(tx *gorm.DB) (err error) {
+	if i.ID == nil {
+		id := uuid.New()
+		i.ID = &id
+	}
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+func (i *BannedDevice) BeforeUpdate(tx *gorm.DB) (err error) {
+	i.UpdateTime = time.Now().UTC()
+	return
+}
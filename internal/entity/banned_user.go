@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const BannedUserTableName = "banned_user"
+
+func (BannedUser) TableName() string {
+	return BannedUserTableName
+}
+
+// BannedUser is a ban of a user. The ban is permanent when ExpirationTime
+// is nil, and it's lifted by deleting it.
+type BannedUser struct {
+	ID             *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
+	UserId         *uuid.UUID     `gorm:"type:uuid;index;column:user_id;not null"`
+	Reason         string         `gorm:"column:reason;not null"`
+	ModeratorId    *uuid.UUID     `gorm:"type:uuid;column:moderator_id;not null"`
+	ExpirationTime *time.Time     `gorm:"column:expiration_time"`
+	CreateTime     time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime     time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime     gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *BannedUser) BeforeCreate(tx *gorm.DB) (err error) {
+	if i.ID == nil {
+		id := uuid.New()
+		i.ID = &id
+	}
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+func (i *BannedUser) BeforeUpdate(tx *gorm.DB) (err error) {
+	i.UpdateTime = time.Now().UTC()
+	return
+}
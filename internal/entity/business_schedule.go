@@ -7,46 +7,27 @@ import (
 	"gorm.io/gorm"
 )
 
-const BusinessScheduleTableName = "business_schedule"
+const (
+	BusinessScheduleTableName          = "business_schedule"
+	BusinessScheduleIntervalTableName  = "business_schedule_interval"
+	BusinessScheduleExceptionTableName = "business_schedule_exception"
+)
 
 func (BusinessSchedule) TableName() string {
 	return BusinessScheduleTableName
 }
 
+// BusinessSchedule tells when a business is open. The times of its intervals
+// and exceptions are wall clock times of its timezone.
 type BusinessSchedule struct {
-	ID                         *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
-	FirstOpeningTimeSunday     time.Time      `gorm:"column:first_opening_time_sunday"`
-	SecondOpeningTimeSunday    time.Time      `gorm:"column:second_opening_time_sunday"`
-	FirstOpeningTimeMonday     time.Time      `gorm:"column:first_opening_time_monday"`
-	SecondOpeningTimeMonday    time.Time      `gorm:"column:second_opening_time_monday"`
-	FirstOpeningTimeTuesday    time.Time      `gorm:"column:first_opening_time_tuesday"`
-	SecondOpeningTimeTuesday   time.Time      `gorm:"column:second_opening_time_tuesday"`
-	FirstOpeningTimeWednesday  time.Time      `gorm:"column:first_opening_time_wednesday"`
-	SecondOpeningTimeWednesday time.Time      `gorm:"column:second_opening_time_wednesday"`
-	FirstOpeningTimeThursday   time.Time      `gorm:"column:first_opening_time_thursday"`
-	SecondOpeningTimeThursday  time.Time      `gorm:"column:second_opening_time_thursday"`
-	FirstOpeningTimeFriday     time.Time      `gorm:"column:first_opening_time_friday"`
-	SecondOpeningTimeFriday    time.Time      `gorm:"column:second_opening_time_friday"`
-	FirstOpeningTimeSaturday   time.Time      `gorm:"column:first_opening_time_saturday"`
-	SecondOpeningTimeSaturday  time.Time      `gorm:"column:second_opening_time_saturday"`
-	FirstClosingTimeSunday     time.Time      `gorm:"column:first_closing_time_sunday"`
-	SecondClosingTimeSunday    time.Time      `gorm:"column:second_closing_time_sunday"`
-	FirstClosingTimeMonday     time.Time      `gorm:"column:first_closing_time_monday"`
-	SecondClosingTimeMonday    time.Time      `gorm:"column:second_closing_time_monday"`
-	FirstClosingTimeTuesday    time.Time      `gorm:"column:first_closing_time_tuesday"`
-	SecondClosingTimeTuesday   time.Time      `gorm:"column:second_closing_time_tuesday"`
-	FirstClosingTimeWednesday  time.Time      `gorm:"column:first_closing_time_wednesday"`
-	SecondClosingTimeWednesday time.Time      `gorm:"column:second_closing_time_wednesday"`
-	FirstClosingTimeThursday   time.Time      `gorm:"column:first_closing_time_thursday"`
-	SecondClosingTimeThursday  time.Time      `gorm:"column:second_closing_time_thursday"`
-	FirstClosingTimeFriday     time.Time      `gorm:"column:first_closing_time_friday"`
-	SecondClosingTimeFriday    time.Time      `gorm:"column:second_closing_time_friday"`
-	FirstClosingTimeSaturday   time.Time      `gorm:"column:first_closing_time_saturday"`
-	SecondClosingTimeSaturday  time.Time      `gorm:"column:second_closing_time_saturday"`
-	BusinessId                 *uuid.UUID     `gorm:"column:business_id;not null"`
-	CreateTime                 time.Time      `gorm:"column:create_time;not null"`
-	UpdateTime                 time.Time      `gorm:"column:update_time;not null"`
-	DeleteTime                 gorm.DeletedAt `gorm:"index;column:delete_time"`
+	ID         *uuid.UUID                  `gorm:"type:uuid;default:uuid_generate_v4()"`
+	Timezone   string                      `gorm:"column:timezone;not null"`
+	Intervals  []BusinessScheduleInterval  `gorm:"foreignKey:BusinessScheduleId"`
+	Exceptions []BusinessScheduleException `gorm:"foreignKey:BusinessScheduleId"`
+	BusinessId *uuid.UUID                  `gorm:"column:business_id;not null"`
+	CreateTime time.Time                   `gorm:"column:create_time;not null"`
+	UpdateTime time.Time                   `gorm:"column:update_time;not null"`
+	DeleteTime gorm.DeletedAt              `gorm:"index;column:delete_time"`
 }
 
 func (i *BusinessSchedule) BeforeCreate(tx *gorm.DB) (err error) {
@@ -54,3 +35,165 @@ func (i *BusinessSchedule) BeforeCreate(tx *gorm.DB) (err error) {
 	i.UpdateTime = time.Now().UTC()
 	return
 }
+
+func (BusinessScheduleInterval) TableName() string {
+	return BusinessScheduleIntervalTableName
+}
+
+// BusinessScheduleInterval opens the business every week on the weekday. The
+// times are minutes since midnight, and an interval that doesn't close after
+// it opens closes the next day.
+type BusinessScheduleInterval struct {
+	ID                 *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
+	BusinessScheduleId *uuid.UUID     `gorm:"type:uuid;index;column:business_schedule_id;not null"`
+	Weekday            time.Weekday   `gorm:"column:weekday;not null"`
+	OpeningMinute      int32          `gorm:"column:opening_minute;not null"`
+	ClosingMinute      int32          `gorm:"column:closing_minute;not null"`
+	CreateTime         time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime         time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime         gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *BusinessScheduleInterval) BeforeCreate(tx *gorm.DB) (err error) {
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+func (BusinessScheduleException) TableName() string {
+	return BusinessScheduleExceptionTableName
+}
+
+// BusinessScheduleException replaces the weekly intervals on a date, a
+// holiday for instance. A closed exception closes the business the whole date,
+// otherwise the exceptions of the date are its intervals.
+type BusinessScheduleException struct {
+	ID                 *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
+	BusinessScheduleId *uuid.UUID     `gorm:"type:uuid;index;column:business_schedule_id;not null"`
+	Date               time.Time      `gorm:"type:date;index;column:date;not null"`
+	Closed             bool           `gorm:"column:closed;not null"`
+	OpeningMinute      int32          `gorm:"column:opening_minute;not null"`
+	ClosingMinute      int32          `gorm:"column:closing_minute;not null"`
+	Description        string         `gorm:"column:description"`
+	CreateTime         time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime         time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime         gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *BusinessScheduleException) BeforeCreate(tx *gorm.DB) (err error) {
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+// maxScheduleLookahead is the number of days the schedule is searched ahead.
+const maxScheduleLookahead = 366
+
+// openSpan is an interval of a date placed in time.
+type openSpan struct {
+	from time.Time
+	to   time.Time
+}
+
+// Location returns the timezone of the schedule, UTC when it isn't valid.
+func (i *BusinessSchedule) Location() *time.Location {
+	location, err := time.LoadLocation(i.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// spans returns the spans that open on the date of the time in the location.
+func (i *BusinessSchedule) spans(t time.Time, location *time.Location) []openSpan {
+	year, month, day := t.In(location).Date()
+	var minutes [][2]int32
+	exception := false
+	for _, item := range i.Exceptions {
+		exceptionYear, exceptionMonth, exceptionDay := item.Date.Date()
+		if exceptionYear != year || exceptionMonth != month || exceptionDay != day {
+			continue
+		}
+		if item.Closed {
+			return nil
+		}
+		exception = true
+		minutes = append(minutes, [2]int32{item.OpeningMinute, item.ClosingMinute})
+	}
+	if !exception {
+		weekday := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+		for _, item := range i.Intervals {
+			if item.Weekday == weekday {
+				minutes = append(minutes, [2]int32{item.OpeningMinute, item.ClosingMinute})
+			}
+		}
+	}
+	res := make([]openSpan, 0, len(minutes))
+	for _, item := range minutes {
+		closingDay := day
+		if item[1] <= item[0] {
+			closingDay++
+		}
+		res = append(res, openSpan{
+			from: time.Date(year, month, day, 0, int(item[0]), 0, 0, location),
+			to:   time.Date(year, month, closingDay, 0, int(item[1]), 0, 0, location),
+		})
+	}
+	return res
+}
+
+// closingAt returns when the span open at the time closes. The spans of the
+// day before are checked too, they may close after midnight.
+func (i *BusinessSchedule) closingAt(t time.Time, location *time.Location) (time.Time, bool) {
+	var closing time.Time
+	open := false
+	local := t.In(location)
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		for _, span := range i.spans(day, location) {
+			if !t.Before(span.from) && t.Before(span.to) && span.to.After(closing) {
+				closing = span.to
+				open = true
+			}
+		}
+	}
+	return closing, open
+}
+
+// IsOpenAt reports whether the business is open at the time.
+func (i *BusinessSchedule) IsOpenAt(t time.Time) bool {
+	_, open := i.closingAt(t, i.Location())
+	return open
+}
+
+// IsOpenBetween reports whether the business stays open from start to end,
+// through spans that follow one another.
+func (i *BusinessSchedule) IsOpenBetween(start time.Time, end time.Time) bool {
+	location := i.Location()
+	closing, open := i.closingAt(start, location)
+	for n := 0; open && closing.Before(end) && n < maxScheduleLookahead; n++ {
+		closing, open = i.closingAt(closing, location)
+	}
+	return open && !closing.Before(end)
+}
+
+// NextOpening returns the first time at or after t when the business is open,
+// or false when it doesn't open in the next year.
+func (i *BusinessSchedule) NextOpening(t time.Time) (time.Time, bool) {
+	location := i.Location()
+	if _, open := i.closingAt(t, location); open {
+		return t, true
+	}
+	local := t.In(location)
+	for n := 0; n <= maxScheduleLookahead; n++ {
+		var next time.Time
+		for _, span := range i.spans(local.AddDate(0, 0, n), location) {
+			if span.from.After(t) && (next.IsZero() || span.from.Before(next)) {
+				next = span.from
+			}
+		}
+		if !next.IsZero() {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func testSchedule() *BusinessSchedule {
+	return &BusinessSchedule{
+		Timezone: "America/Havana",
+		Intervals: []BusinessScheduleInterval{
+			// Monday 9:00-13:00 and 15:00-19:00
+			{Weekday: time.Monday, OpeningMinute: 9 * 60, ClosingMinute: 13 * 60},
+			{Weekday: time.Monday, OpeningMinute: 15 * 60, ClosingMinute: 19 * 60},
+			// Friday 20:00 to Saturday 2:00
+			{Weekday: time.Friday, OpeningMinute: 20 * 60, ClosingMinute: 2 * 60},
+			// Saturday 2:00-4:00, right after the Friday night
+			{Weekday: time.Saturday, OpeningMinute: 2 * 60, ClosingMinute: 4 * 60},
+		},
+		Exceptions: []BusinessScheduleException{
+			{Date: time.Date(2022, 7, 25, 0, 0, 0, 0, time.UTC), Closed: true},
+			{Date: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC), OpeningMinute: 10 * 60, ClosingMinute: 12 * 60},
+		},
+	}
+}
+
+func TestBusinessScheduleIsOpenAt(t *testing.T) {
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skip(err)
+	}
+	schedule := testSchedule()
+	tests := []struct {
+		name string
+		time time.Time
+		open bool
+	}{
+		{"monday morning", time.Date(2022, 7, 18, 10, 0, 0, 0, havana), true},
+		{"monday lunch", time.Date(2022, 7, 18, 14, 0, 0, 0, havana), false},
+		{"monday closing", time.Date(2022, 7, 18, 19, 0, 0, 0, havana), false},
+		{"monday in utc", time.Date(2022, 7, 18, 13, 0, 0, 0, time.UTC), true},
+		{"friday night", time.Date(2022, 7, 22, 23, 0, 0, 0, havana), true},
+		{"after midnight", time.Date(2022, 7, 23, 1, 0, 0, 0, havana), true},
+		{"saturday morning", time.Date(2022, 7, 23, 5, 0, 0, 0, havana), false},
+		{"closed monday", time.Date(2022, 7, 25, 10, 0, 0, 0, havana), false},
+		{"exception monday", time.Date(2022, 8, 1, 11, 0, 0, 0, havana), true},
+		{"exception replaces the week", time.Date(2022, 8, 1, 16, 0, 0, 0, havana), false},
+	}
+	for _, test := range tests {
+		if open := schedule.IsOpenAt(test.time); open != test.open {
+			t.Errorf("%s: got %v, want %v", test.name, open, test.open)
+		}
+	}
+}
+
+func TestBusinessScheduleIsOpenBetween(t *testing.T) {
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skip(err)
+	}
+	schedule := testSchedule()
+	if !schedule.IsOpenBetween(time.Date(2022, 7, 22, 22, 0, 0, 0, havana), time.Date(2022, 7, 23, 3, 30, 0, 0, havana)) {
+		t.Error("the friday night and the saturday follow one another")
+	}
+	if schedule.IsOpenBetween(time.Date(2022, 7, 18, 12, 0, 0, 0, havana), time.Date(2022, 7, 18, 16, 0, 0, 0, havana)) {
+		t.Error("the business closes for lunch")
+	}
+}
+
+func TestBusinessScheduleNextOpening(t *testing.T) {
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skip(err)
+	}
+	schedule := testSchedule()
+	tests := []struct {
+		name string
+		time time.Time
+		next time.Time
+	}{
+		{"open", time.Date(2022, 7, 18, 10, 0, 0, 0, havana), time.Date(2022, 7, 18, 10, 0, 0, 0, havana)},
+		{"lunch", time.Date(2022, 7, 18, 14, 0, 0, 0, havana), time.Date(2022, 7, 18, 15, 0, 0, 0, havana)},
+		{"monday night", time.Date(2022, 7, 18, 21, 0, 0, 0, havana), time.Date(2022, 7, 22, 20, 0, 0, 0, havana)},
+		{"before the closed monday", time.Date(2022, 7, 23, 5, 0, 0, 0, havana), time.Date(2022, 7, 29, 20, 0, 0, 0, havana)},
+	}
+	for _, test := range tests {
+		next, ok := schedule.NextOpening(test.time)
+		if !ok || !next.Equal(test.next) {
+			t.Errorf("%s: got %v %v, want %v", test.name, next, ok, test.next)
+		}
+	}
+	if _, ok := (&BusinessSchedule{}).NextOpening(time.Now()); ok {
+		t.Error("a schedule without intervals opens")
+	}
+}
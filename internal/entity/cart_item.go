@@ -30,6 +30,7 @@ type CartItem struct {
 	BlurHash             string         `gorm:"column:blurhash;not null"`
 	UserId               *uuid.UUID     `gorm:"column:user_id;not null"`
 	AuthorizationTokenId *uuid.UUID     `gorm:"column:authorization_token_id;not null"`
+	ExpirationTime       time.Time      `gorm:"column:expiration_time;not null"`
 	CreateTime           time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime           time.Time      `gorm:"column:update_time;not null"`
 	DeleteTime           gorm.DeletedAt `gorm:"index;column:delete_time"`
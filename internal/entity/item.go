@@ -102,3 +102,15 @@ func (u *Item) BeforeUpdate(tx *gorm.DB) (err error) {
 	u.UpdateTime = time.Now().UTC()
 	return
 }
+
+// ItemAvailabilityExhausted is how the availability of an item whose last
+// units were taken is stored, the updates from a struct skip a zero.
+const ItemAvailabilityExhausted = -1
+
+// Restock gives the quantity back to the availability of the item.
+func (i *ItemBusiness) Restock(quantity int64) {
+	if i.Availability == ItemAvailabilityExhausted {
+		i.Availability = 0
+	}
+	i.Availability += quantity
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "testing"
+
+func TestItemBusinessRestock(t *testing.T) {
+	tests := []struct {
+		name         string
+		availability int64
+		quantity     int64
+		want         int64
+	}{
+		{"in stock", 3, 2, 5},
+		{"exhausted", ItemAvailabilityExhausted, 2, 2},
+		{"exhausted by one unit", ItemAvailabilityExhausted, 1, 1},
+	}
+	for _, test := range tests {
+		item := ItemBusiness{Availability: test.availability}
+		item.Restock(test.quantity)
+		if item.Availability != test.want {
+			t.Errorf("%s: Restock(%d) = %d, want %d", test.name, test.quantity, item.Availability, test.want)
+		}
+	}
+}
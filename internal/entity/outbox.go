@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const OutboxTableName = "outbox"
+
+func (Outbox) TableName() string {
+	return OutboxTableName
+}
+
+// Channels an outbox message can be delivered through.
+const (
+	OutboxChannelEmail   = "email"
+	OutboxChannelPush    = "push"
+	OutboxChannelWebhook = "webhook"
+)
+
+// Statuses of an outbox message. A message is dead when it failed to be
+// delivered after the maximum number of attempts.
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusDelivered = "delivered"
+	OutboxStatusDead      = "dead"
+)
+
+type Outbox struct {
+	ID              *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
+	Channel         string         `gorm:"column:channel;not null"`
+	Payload         string         `gorm:"column:payload;type:jsonb;not null"`
+	Status          string         `gorm:"column:status;not null;index"`
+	Attempts        int32          `gorm:"column:attempts;not null"`
+	LastError       string         `gorm:"column:last_error"`
+	NextAttemptTime time.Time      `gorm:"column:next_attempt_time;not null;index"`
+	DeliverTime     *time.Time     `gorm:"column:deliver_time"`
+	CreateTime      time.Time      `gorm:"column:create_time;not null"`
+	UpdateTime      time.Time      `gorm:"column:update_time;not null"`
+	DeleteTime      gorm.DeletedAt `gorm:"index;column:delete_time"`
+}
+
+func (i *Outbox) BeforeCreate(tx *gorm.DB) (err error) {
+	i.CreateTime = time.Now().UTC()
+	i.UpdateTime = time.Now().UTC()
+	if i.Status == "" {
+		i.Status = OutboxStatusPending
+	}
+	if i.NextAttemptTime.IsZero() {
+		i.NextAttemptTime = i.CreateTime
+	}
+	return
+}
+
+func (i *Outbox) BeforeUpdate(tx *gorm.DB) (err error) {
+	i.UpdateTime = time.Now().UTC()
+	return
+}
+
+// OutboxWebhook is the payload of the webhook messages, the email and push
+// messages store a notification.Message.
+type OutboxWebhook struct {
+	Url  string          `json:"url"`
+	Body json.RawMessage `json:"body"`
+}
@@ -14,17 +14,31 @@ func (RefreshToken) TableName() string {
 }
 
 type RefreshToken struct {
-	ID         *uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4()"`
-	UserId     *uuid.UUID     `gorm:"column:user_id;not null"`
-	User       User           `gorm:"foreignKey:UserId"`
-	DeviceId   *uuid.UUID     `gorm:"column:device_id;not null"`
-	Device     Device         `gorm:"foreignKey:DeviceId"`
+	ID       *uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4()"`
+	UserId   *uuid.UUID `gorm:"column:user_id;not null"`
+	User     User       `gorm:"foreignKey:UserId"`
+	DeviceId *uuid.UUID `gorm:"column:device_id;not null"`
+	Device   Device     `gorm:"foreignKey:DeviceId"`
+	// FamilyId is the id of the token that started the chain of refreshes and
+	// ParentId the token refreshed to issue this one.
+	FamilyId *uuid.UUID `gorm:"type:uuid;index;column:family_id"`
+	ParentId *uuid.UUID `gorm:"type:uuid;column:parent_id"`
+	// UsedTime is set once the token is refreshed. Presenting it again revokes
+	// its family.
+	UsedTime   *time.Time     `gorm:"column:used_time"`
 	CreateTime time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime time.Time      `gorm:"column:update_time;not null"`
 	DeleteTime gorm.DeletedAt `gorm:"index;column:delete_time"`
 }
 
 func (r *RefreshToken) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == nil {
+		id := uuid.New()
+		r.ID = &id
+	}
+	if r.FamilyId == nil {
+		r.FamilyId = r.ID
+	}
 	r.CreateTime = time.Now().UTC()
 	r.UpdateTime = time.Now().UTC()
 	return
@@ -0,0 +1,21 @@
+package entity
+
+import "github.com/google/uuid"
+
+// The kinds of search suggestions.
+const (
+	SearchSuggestionTypeItem        = "item"
+	SearchSuggestionTypeBusiness    = "business"
+	SearchSuggestionTypeCategory    = "category"
+	SearchSuggestionTypeRecentQuery = "recent_query"
+)
+
+// SearchSuggestion is a name the search suggests while the query is typed.
+// The suggestions without province are suggested in every province.
+type SearchSuggestion struct {
+	Type           string     `gorm:"column:type"`
+	Id             *uuid.UUID `gorm:"column:id"`
+	Text           string     `gorm:"column:text"`
+	ProvinceId     *uuid.UUID `gorm:"column:province_id"`
+	MunicipalityId *uuid.UUID `gorm:"column:municipality_id"`
+}
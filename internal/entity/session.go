@@ -21,6 +21,8 @@ type Session struct {
 	DeviceIdentifier         string         `gorm:"column:device_id;not null"`
 	FirebaseCloudMessagingId string         `gorm:"column:firebase_cloud_messaging_id;not null"`
 	Model                    string         `gorm:"column:model;not null"`
+	LastSeenTime             *time.Time     `gorm:"column:last_seen_time"`
+	LastSeenIp               string         `gorm:"column:last_seen_ip"`
 	CreateTime               time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime               time.Time      `gorm:"column:update_time;not null"`
 	DeleteTime               gorm.DeletedAt `gorm:"index;column:delete_time"`
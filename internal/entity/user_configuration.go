@@ -19,6 +19,8 @@ type UserConfiguration struct {
 	HighQualityImagesWifi *bool          `gorm:"column:high_quality_images_wifi"`
 	HighQualityImagesData *bool          `gorm:"column:high_quality_images_data"`
 	PaymentMethod         string         `gorm:"column:payment_method"`
+	Language              string         `gorm:"column:language"`
+	OrderNotifications    *bool          `gorm:"column:order_notifications;not null;default:true"`
 	UserId                *uuid.UUID     `gorm:"column:user_id;not null"`
 	CreateTime            time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime            time.Time      `gorm:"column:update_time;not null"`
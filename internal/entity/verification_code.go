@@ -19,6 +19,8 @@ type VerificationCode struct {
 	Email            string         `gorm:"column:email;not null"`
 	Type             string         `gorm:"column:type;not null"`
 	DeviceIdentifier string         `gorm:"column:device_identifier;not null"`
+	Attempts         int32          `gorm:"column:attempts;not null;default:0"`
+	ExpirationTime   time.Time      `gorm:"column:expiration_time;not null"`
 	CreateTime       time.Time      `gorm:"column:create_time;not null"`
 	UpdateTime       time.Time      `gorm:"column:update_time;not null"`
 	DeleteTime       gorm.DeletedAt `gorm:"index;column:delete_time"`
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// analyticsBuffersKey is the set of the hours that have buffered views.
+const analyticsBuffersKey = "analytics:buffers"
+
+type AnalyticsRepository interface {
+	BufferAnalytics(ctx context.Context, data []entity.AnalyticsHourly, ttl time.Duration) error
+	ListAnalyticsBuffer(ctx context.Context) ([]time.Time, error)
+	GetAnalyticsBuffer(ctx context.Context, hour time.Time) (*[]entity.AnalyticsHourly, error)
+	DeleteAnalyticsBuffer(ctx context.Context, hour time.Time) error
+	UpsertAnalyticsHourly(ctx context.Context, tx *gorm.DB, data *[]entity.AnalyticsHourly) error
+	RollupAnalyticsDaily(ctx context.Context, tx *gorm.DB, day time.Time) error
+	ListBusinessAnalytics(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error)
+	SumItemAnalytics(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error)
+	CountBusinessOrder(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (int64, error)
+	CountItemOrder(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error)
+}
+
+type analyticsRepository struct{}
+
+func analyticsBufferKey(hour time.Time) string {
+	return "analytics:" + strconv.FormatInt(hour.Unix(), 10)
+}
+
+// BufferAnalytics adds the counts to the Redis hash of their hour, the
+// rollup job moves them to the database.
+func (i *analyticsRepository) BufferAnalytics(ctx context.Context, data []entity.AnalyticsHourly, ttl time.Duration) error {
+	rdbPipe := Rdb.Pipeline()
+	hours := make(map[time.Time]bool)
+	for _, item := range data {
+		key := analyticsBufferKey(item.Time)
+		rdbPipe.HIncrBy(ctx, key, item.Resource+":"+item.ResourceId.String()+":"+item.Type, item.Count)
+		if !hours[item.Time] {
+			hours[item.Time] = true
+			rdbPipe.Expire(ctx, key, ttl)
+			rdbPipe.SAdd(ctx, analyticsBuffersKey, item.Time.Unix())
+		}
+	}
+	_, err := rdbPipe.Exec(ctx)
+	return err
+}
+
+func (i *analyticsRepository) ListAnalyticsBuffer(ctx context.Context) ([]time.Time, error) {
+	members, err := Rdb.SMembers(ctx, analyticsBuffersKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]time.Time, 0, len(members))
+	for _, member := range members {
+		unix, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			log.Errorf("invalid analytics buffer %q", member)
+			continue
+		}
+		res = append(res, time.Unix(unix, 0).UTC())
+	}
+	return res, nil
+}
+
+// GetAnalyticsBuffer returns the counts buffered for the hour. The counts of
+// the items miss their business.
+func (i *analyticsRepository) GetAnalyticsBuffer(ctx context.Context, hour time.Time) (*[]entity.AnalyticsHourly, error) {
+	fields, err := Rdb.HGetAll(ctx, analyticsBufferKey(hour)).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]entity.AnalyticsHourly, 0, len(fields))
+	for field, value := range fields {
+		parts := strings.SplitN(field, ":", 3)
+		if len(parts) != 3 {
+			log.Errorf("invalid analytics field %q", field)
+			continue
+		}
+		resourceId, err := uuid.Parse(parts[1])
+		if err != nil {
+			log.Errorf("invalid analytics field %q", field)
+			continue
+		}
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Errorf("invalid analytics count %q", value)
+			continue
+		}
+		res = append(res, entity.AnalyticsHourly{Resource: parts[0], ResourceId: &resourceId, Type: parts[2], Time: hour, Count: count})
+	}
+	return &res, nil
+}
+
+func (i *analyticsRepository) DeleteAnalyticsBuffer(ctx context.Context, hour time.Time) error {
+	rdbPipe := Rdb.Pipeline()
+	rdbPipe.Del(ctx, analyticsBufferKey(hour))
+	rdbPipe.SRem(ctx, analyticsBuffersKey, hour.Unix())
+	_, err := rdbPipe.Exec(ctx)
+	return err
+}
+
+func (i *analyticsRepository) UpsertAnalyticsHourly(ctx context.Context, tx *gorm.DB, data *[]entity.AnalyticsHourly) error {
+	return Datasource.NewAnalyticsDatasource().UpsertAnalyticsHourly(tx, data)
+}
+
+func (i *analyticsRepository) RollupAnalyticsDaily(ctx context.Context, tx *gorm.DB, day time.Time) error {
+	return Datasource.NewAnalyticsDatasource().RollupAnalyticsDaily(tx, day)
+}
+
+func (i *analyticsRepository) ListBusinessAnalytics(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error) {
+	return Datasource.NewAnalyticsDatasource().ListBusinessAnalytics(tx, filter)
+}
+
+func (i *analyticsRepository) SumItemAnalytics(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error) {
+	return Datasource.NewAnalyticsDatasource().SumItemAnalytics(tx, filter)
+}
+
+func (i *analyticsRepository) CountBusinessOrder(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (int64, error) {
+	return Datasource.NewAnalyticsDatasource().CountBusinessOrder(tx, filter)
+}
+
+func (i *analyticsRepository) CountItemOrder(ctx context.Context, tx *gorm.DB, filter *datasource.AnalyticsFilter) (*[]datasource.AnalyticsCount, error) {
+	return Datasource.NewAnalyticsDatasource().CountItemOrder(tx, filter)
+}
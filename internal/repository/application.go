@@ -2,11 +2,12 @@ package repository
 
 import (
 	"context"
-	"errors"
+	"strconv"
 	"time"
 
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/appcredential"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/go-redis/redis/v9"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -70,35 +71,41 @@ func (i *applicationRepository) ListApplication(ctx context.Context, tx *gorm.DB
 	return res, nil
 }
 
+// CheckApplication returns the application of the access token. The
+// credential of the token must be active and the application not expired. The
+// tokens issued before the credentials work once they are imported.
 func (i *applicationRepository) CheckApplication(ctx context.Context, tx *gorm.DB, accessToken string) (*entity.Application, error) {
-	jwtAccessToken := datasource.JsonWebTokenMetadata{Token: &accessToken}
-	accessTokenParseErr := Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(&jwtAccessToken)
-	if accessTokenParseErr != nil {
-		switch accessTokenParseErr.Error() {
-		case "Token is expired":
-			return nil, errors.New("access token expired")
-		case "signature is invalid":
-			return nil, errors.New("access token signature is invalid")
-		case "token contains an invalid number of segments":
-			return nil, errors.New("access token contains an invalid number of segments")
-		default:
-			return nil, accessTokenParseErr
-		}
+	credentialId, secret, err := appcredential.Parse(accessToken)
+	if err != nil {
+		credentialId, secret = appcredential.ParseLegacy(accessToken)
+	}
+	credential, err := (&applicationCredentialRepository{}).GetApplicationCredential(ctx, tx, &credentialId)
+	if apperror.IsNotFound(err) {
+		return nil, apperror.Unauthenticated("unauthenticated application")
+	} else if err != nil {
+		return nil, err
+	}
+	if !appcredential.Verify(secret, credential.SecretHash) {
+		return nil, apperror.Unauthenticated("unauthenticated application")
+	} else if credential.RevokeTime != nil {
+		return nil, apperror.Unauthenticated("access token revoked")
+	} else if !credential.Active(time.Now().UTC()) {
+		return nil, apperror.Unauthenticated("access token expired")
 	}
-	cacheId := "application:" + jwtAccessToken.TokenId.String()
+	cacheId := "application:" + credential.ApplicationId.String()
 	cacheRes, cacheErr := Rdb.HGetAll(ctx, cacheId).Result()
 	// Check if exists in cache
 	if len(cacheRes) == 0 || cacheErr == redis.Nil {
-		dbRes, dbErr := Datasource.NewApplicationDatasource().GetApplication(tx, &entity.Application{ID: jwtAccessToken.TokenId})
-		if dbErr != nil && dbErr.Error() == "record not found" {
-			return nil, errors.New("unauthenticated application")
+		dbRes, dbErr := Datasource.NewApplicationDatasource().GetApplication(tx, &entity.Application{ID: credential.ApplicationId})
+		if apperror.IsNotFound(dbErr) {
+			return nil, apperror.Unauthenticated("unauthenticated application")
 		} else if dbErr != nil {
 			return nil, dbErr
 		}
 		if dbRes != nil && !dbRes.ExpirationTime.IsZero() {
 			timeNow := time.Now().UTC()
 			if timeNow.After(dbRes.ExpirationTime) {
-				return nil, errors.New("access token expired")
+				return nil, apperror.Unauthenticated("access token expired")
 			}
 		}
 		go func() {
@@ -109,6 +116,8 @@ func (i *applicationRepository) CheckApplication(ctx context.Context, tx *gorm.D
 				"version", dbRes.Version,
 				"description", dbRes.Description,
 				"expiration_time", dbRes.ExpirationTime.Format(time.RFC3339),
+				"max_sessions", strconv.Itoa(int(dbRes.MaxSessions)),
+				"scopes", dbRes.Scopes,
 				"create_time", dbRes.CreateTime.Format(time.RFC3339),
 				"update_time", dbRes.UpdateTime.Format(time.RFC3339),
 			}).Err()
@@ -124,7 +133,7 @@ func (i *applicationRepository) CheckApplication(ctx context.Context, tx *gorm.D
 		if !timeExp.IsZero() {
 			timeNow := time.Now().UTC()
 			if timeNow.After(timeExp) {
-				return nil, errors.New("access token expired")
+				return nil, apperror.Unauthenticated("access token expired")
 			}
 		}
 	}
@@ -132,12 +141,15 @@ func (i *applicationRepository) CheckApplication(ctx context.Context, tx *gorm.D
 	expirationTime, _ := time.Parse(time.RFC3339, cacheRes["expiration_time"])
 	createTime, _ := time.Parse(time.RFC3339, cacheRes["create_time"])
 	updateTime, _ := time.Parse(time.RFC3339, cacheRes["update_time"])
+	maxSessions, _ := strconv.Atoi(cacheRes["max_sessions"])
 	return &entity.Application{
 		ID:             &id,
 		Name:           cacheRes["name"],
 		Version:        cacheRes["version"],
-		Description:    cacheRes["version"],
+		Description:    cacheRes["description"],
 		ExpirationTime: expirationTime,
+		MaxSessions:    int32(maxSessions),
+		Scopes:         cacheRes["scopes"],
 		CreateTime:     createTime,
 		UpdateTime:     updateTime,
 	}, nil
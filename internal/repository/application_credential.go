@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ApplicationCredentialRepository interface {
+	CreateApplicationCredential(ctx context.Context, tx *gorm.DB, data *entity.ApplicationCredential) (*entity.ApplicationCredential, error)
+	GetApplicationCredential(ctx context.Context, tx *gorm.DB, id *uuid.UUID) (*entity.ApplicationCredential, error)
+	ListApplicationCredential(ctx context.Context, tx *gorm.DB, where *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error)
+	UpdateApplicationCredential(ctx context.Context, tx *gorm.DB, where *entity.ApplicationCredential, data *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error)
+	DeleteApplicationCredentialCache(ctx context.Context, ids []uuid.UUID)
+}
+
+// applicationCredentialCacheTTL bounds how long a credential changed by a
+// transaction that raced with a cache fill can still be read from the cache.
+const applicationCredentialCacheTTL = time.Minute * 5
+
+type applicationCredentialRepository struct{}
+
+func (i *applicationCredentialRepository) CreateApplicationCredential(ctx context.Context, tx *gorm.DB, data *entity.ApplicationCredential) (*entity.ApplicationCredential, error) {
+	return Datasource.NewApplicationCredentialDatasource().CreateApplicationCredential(tx, data)
+}
+
+func (i *applicationCredentialRepository) ListApplicationCredential(ctx context.Context, tx *gorm.DB, where *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error) {
+	return Datasource.NewApplicationCredentialDatasource().ListApplicationCredential(tx, where)
+}
+
+// GetApplicationCredential looks up the credential of an access token, it's
+// called on every request so the credential is cached.
+func (i *applicationCredentialRepository) GetApplicationCredential(ctx context.Context, tx *gorm.DB, id *uuid.UUID) (*entity.ApplicationCredential, error) {
+	cacheId := "application_credential:" + id.String()
+	cacheRes, cacheErr := Rdb.HGetAll(ctx, cacheId).Result()
+	// Check if exists in cache
+	if len(cacheRes) == 0 || cacheErr == redis.Nil {
+		dbRes, dbErr := Datasource.NewApplicationCredentialDatasource().GetApplicationCredential(tx, &entity.ApplicationCredential{ID: id})
+		if dbErr != nil {
+			return nil, dbErr
+		}
+		// Store in cache, the revoked credentials are read from the database so
+		// a revocation is never hidden by the cache
+		if dbRes.RevokeTime == nil {
+			var expirationTime string
+			if dbRes.ExpirationTime != nil {
+				expirationTime = dbRes.ExpirationTime.Format(time.RFC3339)
+			}
+			rdbPipe := Rdb.TxPipeline()
+			rdbPipe.HSet(ctx, cacheId, []string{
+				"id", dbRes.ID.String(),
+				"application_id", dbRes.ApplicationId.String(),
+				"secret_hash", dbRes.SecretHash,
+				"expiration_time", expirationTime,
+				"create_time", dbRes.CreateTime.Format(time.RFC3339),
+				"update_time", dbRes.UpdateTime.Format(time.RFC3339),
+			})
+			rdbPipe.Expire(ctx, cacheId, applicationCredentialCacheTTL)
+			if _, cacheErr := rdbPipe.Exec(ctx); cacheErr != nil {
+				log.Error(cacheErr)
+			}
+		}
+		return dbRes, nil
+	}
+	credentialId := uuid.MustParse(cacheRes["id"])
+	applicationId := uuid.MustParse(cacheRes["application_id"])
+	createTime, _ := time.Parse(time.RFC3339, cacheRes["create_time"])
+	updateTime, _ := time.Parse(time.RFC3339, cacheRes["update_time"])
+	res := &entity.ApplicationCredential{
+		ID:            &credentialId,
+		ApplicationId: &applicationId,
+		SecretHash:    cacheRes["secret_hash"],
+		CreateTime:    createTime,
+		UpdateTime:    updateTime,
+	}
+	if expirationTime, err := time.Parse(time.RFC3339, cacheRes["expiration_time"]); err == nil {
+		res.ExpirationTime = &expirationTime
+	}
+	return res, nil
+}
+
+// UpdateApplicationCredential doesn't touch the cache, the caller deletes it
+// with DeleteApplicationCredentialCache once the transaction is committed,
+// before that a concurrent read could cache the old row again.
+func (i *applicationCredentialRepository) UpdateApplicationCredential(ctx context.Context, tx *gorm.DB, where *entity.ApplicationCredential, data *entity.ApplicationCredential) (*[]entity.ApplicationCredential, error) {
+	return Datasource.NewApplicationCredentialDatasource().UpdateApplicationCredential(tx, where, data)
+}
+
+func (i *applicationCredentialRepository) DeleteApplicationCredentialCache(ctx context.Context, ids []uuid.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+	rdbPipe := Rdb.Pipeline()
+	for _, id := range ids {
+		rdbPipe.Del(ctx, "application_credential:"+id.String())
+	}
+	_, err := rdbPipe.Exec(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+}
@@ -13,13 +13,23 @@ import (
 
 type AuthorizationTokenRepository interface {
 	GetAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken) (*entity.AuthorizationToken, error)
+	ListAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken) (*[]entity.AuthorizationToken, error)
 	CreateAuthorizationToken(ctx context.Context, tx *gorm.DB, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error)
 	DeleteAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error)
 	DeleteAuthorizationTokenByRefreshTokenIds(ctx context.Context, tx *gorm.DB, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error)
+	TouchAuthorizationToken(ctx context.Context, tx *gorm.DB, id *uuid.UUID, ip string) error
 }
 
 type authorizationTokenRepository struct{}
 
+func (v *authorizationTokenRepository) ListAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken) (*[]entity.AuthorizationToken, error) {
+	res, err := Datasource.NewAuthorizationTokenDatasource().ListAuthorizationToken(tx, where)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (v *authorizationTokenRepository) CreateAuthorizationToken(ctx context.Context, tx *gorm.DB, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error) {
 	// Store in the database
 	dbRes, dbErr := Datasource.NewAuthorizationTokenDatasource().CreateAuthorizationToken(tx, data)
@@ -151,3 +161,17 @@ func (v *authorizationTokenRepository) GetAuthorizationToken(ctx context.Context
 		}, nil
 	}
 }
+
+// TouchAuthorizationToken records the last activity of the session. It
+// writes at most once a minute by session, the cache remembers the last write.
+func (r *authorizationTokenRepository) TouchAuthorizationToken(ctx context.Context, tx *gorm.DB, id *uuid.UUID, ip string) error {
+	touched, err := Rdb.SetNX(ctx, "authorization_token_seen:"+id.String(), ip, time.Minute).Result()
+	if err != nil {
+		return err
+	} else if !touched {
+		return nil
+	}
+	lastSeenTime := time.Now().UTC()
+	_, err = Datasource.NewAuthorizationTokenDatasource().UpdateAuthorizationToken(tx, &entity.AuthorizationToken{ID: id}, &entity.AuthorizationToken{LastSeenTime: &lastSeenTime, LastSeenIp: ip})
+	return err
+}
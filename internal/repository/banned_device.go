@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// bannedDeviceCacheTtl bounds how long a ban, or the lack of one, is served
+// from the cache.
+const bannedDeviceCacheTtl = time.Minute
+
+type BannedDeviceRepository interface {
+	CreateBannedDevice(ctx context.Context, tx *gorm.DB, data *entity.BannedDevice) (*entity.BannedDevice, error)
+	GetActiveBannedDevice(ctx context.Context, tx *gorm.DB, deviceIdentifier string) (*entity.BannedDevice, error)
+	DeleteBannedDevice(ctx context.Context, tx *gorm.DB, where *entity.BannedDevice) (*[]entity.BannedDevice, error)
+}
+
+type bannedDeviceRepository struct{}
+
+func (i *bannedDeviceRepository) CreateBannedDevice(ctx context.Context, tx *gorm.DB, data *entity.BannedDevice) (*entity.BannedDevice, error) {
+	dbRes, dbErr := Datasource.NewBannedDeviceDatasource().CreateBannedDevice(tx, data)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	cacheErr := Rdb.Del(ctx, "banned_device:"+dbRes.DeviceIdentifier).Err()
+	if cacheErr != nil {
+		log.Error(cacheErr)
+	}
+	return dbRes, nil
+}
+
+// GetActiveBannedDevice is called on every authenticated request, so the
+// result is cached, the lack of a ban too.
+func (i *bannedDeviceRepository) GetActiveBannedDevice(ctx context.Context, tx *gorm.DB, deviceIdentifier string) (*entity.BannedDevice, error) {
+	cacheId := "banned_device:" + deviceIdentifier
+	cacheRes, cacheErr := Rdb.HGetAll(ctx, cacheId).Result()
+	now := time.Now().UTC()
+	// Check if exists in cache
+	if len(cacheRes) == 0 || cacheErr != nil {
+		dbRes, dbErr := Datasource.NewBannedDeviceDatasource().GetActiveBannedDevice(tx, &entity.BannedDevice{DeviceIdentifier: deviceIdentifier}, now)
+		if dbErr != nil && !apperror.IsNotFound(dbErr) {
+			return nil, dbErr
+		}
+		// Store in cache
+		go func() {
+			ctx := context.Background()
+			fields := []string{"id", ""}
+			ttl := bannedDeviceCacheTtl
+			if dbRes != nil {
+				var expirationTime string
+				if dbRes.ExpirationTime != nil {
+					expirationTime = dbRes.ExpirationTime.Format(time.RFC3339)
+					if untilExpiration := dbRes.ExpirationTime.Sub(now); untilExpiration < ttl {
+						ttl = untilExpiration
+					}
+				}
+				fields = []string{
+					"id", dbRes.ID.String(),
+					"device_identifier", dbRes.DeviceIdentifier,
+					"reason", dbRes.Reason,
+					"moderator_id", dbRes.ModeratorId.String(),
+					"expiration_time", expirationTime,
+					"create_time", dbRes.CreateTime.Format(time.RFC3339),
+					"update_time", dbRes.UpdateTime.Format(time.RFC3339),
+				}
+			}
+			cacheErr := Rdb.HSet(ctx, cacheId, fields).Err()
+			if cacheErr != nil {
+				log.Error(cacheErr)
+			} else {
+				Rdb.Expire(ctx, cacheId, ttl)
+			}
+		}()
+		if dbRes == nil {
+			return nil, apperror.ErrNotFound
+		}
+		return dbRes, nil
+	}
+	if cacheRes["id"] == "" {
+		return nil, apperror.ErrNotFound
+	}
+	banId := uuid.MustParse(cacheRes["id"])
+	moderatorId := uuid.MustParse(cacheRes["moderator_id"])
+	createTime, _ := time.Parse(time.RFC3339, cacheRes["create_time"])
+	updateTime, _ := time.Parse(time.RFC3339, cacheRes["update_time"])
+	res := &entity.BannedDevice{
+		ID:               &banId,
+		DeviceIdentifier: cacheRes["device_identifier"],
+		Reason:           cacheRes["reason"],
+		ModeratorId:      &moderatorId,
+		CreateTime:       createTime,
+		UpdateTime:       updateTime,
+	}
+	if expirationTime, err := time.Parse(time.RFC3339, cacheRes["expiration_time"]); err == nil {
+		if !now.Before(expirationTime) {
+			return nil, apperror.ErrNotFound
+		}
+		res.ExpirationTime = &expirationTime
+	}
+	return res, nil
+}
+
+func (i *bannedDeviceRepository) DeleteBannedDevice(ctx context.Context, tx *gorm.DB, where *entity.BannedDevice) (*[]entity.BannedDevice, error) {
+	// Delete in database
+	dbRes, dbErr := Datasource.NewBannedDeviceDatasource().DeleteBannedDevice(tx, where)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	rdbPipe := Rdb.Pipeline()
+	for _, item := range *dbRes {
+		rdbPipe.Del(ctx, "banned_device:"+item.DeviceIdentifier)
+	}
+	_, err := rdbPipe.Exec(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+	return dbRes, nil
+}
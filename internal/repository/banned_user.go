@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// bannedUserCacheTtl bounds how long a ban, or the lack of one, is served
+// from the cache.
+const bannedUserCacheTtl = time.Minute
+
+type BannedUserRepository interface {
+	CreateBannedUser(ctx context.Context, tx *gorm.DB, data *entity.BannedUser) (*entity.BannedUser, error)
+	GetActiveBannedUser(ctx context.Context, tx *gorm.DB, userId *uuid.UUID) (*entity.BannedUser, error)
+	DeleteBannedUser(ctx context.Context, tx *gorm.DB, where *entity.BannedUser) (*[]entity.BannedUser, error)
+}
+
+type bannedUserRepository struct{}
+
+func (i *bannedUserRepository) CreateBannedUser(ctx context.Context, tx *gorm.DB, data *entity.BannedUser) (*entity.BannedUser, error) {
+	dbRes, dbErr := Datasource.NewBannedUserDatasource().CreateBannedUser(tx, data)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	cacheErr := Rdb.Del(ctx, "banned_user:"+dbRes.UserId.String()).Err()
+	if cacheErr != nil {
+		log.Error(cacheErr)
+	}
+	return dbRes, nil
+}
+
+// GetActiveBannedUser is called on every authenticated request, so the
+// result is cached, the lack of a ban too.
+func (i *bannedUserRepository) GetActiveBannedUser(ctx context.Context, tx *gorm.DB, userId *uuid.UUID) (*entity.BannedUser, error) {
+	cacheId := "banned_user:" + userId.String()
+	cacheRes, cacheErr := Rdb.HGetAll(ctx, cacheId).Result()
+	now := time.Now().UTC()
+	// Check if exists in cache
+	if len(cacheRes) == 0 || cacheErr != nil {
+		dbRes, dbErr := Datasource.NewBannedUserDatasource().GetActiveBannedUser(tx, &entity.BannedUser{UserId: userId}, now)
+		if dbErr != nil && !apperror.IsNotFound(dbErr) {
+			return nil, dbErr
+		}
+		// Store in cache
+		go func() {
+			ctx := context.Background()
+			fields := []string{"id", ""}
+			ttl := bannedUserCacheTtl
+			if dbRes != nil {
+				var expirationTime string
+				if dbRes.ExpirationTime != nil {
+					expirationTime = dbRes.ExpirationTime.Format(time.RFC3339)
+					if untilExpiration := dbRes.ExpirationTime.Sub(now); untilExpiration < ttl {
+						ttl = untilExpiration
+					}
+				}
+				fields = []string{
+					"id", dbRes.ID.String(),
+					"user_id", dbRes.UserId.String(),
+					"reason", dbRes.Reason,
+					"moderator_id", dbRes.ModeratorId.String(),
+					"expiration_time", expirationTime,
+					"create_time", dbRes.CreateTime.Format(time.RFC3339),
+					"update_time", dbRes.UpdateTime.Format(time.RFC3339),
+				}
+			}
+			cacheErr := Rdb.HSet(ctx, cacheId, fields).Err()
+			if cacheErr != nil {
+				log.Error(cacheErr)
+			} else {
+				Rdb.Expire(ctx, cacheId, ttl)
+			}
+		}()
+		if dbRes == nil {
+			return nil, apperror.ErrNotFound
+		}
+		return dbRes, nil
+	}
+	if cacheRes["id"] == "" {
+		return nil, apperror.ErrNotFound
+	}
+	banId := uuid.MustParse(cacheRes["id"])
+	bannedUserId := uuid.MustParse(cacheRes["user_id"])
+	moderatorId := uuid.MustParse(cacheRes["moderator_id"])
+	createTime, _ := time.Parse(time.RFC3339, cacheRes["create_time"])
+	updateTime, _ := time.Parse(time.RFC3339, cacheRes["update_time"])
+	res := &entity.BannedUser{
+		ID:          &banId,
+		UserId:      &bannedUserId,
+		Reason:      cacheRes["reason"],
+		ModeratorId: &moderatorId,
+		CreateTime:  createTime,
+		UpdateTime:  updateTime,
+	}
+	if expirationTime, err := time.Parse(time.RFC3339, cacheRes["expiration_time"]); err == nil {
+		if !now.Before(expirationTime) {
+			return nil, apperror.ErrNotFound
+		}
+		res.ExpirationTime = &expirationTime
+	}
+	return res, nil
+}
+
+func (i *bannedUserRepository) DeleteBannedUser(ctx context.Context, tx *gorm.DB, where *entity.BannedUser) (*[]entity.BannedUser, error) {
+	// Delete in database
+	dbRes, dbErr := Datasource.NewBannedUserDatasource().DeleteBannedUser(tx, where)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	rdbPipe := Rdb.Pipeline()
+	for _, item := range *dbRes {
+		rdbPipe.Del(ctx, "banned_user:"+item.UserId.String())
+	}
+	_, err := rdbPipe.Exec(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+	return dbRes, nil
+}
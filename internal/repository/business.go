@@ -1,10 +1,11 @@
 package repository
 
 import (
-	// "context"
+	"context"
 	// "strconv"
 	// "time"
 
+	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/go-redis/redis/v9"
 	"github.com/google/uuid"
@@ -14,7 +15,7 @@ import (
 )
 
 type BusinessRepository interface {
-	Feed(tx *gorm.DB, coordinates ewkb.Point, limit int32, provinceId string, municipalityId string, cursor int32, municipalityNotEqual bool, homeDelivery bool, toPickUp bool) (*[]entity.Business, error)
+	Feed(tx *gorm.DB, feed *datasource.BusinessFeed) (*[]datasource.BusinessFeedResult, error)
 	CreateBusiness(tx *gorm.DB, data *entity.Business) (*entity.Business, error)
 	GetBusinessPreloadSchedule(tx *gorm.DB, where *entity.Business) (*entity.Business, error)
 	GetBusiness(tx *gorm.DB, where *entity.Business) (*entity.Business, error)
@@ -23,6 +24,12 @@ type BusinessRepository interface {
 	UpdateBusiness(tx *gorm.DB, data *entity.Business, where *entity.Business) (*entity.Business, error)
 	UpdateBusinessCoordinate(tx *gorm.DB, data *entity.Business, where *entity.Business) error
 	BusinessIsInRange(tx *gorm.DB, coordinates ewkb.Point, businessId *uuid.UUID) (*bool, error)
+	SearchBusiness(tx *gorm.DB, search *datasource.BusinessSearch) (*[]datasource.BusinessSearchResult, error)
+	ListBusinessInBounds(tx *gorm.DB, bounds *datasource.BusinessBounds, limit int) (*[]entity.Business, error)
+	ClusterBusinessInBounds(tx *gorm.DB, bounds *datasource.BusinessBounds, cellSize float64) (*[]datasource.BusinessCluster, error)
+	CheckBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*datasource.BusinessDeliveryZoneCheck, error)
+	UpdateBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*datasource.BusinessDeliveryZone, error)
+	GetBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID) (*datasource.BusinessDeliveryZone, error)
 }
 
 type businessRepository struct {
@@ -63,6 +70,7 @@ func (b *businessRepository) CreateBusiness(tx *gorm.DB, data *entity.Business)
 	if err != nil {
 		return nil, err
 	}
+	indexSearchSuggestion(context.Background(), entity.SearchSuggestion{Type: entity.SearchSuggestionTypeBusiness, Id: res.ID, Text: res.Name, ProvinceId: res.ProvinceId, MunicipalityId: res.MunicipalityId})
 	return res, nil
 }
 
@@ -71,6 +79,7 @@ func (b *businessRepository) UpdateBusiness(tx *gorm.DB, data *entity.Business,
 	if err != nil {
 		return nil, err
 	}
+	indexSearchSuggestion(context.Background(), entity.SearchSuggestion{Type: entity.SearchSuggestionTypeBusiness, Id: res.ID, Text: res.Name, ProvinceId: res.ProvinceId, MunicipalityId: res.MunicipalityId})
 	return res, nil
 }
 
@@ -82,8 +91,8 @@ func (b *businessRepository) UpdateBusinessCoordinate(tx *gorm.DB, data *entity.
 	return nil
 }
 
-func (b *businessRepository) Feed(tx *gorm.DB, coordinates ewkb.Point, limit int32, provinceId string, municipalityId string, cursor int32, municipalityNotEqual bool, homeDelivery bool, toPickUp bool) (*[]entity.Business, error) {
-	result, err := Datasource.NewBusinessDatasource().Feed(tx, coordinates, limit, provinceId, municipalityId, cursor, municipalityNotEqual, homeDelivery, toPickUp)
+func (b *businessRepository) Feed(tx *gorm.DB, feed *datasource.BusinessFeed) (*[]datasource.BusinessFeedResult, error) {
+	result, err := Datasource.NewBusinessDatasource().Feed(tx, feed)
 	if err != nil {
 		return nil, err
 	}
@@ -121,3 +130,51 @@ func (i *businessRepository) GetBusinessPreloadSchedule(tx *gorm.DB, where *enti
 	}
 	return result, nil
 }
+
+func (b *businessRepository) SearchBusiness(tx *gorm.DB, search *datasource.BusinessSearch) (*[]datasource.BusinessSearchResult, error) {
+	res, err := Datasource.NewBusinessDatasource().SearchBusiness(tx, search)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *businessRepository) ListBusinessInBounds(tx *gorm.DB, bounds *datasource.BusinessBounds, limit int) (*[]entity.Business, error) {
+	res, err := Datasource.NewBusinessDatasource().ListBusinessInBounds(tx, bounds, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *businessRepository) ClusterBusinessInBounds(tx *gorm.DB, bounds *datasource.BusinessBounds, cellSize float64) (*[]datasource.BusinessCluster, error) {
+	res, err := Datasource.NewBusinessDatasource().ClusterBusinessInBounds(tx, bounds, cellSize)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *businessRepository) CheckBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*datasource.BusinessDeliveryZoneCheck, error) {
+	res, err := Datasource.NewBusinessDatasource().CheckBusinessDeliveryZone(tx, businessId, zone)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *businessRepository) UpdateBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone string) (*datasource.BusinessDeliveryZone, error) {
+	res, err := Datasource.NewBusinessDatasource().UpdateBusinessDeliveryZone(tx, businessId, zone)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *businessRepository) GetBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID) (*datasource.BusinessDeliveryZone, error) {
+	res, err := Datasource.NewBusinessDatasource().GetBusinessDeliveryZone(tx, businessId)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
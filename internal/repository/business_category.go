@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -28,6 +30,7 @@ func (v *businessCategoryRepository) CreateBusinessCategory(tx *gorm.DB, data *e
 	if err != nil {
 		return nil, err
 	}
+	indexSearchSuggestion(context.Background(), entity.SearchSuggestion{Type: entity.SearchSuggestionTypeCategory, Id: res.ID, Text: res.Name, ProvinceId: res.ProvinceId, MunicipalityId: res.MunicipalityId})
 	return res, nil
 }
 
@@ -44,5 +47,10 @@ func (v *businessCategoryRepository) DeleteBusinessCategory(tx *gorm.DB, where *
 	if err != nil {
 		return nil, err
 	}
+	deleted := make([]uuid.UUID, 0, len(*res))
+	for _, item := range *res {
+		deleted = append(deleted, *item.ID)
+	}
+	deleteSearchSuggestion(context.Background(), entity.SearchSuggestionTypeCategory, deleted)
 	return res, nil
 }
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"gorm.io/gorm"
 )
@@ -20,10 +23,15 @@ func (i *businessScheduleRepository) GetBusinessSchedule(tx *gorm.DB, where *ent
 	return result, nil
 }
 
+// BusinessIsOpen returns datasource.ErrBusinessClosed when the business is
+// closed at the moment.
 func (i *businessScheduleRepository) BusinessIsOpen(tx *gorm.DB, where *entity.BusinessSchedule) (bool, error) {
-	result, err := Datasource.NewBusinessScheduleDatasource().BusinessIsOpen(tx, where)
+	schedule, err := Datasource.NewBusinessScheduleDatasource().GetBusinessSchedule(tx, where)
 	if err != nil {
 		return false, err
 	}
-	return result, nil
+	if !schedule.IsOpenAt(time.Now()) {
+		return false, datasource.ErrBusinessClosed
+	}
+	return true, nil
 }
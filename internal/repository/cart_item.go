@@ -17,10 +17,19 @@ type CartItemRepository interface {
 	GetCartItem(tx *gorm.DB, where *entity.CartItem) (*entity.CartItem, error)
 	DeleteCartItem(tx *gorm.DB, where *entity.CartItem, ids *[]uuid.UUID) (*[]entity.CartItem, error)
 	CartItemIsEmpty(tx *gorm.DB, where *entity.CartItem) (*bool, error)
+	ListExpiredCartItem(tx *gorm.DB, now time.Time, limit int) (*[]entity.CartItem, error)
 }
 
 type cartItemRepository struct{}
 
+func (i *cartItemRepository) ListExpiredCartItem(tx *gorm.DB, now time.Time, limit int) (*[]entity.CartItem, error) {
+	res, err := Datasource.NewCartItemDatasource().ListExpiredCartItem(tx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (i *cartItemRepository) ListCartItem(tx *gorm.DB, where *entity.CartItem, cursor *time.Time) (*[]entity.CartItem, error) {
 	res, err := Datasource.NewCartItemDatasource().ListCartItem(tx, where, cursor)
 	if err != nil {
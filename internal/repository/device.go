@@ -15,6 +15,7 @@ type DeviceRepository interface {
 	GetDevice(ctx context.Context, tx *gorm.DB, where *entity.Device) (*entity.Device, error)
 	CreateDevice(ctx context.Context, tx *gorm.DB, data *entity.Device) (*entity.Device, error)
 	UpdateDevice(ctx context.Context, tx *gorm.DB, where *entity.Device, data *entity.Device) (*entity.Device, error)
+	ListDeviceInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.Device, error)
 }
 
 type deviceRepository struct{}
@@ -120,3 +121,11 @@ func (v *deviceRepository) UpdateDevice(ctx context.Context, tx *gorm.DB, where
 	}
 	return dbRes, nil
 }
+
+func (i *deviceRepository) ListDeviceInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.Device, error) {
+	res, err := Datasource.NewDeviceDatasource().ListDeviceInIds(tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
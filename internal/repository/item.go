@@ -2,10 +2,13 @@ package repository
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"github.com/go-redis/redis/v9"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -17,11 +20,12 @@ type ItemRepository interface {
 	ListItem(ctx context.Context, tx *gorm.DB, where *entity.ItemBusiness, cursor time.Time) (*[]entity.ItemBusiness, error)
 	ListItemInIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) (*[]entity.ItemBusiness, error)
 	CreateItem(ctx context.Context, tx *gorm.DB, data *entity.ItemBusiness) (*entity.ItemBusiness, error)
-	SearchItem(ctx context.Context, tx *gorm.DB, name string, provinceId string, municipalityId string, cursor int64, municipalityNotEqual bool, limit int64) (*[]entity.ItemBusiness, error)
-	SearchItemByBusiness(ctx context.Context, tx *gorm.DB, name string, cursor int64, businessId string) (*[]entity.ItemBusiness, error)
+	SearchItem(ctx context.Context, tx *gorm.DB, search *datasource.ItemSearch) (*[]datasource.ItemSearchResult, error)
 	UpdateItem(ctx context.Context, tx *gorm.DB, where *entity.ItemBusiness, data *entity.ItemBusiness) (*entity.ItemBusiness, error)
 	UpdateItems(ctx context.Context, tx *gorm.DB, data *[]entity.ItemBusiness) (*[]entity.ItemBusiness, error)
 	DeleteItem(ctx context.Context, tx *gorm.DB, where *entity.ItemBusiness, ids *[]uuid.UUID) (*[]entity.ItemBusiness, error)
+	RestockItems(ctx context.Context, tx *gorm.DB, quantities map[uuid.UUID]int64) error
+	GetItemAvailabilityForUpdate(ctx context.Context, tx *gorm.DB, id *uuid.UUID) (int64, error)
 }
 
 type itemRepository struct{}
@@ -45,6 +49,11 @@ func (v *itemRepository) DeleteItem(ctx context.Context, tx *gorm.DB, where *ent
 		if err != nil {
 			log.Error(err)
 		}
+		ids := make([]uuid.UUID, 0, len(*dbRes))
+		for _, item := range *dbRes {
+			ids = append(ids, *item.ID)
+		}
+		deleteSearchSuggestion(ctx, entity.SearchSuggestionTypeItem, ids)
 	}
 	return dbRes, nil
 }
@@ -55,6 +64,7 @@ func (v *itemRepository) CreateItem(ctx context.Context, tx *gorm.DB, data *enti
 	if dbErr != nil {
 		return nil, dbErr
 	} else {
+		indexItemSearchSuggestion(ctx, []entity.ItemBusiness{*dbRes})
 		// Store in cache
 		go func() {
 			ctx := context.Background()
@@ -103,6 +113,37 @@ func (i *itemRepository) ListItem(ctx context.Context, tx *gorm.DB, where *entit
 	return dbRes, nil
 }
 
+// RestockItems gives the quantities back to the availability of the items,
+// the deleted items are skipped. The items are updated in the order of their
+// ids so concurrent restocks don't deadlock.
+func (i *itemRepository) RestockItems(ctx context.Context, tx *gorm.DB, quantities map[uuid.UUID]int64) error {
+	ids := make([]uuid.UUID, 0, len(quantities))
+	for id := range quantities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(a, b int) bool { return ids[a].String() < ids[b].String() })
+	rdbPipe := Rdb.Pipeline()
+	for _, id := range ids {
+		err := Datasource.NewItemDatasource().RestockItem(tx, id, quantities[id])
+		if apperror.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		// The cache is filled again from the database
+		rdbPipe.Del(ctx, "item:"+id.String())
+	}
+	_, err := rdbPipe.Exec(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+	return nil
+}
+
+func (i *itemRepository) GetItemAvailabilityForUpdate(ctx context.Context, tx *gorm.DB, id *uuid.UUID) (int64, error) {
+	return Datasource.NewItemDatasource().GetItemAvailabilityForUpdate(tx, *id)
+}
+
 func (i *itemRepository) ListItemInIds(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) (*[]entity.ItemBusiness, error) {
 	// Delete in database
 	dbRes, dbErr := Datasource.NewItemDatasource().ListItemInIds(tx, ids)
@@ -207,6 +248,7 @@ func (i *itemRepository) UpdateItem(ctx context.Context, tx *gorm.DB, where *ent
 	if dbErr != nil {
 		return nil, dbErr
 	} else {
+		indexItemSearchSuggestion(ctx, []entity.ItemBusiness{*dbRes})
 		// Store in cache
 		cacheId := "item:" + dbRes.ID.String()
 		cacheErr := Rdb.HSet(ctx, cacheId, []string{
@@ -250,6 +292,7 @@ func (i *itemRepository) UpdateItems(ctx context.Context, tx *gorm.DB, data *[]e
 	if dbErr != nil {
 		return nil, dbErr
 	} else {
+		indexItemSearchSuggestion(ctx, *dbRes)
 		// Update in cache
 		rdbPipe := Rdb.Pipeline()
 		for _, item := range *dbRes {
@@ -294,16 +337,8 @@ func (i *itemRepository) UpdateItems(ctx context.Context, tx *gorm.DB, data *[]e
 	return dbRes, nil
 }
 
-func (i *itemRepository) SearchItem(ctx context.Context, tx *gorm.DB, name string, provinceId string, municipalityId string, cursor int64, municipalityNotEqual bool, limit int64) (*[]entity.ItemBusiness, error) {
-	res, err := Datasource.NewItemDatasource().SearchItem(tx, name, provinceId, municipalityId, cursor, municipalityNotEqual, limit)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-func (i *itemRepository) SearchItemByBusiness(ctx context.Context, tx *gorm.DB, name string, cursor int64, businessId string) (*[]entity.ItemBusiness, error) {
-	res, err := Datasource.NewItemDatasource().SearchItemByBusiness(tx, name, cursor, businessId)
+func (i *itemRepository) SearchItem(ctx context.Context, tx *gorm.DB, search *datasource.ItemSearch) (*[]datasource.ItemSearchResult, error) {
+	res, err := Datasource.NewItemDatasource().SearchItem(tx, search)
 	if err != nil {
 		return nil, err
 	}
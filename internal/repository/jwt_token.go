@@ -1,29 +1,20 @@
 package repository
 
 import (
-	"time"
-
 	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/pkg/keyring"
 )
 
 type JwtTokenRepository interface {
 	CreateJwtRefreshToken(tokenMetadata *datasource.JsonWebTokenMetadata) error
-	CreateJwtAccessToken(tokenMetadata *datasource.JsonWebTokenMetadata, expirationTime *time.Time) error
 	CreateJwtAuthorizationToken(tokenMetadata *datasource.JsonWebTokenMetadata) error
 	ParseJwtRefreshToken(tokenMetadata *datasource.JsonWebTokenMetadata) error
 	ParseJwtAuthorizationToken(tokenMetadata *datasource.JsonWebTokenMetadata) error
+	ListJwk() []keyring.JWK
 }
 
 type jwtTokenRepository struct{}
 
-func (v *jwtTokenRepository) CreateJwtAccessToken(tokenMetadata *datasource.JsonWebTokenMetadata, expirationTime *time.Time) error {
-	err := Datasource.NewJwtTokenDatasource().CreateJwtAccessToken(tokenMetadata, expirationTime)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 func (v *jwtTokenRepository) CreateJwtRefreshToken(tokenMetadata *datasource.JsonWebTokenMetadata) error {
 	err := Datasource.NewJwtTokenDatasource().CreateJwtRefreshToken(tokenMetadata)
 	if err != nil {
@@ -55,3 +46,7 @@ func (r *jwtTokenRepository) ParseJwtAuthorizationToken(tokenMetadata *datasourc
 	}
 	return nil
 }
+
+func (r *jwtTokenRepository) ListJwk() []keyring.JWK {
+	return Datasource.NewJwtTokenDatasource().ListJwk()
+}
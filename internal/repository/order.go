@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -14,10 +17,28 @@ type OrderRepository interface {
 	UpdateOrder(tx *gorm.DB, where *entity.Order, data *entity.Order) (*entity.Order, error)
 	GetOrder(tx *gorm.DB, where *entity.Order) (*entity.Order, error)
 	ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*bool, error)
+	ListExpiredOrder(tx *gorm.DB, now time.Time, limit int) (*[]entity.Order, error)
+	ListBusinessOrder(tx *gorm.DB, filter *datasource.BusinessOrderFilter) (*[]entity.Order, error)
 }
 
 type orderRepository struct{}
 
+func (i *orderRepository) ListBusinessOrder(tx *gorm.DB, filter *datasource.BusinessOrderFilter) (*[]entity.Order, error) {
+	res, err := Datasource.NewOrderDatasource().ListBusinessOrder(tx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *orderRepository) ListExpiredOrder(tx *gorm.DB, now time.Time, limit int) (*[]entity.Order, error) {
+	res, err := Datasource.NewOrderDatasource().ListExpiredOrder(tx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (i *orderRepository) ExistsUpcomingOrders(tx *gorm.DB, userId uuid.UUID) (*bool, error) {
 	res, err := Datasource.NewOrderDatasource().ExistsUpcomingOrders(tx, userId)
 	if err != nil {
@@ -1,16 +1,141 @@
 package repository
 
 import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type OrderLifecycleRepository interface {
 	CreateOrderLifecycle(tx *gorm.DB, data *entity.OrderLifecycle) (*entity.OrderLifecycle, error)
+	ListOrderLifecycle(tx *gorm.DB, where *entity.OrderLifecycle) (*[]entity.OrderLifecycle, error)
+	PublishOrderLifecycle(ctx context.Context, data *entity.OrderLifecycle) error
+	SubscribeOrderLifecycle(ctx context.Context, orderId *uuid.UUID) (*OrderLifecycleSubscription, error)
 }
 
 type orderLifecycleRepository struct{}
 
+type orderLifecycleMessage struct {
+	ID         *uuid.UUID `json:"id"`
+	Status     string     `json:"status"`
+	OrderId    *uuid.UUID `json:"order_id"`
+	CreateTime time.Time  `json:"create_time"`
+}
+
+// OrderLifecycleSubscription delivers the lifecycles published for an order
+// until it is closed. C is closed too when the watcher falls behind, the
+// watcher has to read the history again.
+type OrderLifecycleSubscription struct {
+	orderId uuid.UUID
+	c       chan *entity.OrderLifecycle
+	closed  bool
+	C       <-chan *entity.OrderLifecycle
+}
+
+func (s *OrderLifecycleSubscription) Close() error {
+	orderLifecycleWatchers.remove(s)
+	return nil
+}
+
+// orderLifecycleWatcherBuffer is how many lifecycles a watcher can fall
+// behind, an order only has a few.
+const orderLifecycleWatcherBuffer = 16
+
+// orderLifecycleHub shares one Redis subscription to the lifecycles of every
+// order among the watchers of the process, it hands each lifecycle to the
+// watchers of its order.
+type orderLifecycleHub struct {
+	mu       sync.Mutex
+	pubsub   *redis.PubSub
+	watchers map[uuid.UUID]map[*OrderLifecycleSubscription]struct{}
+}
+
+var orderLifecycleWatchers = &orderLifecycleHub{watchers: map[uuid.UUID]map[*OrderLifecycleSubscription]struct{}{}}
+
+// add registers a watcher of the order, it subscribes the process the first
+// time and returns once the subscription is active.
+func (h *orderLifecycleHub) add(ctx context.Context, orderId uuid.UUID) (*OrderLifecycleSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.pubsub == nil {
+		pubsub := Rdb.PSubscribe(context.Background(), orderLifecycleChannel(nil))
+		_, err := pubsub.Receive(ctx)
+		if err != nil {
+			pubsub.Close()
+			return nil, err
+		}
+		h.pubsub = pubsub
+		go h.run(pubsub.Channel())
+	}
+	c := make(chan *entity.OrderLifecycle, orderLifecycleWatcherBuffer)
+	subscription := &OrderLifecycleSubscription{orderId: orderId, c: c, C: c}
+	if h.watchers[orderId] == nil {
+		h.watchers[orderId] = map[*OrderLifecycleSubscription]struct{}{}
+	}
+	h.watchers[orderId][subscription] = struct{}{}
+	return subscription, nil
+}
+
+func (h *orderLifecycleHub) remove(subscription *OrderLifecycleSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.close(subscription)
+}
+
+// close must be called with the lock held.
+func (h *orderLifecycleHub) close(subscription *OrderLifecycleSubscription) {
+	if subscription.closed {
+		return
+	}
+	subscription.closed = true
+	close(subscription.c)
+	delete(h.watchers[subscription.orderId], subscription)
+	if len(h.watchers[subscription.orderId]) == 0 {
+		delete(h.watchers, subscription.orderId)
+	}
+}
+
+func (h *orderLifecycleHub) run(messages <-chan *redis.Message) {
+	for msg := range messages {
+		var data orderLifecycleMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &data); err != nil || data.OrderId == nil {
+			log.Errorf("order lifecycle message %q: %v", msg.Payload, err)
+			continue
+		}
+		h.dispatch(&entity.OrderLifecycle{ID: data.ID, Status: data.Status, OrderId: data.OrderId, CreateTime: data.CreateTime})
+	}
+}
+
+// dispatch never blocks on a watcher, the one that falls behind is closed.
+func (h *orderLifecycleHub) dispatch(item *entity.OrderLifecycle) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for subscription := range h.watchers[*item.OrderId] {
+		select {
+		case subscription.c <- item:
+		default:
+			log.Warnf("order lifecycle watcher of the order %s fell behind", item.OrderId)
+			h.close(subscription)
+		}
+	}
+}
+
+// orderLifecycleChannel is the channel of the order, or the pattern of the
+// channels of every order when it's nil.
+func orderLifecycleChannel(orderId *uuid.UUID) string {
+	if orderId == nil {
+		return "order_lifecycle:*"
+	}
+	return "order_lifecycle:" + orderId.String()
+}
+
 func (v *orderLifecycleRepository) CreateOrderLifecycle(tx *gorm.DB, data *entity.OrderLifecycle) (*entity.OrderLifecycle, error) {
 	res, err := Datasource.NewOrderLifecycleDatasource().CreateOrderLifecycle(tx, data)
 	if err != nil {
@@ -18,3 +143,29 @@ func (v *orderLifecycleRepository) CreateOrderLifecycle(tx *gorm.DB, data *entit
 	}
 	return res, nil
 }
+
+func (v *orderLifecycleRepository) ListOrderLifecycle(tx *gorm.DB, where *entity.OrderLifecycle) (*[]entity.OrderLifecycle, error) {
+	res, err := Datasource.NewOrderLifecycleDatasource().ListOrderLifecycle(tx, where)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PublishOrderLifecycle announces a status change of an order to the
+// subscribers of the order in every replica. It must be called after the
+// transaction that created the lifecycle is committed.
+func (v *orderLifecycleRepository) PublishOrderLifecycle(ctx context.Context, data *entity.OrderLifecycle) error {
+	payload, err := json.Marshal(&orderLifecycleMessage{ID: data.ID, Status: data.Status, OrderId: data.OrderId, CreateTime: data.CreateTime})
+	if err != nil {
+		return err
+	}
+	return Rdb.Publish(ctx, orderLifecycleChannel(data.OrderId), payload).Err()
+}
+
+// SubscribeOrderLifecycle returns once the subscription is active, so no
+// change published afterwards is missed. The watchers of the process share a
+// single Redis subscription.
+func (v *orderLifecycleRepository) SubscribeOrderLifecycle(ctx context.Context, orderId *uuid.UUID) (*OrderLifecycleSubscription, error) {
+	return orderLifecycleWatchers.add(ctx, *orderId)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"gorm.io/gorm"
+)
+
+type OutboxRepository interface {
+	CreateOutbox(tx *gorm.DB, data *entity.Outbox) (*entity.Outbox, error)
+	GetOutbox(tx *gorm.DB, where *entity.Outbox) (*entity.Outbox, error)
+	ListOutbox(tx *gorm.DB, where *entity.Outbox, limit int) (*[]entity.Outbox, error)
+	ClaimOutbox(tx *gorm.DB, now time.Time, leaseTime time.Time, limit int) (*[]entity.Outbox, error)
+	UpdateOutbox(tx *gorm.DB, where *entity.Outbox, data *entity.Outbox) (*entity.Outbox, error)
+	ReplayOutbox(tx *gorm.DB, where *entity.Outbox, now time.Time) (int64, error)
+}
+
+type outboxRepository struct{}
+
+func (i *outboxRepository) CreateOutbox(tx *gorm.DB, data *entity.Outbox) (*entity.Outbox, error) {
+	res, err := Datasource.NewOutboxDatasource().CreateOutbox(tx, data)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *outboxRepository) GetOutbox(tx *gorm.DB, where *entity.Outbox) (*entity.Outbox, error) {
+	res, err := Datasource.NewOutboxDatasource().GetOutbox(tx, where)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *outboxRepository) ListOutbox(tx *gorm.DB, where *entity.Outbox, limit int) (*[]entity.Outbox, error) {
+	res, err := Datasource.NewOutboxDatasource().ListOutbox(tx, where, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *outboxRepository) ClaimOutbox(tx *gorm.DB, now time.Time, leaseTime time.Time, limit int) (*[]entity.Outbox, error) {
+	res, err := Datasource.NewOutboxDatasource().ClaimOutbox(tx, now, leaseTime, limit)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *outboxRepository) UpdateOutbox(tx *gorm.DB, where *entity.Outbox, data *entity.Outbox) (*entity.Outbox, error) {
+	res, err := Datasource.NewOutboxDatasource().UpdateOutbox(tx, where, data)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *outboxRepository) ReplayOutbox(tx *gorm.DB, where *entity.Outbox, now time.Time) (int64, error) {
+	return Datasource.NewOutboxDatasource().ReplayOutbox(tx, where, now)
+}
@@ -13,6 +13,9 @@ import (
 
 type RefreshTokenRepository interface {
 	GetRefreshToken(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error)
+	GetRefreshTokenForUpdate(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error)
+	UpdateRefreshToken(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken, data *entity.RefreshToken) (*entity.RefreshToken, error)
+	DeleteRefreshTokenFamily(ctx context.Context, tx *gorm.DB, familyId *uuid.UUID) (*[]entity.RefreshToken, error)
 	CreateRefreshToken(ctx context.Context, tx *gorm.DB, data *entity.RefreshToken) (*entity.RefreshToken, error)
 	DeleteRefreshToken(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken, ids *[]uuid.UUID) (*[]entity.RefreshToken, error)
 	DeleteRefreshTokenDeviceIdNotEqual(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken, ids *[]uuid.UUID) (*[]entity.RefreshToken, error)
@@ -134,3 +137,41 @@ func (r *refreshTokenRepository) GetRefreshToken(ctx context.Context, tx *gorm.D
 		}, nil
 	}
 }
+
+// GetRefreshTokenForUpdate skips the cache, the refresh needs the current state
+// of the token.
+func (r *refreshTokenRepository) GetRefreshTokenForUpdate(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error) {
+	return Datasource.NewRefreshTokenDatasource().GetRefreshTokenForUpdate(tx, where)
+}
+
+func (r *refreshTokenRepository) UpdateRefreshToken(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken, data *entity.RefreshToken) (*entity.RefreshToken, error) {
+	// Update in database
+	dbRes, dbErr := Datasource.NewRefreshTokenDatasource().UpdateRefreshToken(tx, where, data)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	cacheErr := Rdb.Del(ctx, "refresh_token:"+where.ID.String()).Err()
+	if cacheErr != nil {
+		log.Error(cacheErr)
+	}
+	return dbRes, nil
+}
+
+func (r *refreshTokenRepository) DeleteRefreshTokenFamily(ctx context.Context, tx *gorm.DB, familyId *uuid.UUID) (*[]entity.RefreshToken, error) {
+	// Delete in database
+	dbRes, dbErr := Datasource.NewRefreshTokenDatasource().DeleteRefreshTokenFamily(tx, familyId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	rdbPipe := Rdb.Pipeline()
+	for _, item := range *dbRes {
+		rdbPipe.Del(ctx, "refresh_token:"+item.ID.String())
+	}
+	_, err := rdbPipe.Exec(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+	return dbRes, nil
+}
@@ -29,6 +29,11 @@ type Repository interface {
 	NewUnionOrderAndOrderedItemRepository() UnionOrderAndOrderedItemRepository
 	NewBusinessUserRepository() BusinessUserRepository
 	NewApplicationRepository() ApplicationRepository
+	NewApplicationCredentialRepository() ApplicationCredentialRepository
+	NewBannedDeviceRepository() BannedDeviceRepository
+	NewBannedUserRepository() BannedUserRepository
+	NewAnalyticsRepository() AnalyticsRepository
+	NewSearchSuggestionRepository() SearchSuggestionRepository
 	NewBusinessScheduleRepository() BusinessScheduleRepository
 	NewOrderLifecycleRepository() OrderLifecycleRepository
 	NewBusinessCategoryRepository() BusinessCategoryRepository
@@ -41,6 +46,7 @@ type Repository interface {
 	NewPermissionRepository() PermissionRepository
 	NewPaymentMethodRepository() PaymentMethodRepository
 	NewBusinessPaymentMethodRepository() BusinessPaymentMethodRepository
+	NewOutboxRepository() OutboxRepository
 }
 
 type repository struct {
@@ -75,6 +81,26 @@ func (d *repository) NewApplicationRepository() ApplicationRepository {
 	return &applicationRepository{}
 }
 
+func (d *repository) NewApplicationCredentialRepository() ApplicationCredentialRepository {
+	return &applicationCredentialRepository{}
+}
+
+func (d *repository) NewBannedDeviceRepository() BannedDeviceRepository {
+	return &bannedDeviceRepository{}
+}
+
+func (d *repository) NewBannedUserRepository() BannedUserRepository {
+	return &bannedUserRepository{}
+}
+
+func (d *repository) NewAnalyticsRepository() AnalyticsRepository {
+	return &analyticsRepository{}
+}
+
+func (d *repository) NewSearchSuggestionRepository() SearchSuggestionRepository {
+	return &searchSuggestionRepository{}
+}
+
 func (d *repository) NewUserRepository() UserRepository {
 	return &userRepository{}
 }
@@ -185,3 +211,7 @@ func (d *repository) NewPaymentMethodRepository() PaymentMethodRepository {
 func (d *repository) NewBusinessPaymentMethodRepository() BusinessPaymentMethodRepository {
 	return &businessPaymentMethodRepository{}
 }
+
+func (d *repository) NewOutboxRepository() OutboxRepository {
+	return &outboxRepository{}
+}
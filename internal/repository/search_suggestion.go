@@ -0,0 +1,311 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
+)
+
+// The suggestions live in a sorted set by province, all with score 0 so they
+// are sorted by their normalized text and a prefix is a ZRANGEBYLEX. The
+// entries hash keeps, by suggestion, its member and what the member doesn't
+// carry, to find and replace it when the name changes.
+const (
+	searchSuggestionEntriesKey = "search_suggestion:entries"
+	searchSuggestionKeysKey    = "search_suggestion:keys"
+	// maxRecentSearchQueries is the number of queries kept by user.
+	maxRecentSearchQueries = 20
+	recentSearchQueryTtl   = 90 * 24 * time.Hour
+)
+
+type SearchSuggestionRepository interface {
+	IndexSearchSuggestion(ctx context.Context, data []entity.SearchSuggestion) error
+	DeleteSearchSuggestion(ctx context.Context, suggestionType string, ids []uuid.UUID) error
+	ListSearchSuggestion(ctx context.Context, prefix string, provinceId *uuid.UUID, limit int64) ([]entity.SearchSuggestion, error)
+	RebuildSearchSuggestion(ctx context.Context, tx *gorm.DB) (int, error)
+	AddRecentSearchQuery(ctx context.Context, userId *uuid.UUID, query string) error
+	ListRecentSearchQuery(ctx context.Context, userId *uuid.UUID, prefix string, limit int) ([]string, error)
+}
+
+type searchSuggestionRepository struct{}
+
+// searchSuggestionKey returns the sorted set of the province, the one of the
+// suggestions of every province when it is nil.
+func searchSuggestionKey(provinceId *uuid.UUID) string {
+	if provinceId == nil {
+		return "search_suggestion:province:all"
+	}
+	return "search_suggestion:province:" + provinceId.String()
+}
+
+func searchSuggestionField(suggestionType string, id *uuid.UUID) string {
+	return suggestionType + ":" + id.String()
+}
+
+func recentSearchQueryKey(userId *uuid.UUID) string {
+	return "search_history:" + userId.String()
+}
+
+var searchTextTransformer = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// NormalizeSearchText lowercases the text, removes its accents and collapses
+// its spaces, the suggestions are matched on the normalized text.
+func NormalizeSearchText(text string) string {
+	res, _, err := transform.String(searchTextTransformer, text)
+	if err != nil {
+		res = text
+	}
+	return strings.Join(strings.Fields(strings.ToLower(res)), " ")
+}
+
+// searchSuggestionEntry is the value of a suggestion in the entries hash:
+// its sorted set, its member, its municipality and its text, separated by
+// NUL like the two parts of the member.
+func searchSuggestionEntry(key string, member string, suggestion *entity.SearchSuggestion) string {
+	var municipalityId string
+	if suggestion.MunicipalityId != nil {
+		municipalityId = suggestion.MunicipalityId.String()
+	}
+	return strings.Join([]string{key, member, municipalityId, suggestion.Text}, "\x00")
+}
+
+func parseSearchSuggestionEntry(entry string) (key string, member string, suggestion entity.SearchSuggestion, ok bool) {
+	values := strings.SplitN(entry, "\x00", 5)
+	if len(values) != 5 {
+		return "", "", suggestion, false
+	}
+	key, member = values[0], values[1]+"\x00"+values[2]
+	field := values[2]
+	index := strings.LastIndex(field, ":")
+	if index == -1 {
+		return "", "", suggestion, false
+	}
+	id, err := uuid.Parse(field[index+1:])
+	if err != nil {
+		return "", "", suggestion, false
+	}
+	suggestion = entity.SearchSuggestion{Type: field[:index], Id: &id, Text: values[4]}
+	if municipalityId, err := uuid.Parse(values[3]); err == nil {
+		suggestion.MunicipalityId = &municipalityId
+	}
+	return key, member, suggestion, true
+}
+
+// IndexSearchSuggestion adds the suggestions or replaces them when they are
+// already indexed.
+func (i *searchSuggestionRepository) IndexSearchSuggestion(ctx context.Context, data []entity.SearchSuggestion) error {
+	if len(data) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(data))
+	for index := range data {
+		fields = append(fields, searchSuggestionField(data[index].Type, data[index].Id))
+	}
+	entries, err := Rdb.HMGet(ctx, searchSuggestionEntriesKey, fields...).Result()
+	if err != nil {
+		return err
+	}
+	pipe := Rdb.TxPipeline()
+	for index := range data {
+		if entry, ok := entries[index].(string); ok {
+			if key, member, _, ok := parseSearchSuggestionEntry(entry); ok {
+				pipe.ZRem(ctx, key, member)
+			}
+		}
+		text := NormalizeSearchText(data[index].Text)
+		if text == "" {
+			pipe.HDel(ctx, searchSuggestionEntriesKey, fields[index])
+			continue
+		}
+		key := searchSuggestionKey(data[index].ProvinceId)
+		member := text + "\x00" + fields[index]
+		pipe.ZAdd(ctx, key, redis.Z{Member: member})
+		pipe.SAdd(ctx, searchSuggestionKeysKey, key)
+		pipe.HSet(ctx, searchSuggestionEntriesKey, fields[index], searchSuggestionEntry(key, member, &data[index]))
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (i *searchSuggestionRepository) DeleteSearchSuggestion(ctx context.Context, suggestionType string, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(ids))
+	for index := range ids {
+		fields = append(fields, searchSuggestionField(suggestionType, &ids[index]))
+	}
+	entries, err := Rdb.HMGet(ctx, searchSuggestionEntriesKey, fields...).Result()
+	if err != nil {
+		return err
+	}
+	pipe := Rdb.TxPipeline()
+	for _, entry := range entries {
+		if entry, ok := entry.(string); ok {
+			if key, member, _, ok := parseSearchSuggestionEntry(entry); ok {
+				pipe.ZRem(ctx, key, member)
+			}
+		}
+	}
+	pipe.HDel(ctx, searchSuggestionEntriesKey, fields...)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// ListSearchSuggestion returns up to limit suggestions of the province and up
+// to limit of every province that start with the prefix, in the order of
+// their normalized text.
+func (i *searchSuggestionRepository) ListSearchSuggestion(ctx context.Context, prefix string, provinceId *uuid.UUID, limit int64) ([]entity.SearchSuggestion, error) {
+	prefix = NormalizeSearchText(prefix)
+	if prefix == "" {
+		return nil, nil
+	}
+	var fields []string
+	for _, key := range []string{searchSuggestionKey(provinceId), searchSuggestionKey(nil)} {
+		members, err := Rdb.ZRangeByLex(ctx, key, &redis.ZRangeBy{Min: "[" + prefix, Max: "[" + prefix + "\xff", Count: limit}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			index := strings.LastIndex(member, "\x00")
+			fields = append(fields, member[index+1:])
+		}
+		if provinceId == nil {
+			break
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	entries, err := Rdb.HMGet(ctx, searchSuggestionEntriesKey, fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]entity.SearchSuggestion, 0, len(entries))
+	for _, entry := range entries {
+		if entry, ok := entry.(string); ok {
+			if _, _, suggestion, ok := parseSearchSuggestionEntry(entry); ok {
+				res = append(res, suggestion)
+			}
+		}
+	}
+	return res, nil
+}
+
+// RebuildSearchSuggestion replaces the index with the names in the database,
+// for the first deploy or after Redis lost it.
+func (i *searchSuggestionRepository) RebuildSearchSuggestion(ctx context.Context, tx *gorm.DB) (int, error) {
+	data, err := Datasource.NewSearchSuggestionDatasource().ListSearchSuggestion(tx)
+	if err != nil {
+		return 0, err
+	}
+	keys, err := Rdb.SMembers(ctx, searchSuggestionKeysKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	err = Rdb.Del(ctx, append(keys, searchSuggestionEntriesKey, searchSuggestionKeysKey)...).Err()
+	if err != nil {
+		return 0, err
+	}
+	const batchSize = 1000
+	for start := 0; start < len(*data); start += batchSize {
+		end := start + batchSize
+		if end > len(*data) {
+			end = len(*data)
+		}
+		err = i.IndexSearchSuggestion(ctx, (*data)[start:end])
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(*data), nil
+}
+
+// AddRecentSearchQuery keeps the query among the last queries of the user.
+func (i *searchSuggestionRepository) AddRecentSearchQuery(ctx context.Context, userId *uuid.UUID, query string) error {
+	query = strings.Join(strings.Fields(query), " ")
+	if query == "" {
+		return nil
+	}
+	key := recentSearchQueryKey(userId)
+	pipe := Rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(time.Now().Unix()), Member: query})
+	pipe.ZRemRangeByRank(ctx, key, 0, -maxRecentSearchQueries-1)
+	pipe.Expire(ctx, key, recentSearchQueryTtl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ListRecentSearchQuery returns the last queries of the user that start with
+// the prefix, the most recent first.
+func (i *searchSuggestionRepository) ListRecentSearchQuery(ctx context.Context, userId *uuid.UUID, prefix string, limit int) ([]string, error) {
+	queries, err := Rdb.ZRevRange(ctx, recentSearchQueryKey(userId), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	prefix = NormalizeSearchText(prefix)
+	var res []string
+	for _, query := range queries {
+		if len(res) == limit {
+			break
+		}
+		if strings.HasPrefix(NormalizeSearchText(query), prefix) {
+			res = append(res, query)
+		}
+	}
+	return res, nil
+}
+
+// indexItemSearchSuggestion keeps the suggestions of the items current, the
+// disabled ones aren't suggested. The errors are logged, the index is
+// rebuilt from the database.
+func indexItemSearchSuggestion(ctx context.Context, items []entity.ItemBusiness) {
+	var index []entity.SearchSuggestion
+	var disabled []uuid.UUID
+	for _, item := range items {
+		if item.ID == nil {
+			continue
+		}
+		if !item.EnabledFlag {
+			disabled = append(disabled, *item.ID)
+			continue
+		}
+		index = append(index, entity.SearchSuggestion{Type: entity.SearchSuggestionTypeItem, Id: item.ID, Text: item.Name, ProvinceId: item.ProvinceId, MunicipalityId: item.MunicipalityId})
+	}
+	repository := &searchSuggestionRepository{}
+	err := repository.IndexSearchSuggestion(ctx, index)
+	if err != nil {
+		log.Error(err)
+	}
+	err = repository.DeleteSearchSuggestion(ctx, entity.SearchSuggestionTypeItem, disabled)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func indexSearchSuggestion(ctx context.Context, suggestion entity.SearchSuggestion) {
+	if suggestion.Id == nil {
+		return
+	}
+	err := (&searchSuggestionRepository{}).IndexSearchSuggestion(ctx, []entity.SearchSuggestion{suggestion})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func deleteSearchSuggestion(ctx context.Context, suggestionType string, ids []uuid.UUID) {
+	err := (&searchSuggestionRepository{}).DeleteSearchSuggestion(ctx, suggestionType, ids)
+	if err != nil {
+		log.Error(err)
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	"gorm.io/gorm"
 )
 
@@ -15,12 +16,12 @@ func (v *sessionRepository) ListSession(tx *gorm.DB, session *entity.Session) (*
 	var sessionResult *[]entity.Session
 	var result *gorm.DB
 	if session != nil {
-		result = tx.Model(&entity.AuthorizationToken{}).Where(session).Select("authorization_token.id, authorization_token.device_id, authorization_token.app, authorization_token.app_version, device.platform, device.system_version, device.model, device.device_identifier").Joins("left join device on device.id = authorization_token.device_id").Find(&sessionResult)
+		result = tx.Model(&entity.AuthorizationToken{}).Where(session).Select("authorization_token.id, authorization_token.device_id, authorization_token.app, authorization_token.app_version, device.platform, device.system_version, device.model, device.device_identifier, device.firebase_cloud_messaging_id, authorization_token.last_seen_time, authorization_token.last_seen_ip, authorization_token.create_time").Joins("left join device on device.id = authorization_token.device_id").Find(&sessionResult)
 	} else {
-		result = tx.Model(&entity.AuthorizationToken{}).Select("authorization_token.id, authorization_token.device_id, authorization_token.app, authorization_token.app_version, device.platform, device.system_version, device.model, device.device_identifier").Joins("left join device on device.id = authorization_token.device_id").Find(&sessionResult)
+		result = tx.Model(&entity.AuthorizationToken{}).Select("authorization_token.id, authorization_token.device_id, authorization_token.app, authorization_token.app_version, device.platform, device.system_version, device.model, device.device_identifier, device.firebase_cloud_messaging_id, authorization_token.last_seen_time, authorization_token.last_seen_ip, authorization_token.create_time").Joins("left join device on device.id = authorization_token.device_id").Find(&sessionResult)
 	}
 	if result.Error != nil {
-		if result.Error.Error() == "record not found" {
+		if apperror.IsNotFound(result.Error) {
 			return sessionResult, nil
 		} else {
 			return nil, result.Error
@@ -15,6 +15,7 @@ import (
 type UserPermissionRepository interface {
 	CreateUserPermission(ctx context.Context, tx *gorm.DB, data *[]entity.UserPermission) (*[]entity.UserPermission, error)
 	GetUserPermission(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*entity.UserPermission, error)
+	ListUserPermission(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error)
 	DeleteUserPermission(ctx context.Context, tx *gorm.DB, where *entity.UserPermission, ids *[]uuid.UUID) (*[]entity.UserPermission, error)
 	DeleteUserPermissionByBusinessRoleId(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error)
 	DeleteUserPermissionByPermissionId(ctx context.Context, tx *gorm.DB, permissionIds *[]uuid.UUID) (*[]entity.UserPermission, error)
@@ -22,6 +23,14 @@ type UserPermissionRepository interface {
 
 type userPermissionRepository struct{}
 
+func (v *userPermissionRepository) ListUserPermission(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error) {
+	res, err := Datasource.NewUserPermissionDatasource().ListUserPermission(tx, where)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (v *userPermissionRepository) DeleteUserPermissionByBusinessRoleId(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*[]entity.UserPermission, error) {
 	// Delete in database
 	dbRes, dbErr := Datasource.NewUserPermissionDatasource().DeleteUserPermissionByBusinessRoleId(tx, where)
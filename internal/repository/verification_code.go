@@ -2,19 +2,23 @@ package repository
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/daniarmas/api_go/internal/entity"
-	"github.com/go-redis/redis/v9"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type VerificationCodeRepository interface {
-	GetVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error)
 	CreateVerificationCode(ctx context.Context, tx *gorm.DB, data *entity.VerificationCode) (*entity.VerificationCode, error)
 	DeleteVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, ids *[]uuid.UUID) (*[]entity.VerificationCode, error)
+	GetVerificationCodeForUpdate(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error)
+	UpdateVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, data *entity.VerificationCode) (*entity.VerificationCode, error)
+	PurgeVerificationCode(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error)
+	LockVerificationCode(ctx context.Context, email string, deviceIdentifier string, lockout time.Duration) error
+	GetVerificationCodeLockout(ctx context.Context, email string, deviceIdentifier string) (time.Duration, error)
 }
 
 type verificationCodeRepository struct{}
@@ -35,6 +39,8 @@ func (v *verificationCodeRepository) CreateVerificationCode(ctx context.Context,
 			"email", dbRes.Email,
 			"type", dbRes.Type,
 			"device_identifier", dbRes.DeviceIdentifier,
+			"attempts", strconv.Itoa(int(dbRes.Attempts)),
+			"expiration_time", dbRes.ExpirationTime.Format(time.RFC3339),
 			"create_time", dbRes.CreateTime.Format(time.RFC3339),
 			"update_time", dbRes.UpdateTime.Format(time.RFC3339),
 		}).Err()
@@ -47,51 +53,6 @@ func (v *verificationCodeRepository) CreateVerificationCode(ctx context.Context,
 	return dbRes, nil
 }
 
-func (v *verificationCodeRepository) GetVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error) {
-	cacheId := "verification_code:" + where.Code
-	cacheRes, cacheErr := Rdb.HGetAll(ctx, cacheId).Result()
-	// Check if exists in cache
-	if len(cacheRes) == 0 || cacheErr == redis.Nil {
-		dbRes, dbErr := Datasource.NewVerificationCodeDatasource().GetVerificationCode(tx, where)
-		if dbErr != nil {
-			return nil, dbErr
-		}
-		// Store in cache
-		go func() {
-			ctx := context.Background()
-			cacheId := "verification_code:" + dbRes.ID.String()
-			cacheErr := Rdb.HSet(ctx, cacheId, []string{
-				"id", dbRes.ID.String(),
-				"code", dbRes.Code,
-				"email", dbRes.Email,
-				"type", dbRes.Type,
-				"device_identifier", dbRes.DeviceIdentifier,
-				"create_time", dbRes.CreateTime.Format(time.RFC3339),
-				"update_time", dbRes.UpdateTime.Format(time.RFC3339),
-			}).Err()
-			if cacheErr != nil {
-				log.Error(cacheErr)
-			} else {
-				Rdb.Expire(ctx, cacheId, time.Minute*15)
-			}
-		}()
-		return dbRes, nil
-	} else {
-		id := uuid.MustParse(cacheRes["id"])
-		createTime, _ := time.Parse(time.RFC3339, cacheRes["create_time"])
-		updateTime, _ := time.Parse(time.RFC3339, cacheRes["update_time"])
-		return &entity.VerificationCode{
-			ID:               &id,
-			Code:             cacheRes["code"],
-			Email:            cacheRes["email"],
-			Type:             cacheRes["type"],
-			DeviceIdentifier: cacheRes["device_identifier"],
-			CreateTime:       createTime,
-			UpdateTime:       updateTime,
-		}, nil
-	}
-}
-
 func (v *verificationCodeRepository) DeleteVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, ids *[]uuid.UUID) (*[]entity.VerificationCode, error) {
 	// Delete in database
 	dbRes, dbErr := Datasource.NewVerificationCodeDatasource().DeleteVerificationCode(tx, where, ids)
@@ -114,3 +75,49 @@ func (v *verificationCodeRepository) DeleteVerificationCode(ctx context.Context,
 	}
 	return dbRes, nil
 }
+
+func (v *verificationCodeRepository) GetVerificationCodeForUpdate(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error) {
+	// The lock is only taken in the database, the cache is skipped
+	return Datasource.NewVerificationCodeDatasource().GetVerificationCodeForUpdate(tx, where)
+}
+
+func (v *verificationCodeRepository) UpdateVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, data *entity.VerificationCode) (*entity.VerificationCode, error) {
+	dbRes, dbErr := Datasource.NewVerificationCodeDatasource().UpdateVerificationCode(tx, where, data)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	// Delete in cache
+	if where.ID != nil {
+		cacheErr := Rdb.Del(ctx, "verification_code:"+where.ID.String()).Err()
+		if cacheErr != nil {
+			log.Error(cacheErr)
+		}
+	}
+	return dbRes, nil
+}
+
+func (v *verificationCodeRepository) PurgeVerificationCode(ctx context.Context, tx *gorm.DB, now time.Time) (int64, error) {
+	return Datasource.NewVerificationCodeDatasource().PurgeVerificationCode(tx, now)
+}
+
+func verificationCodeLockoutKey(email string, deviceIdentifier string) string {
+	return "verification_code_lockout:" + email + ":" + deviceIdentifier
+}
+
+// LockVerificationCode refuses the codes of the email on the device for the
+// lockout.
+func (v *verificationCodeRepository) LockVerificationCode(ctx context.Context, email string, deviceIdentifier string, lockout time.Duration) error {
+	return Rdb.Set(ctx, verificationCodeLockoutKey(email, deviceIdentifier), "1", lockout).Err()
+}
+
+// GetVerificationCodeLockout returns how long the email stays locked out on
+// the device, or 0 when it isn't.
+func (v *verificationCodeRepository) GetVerificationCodeLockout(ctx context.Context, email string, deviceIdentifier string) (time.Duration, error) {
+	ttl, err := Rdb.PTTL(ctx, verificationCodeLockoutKey(email, deviceIdentifier)).Result()
+	if err != nil {
+		return 0, err
+	} else if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	gp "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// readAnalyticsPermission grants the analytics of a business.
+const readAnalyticsPermission = "read_analytics"
+
+type AnalyticsService interface {
+	CollectAnalytics(ctx context.Context, req *pb.CollectAnalyticsRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	GetBusinessAnalytics(ctx context.Context, req *pb.GetBusinessAnalyticsRequest, md *utils.ClientMetadata) (*pb.GetBusinessAnalyticsResponse, error)
+	ListItemAnalytics(ctx context.Context, req *pb.ListItemAnalyticsRequest, md *utils.ClientMetadata) (*pb.ListItemAnalyticsResponse, error)
+	// RollupAnalytics moves the views buffered in Redis to the hourly and
+	// daily tables and returns how many hours it rolled up.
+	RollupAnalytics(ctx context.Context) (int, error)
+}
+
+type analyticsService struct {
+	dao   repository.Repository
+	sqldb *sqldb.Sql
+	// maxDelay is ANALYTICS_MAX_DELAY, how old a view can be.
+	maxDelay time.Duration
+}
+
+func NewAnalyticsService(dao repository.Repository, sqldb *sqldb.Sql, maxDelay time.Duration) AnalyticsService {
+	return &analyticsService{dao: dao, sqldb: sqldb, maxDelay: maxDelay}
+}
+
+var (
+	businessAnalyticsTypes = map[pb.BusinessAnalyticsType]string{
+		pb.BusinessAnalyticsType_BusinessAnalyticsTypeView:       entity.AnalyticsTypeView,
+		pb.BusinessAnalyticsType_BusinessAnalyticsTypeDetailView: entity.AnalyticsTypeDetailView,
+	}
+	itemAnalyticsTypes = map[pb.ItemAnalyticsType]string{
+		pb.ItemAnalyticsType_ItemAnalyticsTypeView:       entity.AnalyticsTypeView,
+		pb.ItemAnalyticsType_ItemAnalyticsTypeDetailView: entity.AnalyticsTypeDetailView,
+	}
+)
+
+// analyticsHour returns the hour the view is counted in. The views without
+// time or from the future count now, the ones older than the max delay are
+// dropped because their hour may be rolled up for good.
+func analyticsHour(createTime *timestamppb.Timestamp, now time.Time, maxDelay time.Duration) (time.Time, bool) {
+	viewTime := now
+	if createTime != nil && createTime.AsTime().Before(now) {
+		viewTime = createTime.AsTime()
+	}
+	if viewTime.Before(now.Add(-maxDelay)) {
+		return time.Time{}, false
+	}
+	return viewTime.UTC().Truncate(time.Hour), true
+}
+
+// analyticsDay returns the start of the UTC day of the time.
+func analyticsDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// conversionRate returns the orders by view.
+func conversionRate(orders int64, views int64) float64 {
+	if views == 0 {
+		return 0
+	}
+	return float64(orders) / float64(views)
+}
+
+func (i *analyticsService) CollectAnalytics(ctx context.Context, req *pb.CollectAnalyticsRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
+	now := time.Now().UTC()
+	type countKey struct {
+		resource   string
+		resourceId uuid.UUID
+		kind       string
+		hour       time.Time
+	}
+	counts := make(map[countKey]int64)
+	for _, item := range req.BusinessAnalytics {
+		hour, ok := analyticsHour(item.CreateTime, now, i.maxDelay)
+		if !ok {
+			continue
+		}
+		counts[countKey{entity.AnalyticsResourceBusiness, uuid.MustParse(item.BusinessId), businessAnalyticsTypes[item.Type], hour}]++
+	}
+	for _, item := range req.ItemAnalytics {
+		hour, ok := analyticsHour(item.CreateTime, now, i.maxDelay)
+		if !ok {
+			continue
+		}
+		counts[countKey{entity.AnalyticsResourceItem, uuid.MustParse(item.ItemId), itemAnalyticsTypes[item.Type], hour}]++
+	}
+	if len(counts) == 0 {
+		return &gp.Empty{}, nil
+	}
+	data := make([]entity.AnalyticsHourly, 0, len(counts))
+	for key, count := range counts {
+		resourceId := key.resourceId
+		data = append(data, entity.AnalyticsHourly{Resource: key.resource, ResourceId: &resourceId, Type: key.kind, Time: key.hour, Count: count})
+	}
+	// The buffer outlives the last rollup of its hour
+	err := i.dao.NewAnalyticsRepository().BufferAnalytics(ctx, data, i.maxDelay+2*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return &gp.Empty{}, nil
+}
+
+func (i *analyticsService) RollupAnalytics(ctx context.Context) (int, error) {
+	hours, err := i.dao.NewAnalyticsRepository().ListAnalyticsBuffer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	rolledUp := 0
+	for _, hour := range hours {
+		counts, err := i.dao.NewAnalyticsRepository().GetAnalyticsBuffer(ctx, hour)
+		if err != nil {
+			return rolledUp, err
+		}
+		err = i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+			return i.rollupAnalyticsHour(ctx, tx, hour, *counts)
+		})
+		if err != nil {
+			return rolledUp, err
+		}
+		rolledUp++
+		// The views of the hour aren't accepted anymore, so its counts are final
+		if hour.Add(time.Hour + i.maxDelay).Before(now) {
+			err = i.dao.NewAnalyticsRepository().DeleteAnalyticsBuffer(ctx, hour)
+			if err != nil {
+				return rolledUp, err
+			}
+		}
+	}
+	return rolledUp, nil
+}
+
+// rollupAnalyticsHour replaces the hourly counts of the hour with the buffered
+// ones and recomputes its day. The views of the items that no longer exist are
+// dropped.
+func (i *analyticsService) rollupAnalyticsHour(ctx context.Context, tx *gorm.DB, hour time.Time, counts []entity.AnalyticsHourly) error {
+	itemIds := make([]uuid.UUID, 0, len(counts))
+	for _, count := range counts {
+		if count.Resource == entity.AnalyticsResourceItem {
+			itemIds = append(itemIds, *count.ResourceId)
+		}
+	}
+	itemBusinesses := make(map[uuid.UUID]*uuid.UUID, len(itemIds))
+	if len(itemIds) != 0 {
+		items, err := i.dao.NewItemRepository().ListItemInIds(ctx, tx, itemIds)
+		if err != nil {
+			return err
+		}
+		for _, item := range *items {
+			itemBusinesses[*item.ID] = item.BusinessId
+		}
+	}
+	data := make([]entity.AnalyticsHourly, 0, len(counts))
+	for _, count := range counts {
+		switch count.Resource {
+		case entity.AnalyticsResourceBusiness:
+			count.BusinessId = count.ResourceId
+		case entity.AnalyticsResourceItem:
+			count.BusinessId = itemBusinesses[*count.ResourceId]
+		}
+		if count.BusinessId == nil {
+			continue
+		}
+		data = append(data, count)
+	}
+	err := i.dao.NewAnalyticsRepository().UpsertAnalyticsHourly(ctx, tx, &data)
+	if err != nil {
+		return err
+	}
+	return i.dao.NewAnalyticsRepository().RollupAnalyticsDaily(ctx, tx, analyticsDay(hour))
+}
+
+// authorizeAnalytics fails when the caller can't read the analytics of the
+// business.
+func (i *analyticsService) authorizeAnalytics(ctx context.Context, tx *gorm.DB, businessId *uuid.UUID) error {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: readAnalyticsPermission, UserId: principal.AuthorizationToken.UserId, BusinessId: businessId})
+	if apperror.IsNotFound(err) {
+		return apperror.ErrPermissionDenied
+	}
+	return err
+}
+
+func (i *analyticsService) GetBusinessAnalytics(ctx context.Context, req *pb.GetBusinessAnalyticsRequest, md *utils.ClientMetadata) (*pb.GetBusinessAnalyticsResponse, error) {
+	var res pb.GetBusinessAnalyticsResponse
+	businessId := uuid.MustParse(req.BusinessId)
+	filter := &datasource.AnalyticsFilter{
+		BusinessId: &businessId,
+		StartTime:  req.StartTime.AsTime().Truncate(time.Hour),
+		EndTime:    req.EndTime.AsTime(),
+		Daily:      req.Granularity == pb.AnalyticsGranularity_AnalyticsGranularityDay,
+	}
+	if filter.Daily {
+		filter.StartTime = analyticsDay(filter.StartTime)
+	}
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		err := i.authorizeAnalytics(ctx, tx, &businessId)
+		if err != nil {
+			return err
+		}
+		counts, err := i.dao.NewAnalyticsRepository().ListBusinessAnalytics(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+		for _, count := range *counts {
+			if len(res.Points) == 0 || !res.Points[len(res.Points)-1].Time.AsTime().Equal(count.Time) {
+				res.Points = append(res.Points, &pb.AnalyticsPoint{Time: timestamppb.New(count.Time)})
+			}
+			point := res.Points[len(res.Points)-1]
+			switch count.Type {
+			case entity.AnalyticsTypeView:
+				point.Views += count.Count
+				res.Views += count.Count
+			case entity.AnalyticsTypeDetailView:
+				point.DetailViews += count.Count
+				res.DetailViews += count.Count
+			}
+		}
+		res.Orders, err = i.dao.NewAnalyticsRepository().CountBusinessOrder(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+		res.ConversionRate = conversionRate(res.Orders, res.Views)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (i *analyticsService) ListItemAnalytics(ctx context.Context, req *pb.ListItemAnalyticsRequest, md *utils.ClientMetadata) (*pb.ListItemAnalyticsResponse, error) {
+	var res pb.ListItemAnalyticsResponse
+	businessId := uuid.MustParse(req.BusinessId)
+	filter := &datasource.AnalyticsFilter{
+		BusinessId: &businessId,
+		StartTime:  req.StartTime.AsTime().Truncate(time.Hour),
+		EndTime:    req.EndTime.AsTime(),
+	}
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		err := i.authorizeAnalytics(ctx, tx, &businessId)
+		if err != nil {
+			return err
+		}
+		counts, err := i.dao.NewAnalyticsRepository().SumItemAnalytics(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+		orders, err := i.dao.NewAnalyticsRepository().CountItemOrder(ctx, tx, filter)
+		if err != nil {
+			return err
+		}
+		summaries := make(map[uuid.UUID]*pb.ItemAnalyticsSummary)
+		summary := func(itemId uuid.UUID) *pb.ItemAnalyticsSummary {
+			if summaries[itemId] == nil {
+				summaries[itemId] = &pb.ItemAnalyticsSummary{ItemId: itemId.String()}
+			}
+			return summaries[itemId]
+		}
+		for _, count := range *counts {
+			switch count.Type {
+			case entity.AnalyticsTypeView:
+				summary(*count.ResourceId).Views = count.Count
+			case entity.AnalyticsTypeDetailView:
+				summary(*count.ResourceId).DetailViews = count.Count
+			}
+		}
+		for _, count := range *orders {
+			summary(*count.ResourceId).Orders = count.Count
+		}
+		if len(summaries) == 0 {
+			return nil
+		}
+		itemIds := make([]uuid.UUID, 0, len(summaries))
+		for itemId := range summaries {
+			itemIds = append(itemIds, itemId)
+		}
+		items, err := i.dao.NewItemRepository().ListItemInIds(ctx, tx, itemIds)
+		if err != nil {
+			return err
+		}
+		for _, item := range *items {
+			summaries[*item.ID].Name = item.Name
+		}
+		res.Items = make([]*pb.ItemAnalyticsSummary, 0, len(summaries))
+		for _, item := range summaries {
+			item.ConversionRate = conversionRate(item.Orders, item.Views)
+			res.Items = append(res.Items, item)
+		}
+		sort.Slice(res.Items, func(a, b int) bool {
+			if res.Items[a].Views != res.Items[b].Views {
+				return res.Items[a].Views > res.Items[b].Views
+			}
+			return res.Items[a].ItemId < res.Items[b].ItemId
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
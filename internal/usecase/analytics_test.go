@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAnalyticsHour(t *testing.T) {
+	now := time.Date(2022, 7, 14, 10, 25, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		createTime *timestamppb.Timestamp
+		hour       time.Time
+		ok         bool
+	}{
+		{"without time", nil, time.Date(2022, 7, 14, 10, 0, 0, 0, time.UTC), true},
+		{"from the future", timestamppb.New(now.Add(3 * time.Hour)), time.Date(2022, 7, 14, 10, 0, 0, 0, time.UTC), true},
+		{"late", timestamppb.New(now.Add(-5 * time.Hour)), time.Date(2022, 7, 14, 5, 0, 0, 0, time.UTC), true},
+		{"too late", timestamppb.New(now.Add(-25 * time.Hour)), time.Time{}, false},
+	}
+	for _, test := range tests {
+		hour, ok := analyticsHour(test.createTime, now, 24*time.Hour)
+		if ok != test.ok || !hour.Equal(test.hour) {
+			t.Errorf("%s: got %v %v, want %v %v", test.name, hour, ok, test.hour, test.ok)
+		}
+	}
+}
@@ -2,12 +2,13 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"strings"
 	"time"
 
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/appcredential"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
@@ -21,6 +22,10 @@ type ApplicationService interface {
 	ListApplication(ctx context.Context, req *pb.ListApplicationRequest, md *utils.ClientMetadata) (*pb.ListApplicationResponse, error)
 	CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest, md *utils.ClientMetadata) (*pb.Application, error)
 	DeleteApplication(ctx context.Context, req *pb.DeleteApplicationRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	RegisterApplication(ctx context.Context, data *entity.Application) (*entity.Application, string, error)
+	RotateApplicationCredential(ctx context.Context, applicationId uuid.UUID, overlap time.Duration) (string, error)
+	RevokeApplicationCredential(ctx context.Context, where *entity.ApplicationCredential) (int, error)
+	ImportApplicationCredential(ctx context.Context, applicationId uuid.UUID, accessToken string, expirationTime *time.Time) (*uuid.UUID, error)
 }
 
 type applicationService struct {
@@ -41,33 +46,13 @@ func (i *applicationService) ListApplication(ctx context.Context, req *pb.ListAp
 		nextPage = req.NextPage.AsTime()
 	}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authToken, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authToken.UserId, Name: "read_application"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "read_application"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		apps, err := i.dao.NewApplicationRepository().ListApplication(ctx, tx, &entity.Application{}, &nextPage)
 		if err != nil {
@@ -87,6 +72,8 @@ func (i *applicationService) ListApplication(ctx context.Context, req *pb.ListAp
 				Name:           i.Name,
 				Version:        i.Version,
 				Description:    i.Description,
+				MaxSessions:    i.MaxSessions,
+				Scopes:         applicationScopes(&i),
 				ExpirationTime: timestamppb.New(i.ExpirationTime),
 				CreateTime:     timestamppb.New(i.CreateTime),
 				UpdateTime:     timestamppb.New(i.UpdateTime),
@@ -103,97 +90,62 @@ func (i *applicationService) ListApplication(ctx context.Context, req *pb.ListAp
 
 func (i *applicationService) DeleteApplication(ctx context.Context, req *pb.DeleteApplicationRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	var res gp.Empty
+	now := time.Now().UTC()
+	var credentialIds []uuid.UUID
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authToken, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authToken.UserId, Name: "delete_application"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "delete_application"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		id := uuid.MustParse(req.Id)
 		_, err = i.dao.NewApplicationRepository().DeleteApplication(ctx, tx, &entity.Application{ID: &id}, nil)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("application not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("application not found")
 		} else if err != nil {
 			return err
 		}
+		credentials, err := i.dao.NewApplicationCredentialRepository().UpdateApplicationCredential(ctx, tx, &entity.ApplicationCredential{ApplicationId: &id}, &entity.ApplicationCredential{RevokeTime: &now})
+		if err != nil && !apperror.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			credentialIds = applicationCredentialIds(credentials)
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	i.dao.NewApplicationCredentialRepository().DeleteApplicationCredentialCache(ctx, credentialIds)
 	return &res, nil
 }
 
 func (i *applicationService) CreateApplication(ctx context.Context, req *pb.CreateApplicationRequest, md *utils.ClientMetadata) (*pb.Application, error) {
 	var res pb.Application
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authToken, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authToken.UserId, Name: "create_application"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
-		}
-		app, err := i.dao.NewApplicationRepository().CreateApplication(ctx, tx, &entity.Application{Name: req.Application.Name, Version: req.Application.Version, Description: req.Application.Description, ExpirationTime: req.Application.ExpirationTime.AsTime()})
-		if err != nil {
-			return err
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "create_application"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
-		jwtAcessToken := datasource.JsonWebTokenMetadata{TokenId: app.ID}
-		expirationTime := req.Application.ExpirationTime.AsTime()
-		err = i.dao.NewJwtTokenRepository().CreateJwtAccessToken(&jwtAcessToken, &expirationTime)
+		app, accessToken, err := createApplication(ctx, tx, i.dao, &entity.Application{Name: req.Application.Name, Version: req.Application.Version, Description: req.Application.Description, ExpirationTime: req.Application.ExpirationTime.AsTime(), MaxSessions: req.Application.MaxSessions, Scopes: strings.Join(req.Application.Scopes, ",")})
 		if err != nil {
 			return err
 		}
 		res = pb.Application{
 			Id:             app.ID.String(),
 			Name:           app.Name,
-			AccessToken:    *jwtAcessToken.Token,
+			AccessToken:    accessToken,
 			Version:        app.Version,
 			Description:    app.Description,
+			MaxSessions:    app.MaxSessions,
+			Scopes:         applicationScopes(app),
 			ExpirationTime: timestamppb.New(app.ExpirationTime),
 			CreateTime:     timestamppb.New(app.CreateTime),
 			UpdateTime:     timestamppb.New(app.UpdateTime),
@@ -205,3 +157,143 @@ func (i *applicationService) CreateApplication(ctx context.Context, req *pb.Crea
 	}
 	return &res, nil
 }
+
+// RegisterApplication creates the application with its first credential and
+// returns the access token, which can't be read again.
+func (i *applicationService) RegisterApplication(ctx context.Context, data *entity.Application) (*entity.Application, string, error) {
+	var app *entity.Application
+	var accessToken string
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		var err error
+		app, accessToken, err = createApplication(ctx, tx, i.dao, data)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return app, accessToken, nil
+}
+
+// ImportApplicationCredential registers an access token issued before the
+// credentials as a credential of the application, so the clients that still
+// send it keep working until it's rotated or revoked.
+func (i *applicationService) ImportApplicationCredential(ctx context.Context, applicationId uuid.UUID, accessToken string, expirationTime *time.Time) (*uuid.UUID, error) {
+	if _, _, err := appcredential.Parse(accessToken); err == nil {
+		return nil, apperror.InvalidArgument("the access token is already a credential")
+	}
+	id, secret := appcredential.ParseLegacy(accessToken)
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.dao.NewApplicationRepository().GetApplication(ctx, tx, &entity.Application{ID: &applicationId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("application not found")
+		} else if err != nil {
+			return err
+		}
+		_, err = i.dao.NewApplicationCredentialRepository().GetApplicationCredential(ctx, tx, &id)
+		if err == nil {
+			return apperror.AlreadyExists("the access token is already imported")
+		} else if !apperror.IsNotFound(err) {
+			return err
+		}
+		_, err = i.dao.NewApplicationCredentialRepository().CreateApplicationCredential(ctx, tx, &entity.ApplicationCredential{ID: &id, ApplicationId: &applicationId, SecretHash: appcredential.Hash(secret), ExpirationTime: expirationTime})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// RotateApplicationCredential issues a new credential of the application. The
+// active credentials keep working for the overlap, so the clients can move to
+// the new access token.
+func (i *applicationService) RotateApplicationCredential(ctx context.Context, applicationId uuid.UUID, overlap time.Duration) (string, error) {
+	var accessToken string
+	var credentialIds []uuid.UUID
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.dao.NewApplicationRepository().GetApplication(ctx, tx, &entity.Application{ID: &applicationId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("application not found")
+		} else if err != nil {
+			return err
+		}
+		credentials, err := i.dao.NewApplicationCredentialRepository().ListApplicationCredential(ctx, tx, &entity.ApplicationCredential{ApplicationId: &applicationId})
+		if err != nil {
+			return err
+		}
+		expirationTime := time.Now().UTC().Add(overlap)
+		for _, credential := range *credentials {
+			if !credential.Active(time.Now().UTC()) || (credential.ExpirationTime != nil && credential.ExpirationTime.Before(expirationTime)) {
+				continue
+			}
+			_, err = i.dao.NewApplicationCredentialRepository().UpdateApplicationCredential(ctx, tx, &entity.ApplicationCredential{ID: credential.ID}, &entity.ApplicationCredential{ExpirationTime: &expirationTime})
+			if err != nil {
+				return err
+			}
+			credentialIds = append(credentialIds, *credential.ID)
+		}
+		accessToken, err = createApplicationCredential(ctx, tx, i.dao, &applicationId)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	i.dao.NewApplicationCredentialRepository().DeleteApplicationCredentialCache(ctx, credentialIds)
+	return accessToken, nil
+}
+
+// RevokeApplicationCredential revokes the active credentials that match right
+// away, and returns how many were revoked.
+func (i *applicationService) RevokeApplicationCredential(ctx context.Context, where *entity.ApplicationCredential) (int, error) {
+	now := time.Now().UTC()
+	credentials, err := i.dao.NewApplicationCredentialRepository().UpdateApplicationCredential(ctx, i.sqldb.Gorm.WithContext(ctx), where, &entity.ApplicationCredential{RevokeTime: &now})
+	if apperror.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	i.dao.NewApplicationCredentialRepository().DeleteApplicationCredentialCache(ctx, applicationCredentialIds(credentials))
+	return len(*credentials), nil
+}
+
+func applicationCredentialIds(credentials *[]entity.ApplicationCredential) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(*credentials))
+	for _, credential := range *credentials {
+		ids = append(ids, *credential.ID)
+	}
+	return ids
+}
+
+func createApplication(ctx context.Context, tx *gorm.DB, dao repository.Repository, data *entity.Application) (*entity.Application, string, error) {
+	app, err := dao.NewApplicationRepository().CreateApplication(ctx, tx, data)
+	if err != nil {
+		return nil, "", err
+	}
+	accessToken, err := createApplicationCredential(ctx, tx, dao, app.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return app, accessToken, nil
+}
+
+// createApplicationCredential stores the hash of a new credential of the
+// application and returns its access token.
+func createApplicationCredential(ctx context.Context, tx *gorm.DB, dao repository.Repository, applicationId *uuid.UUID) (string, error) {
+	id := uuid.New()
+	accessToken, secretHash, err := appcredential.Generate(id)
+	if err != nil {
+		return "", err
+	}
+	_, err = dao.NewApplicationCredentialRepository().CreateApplicationCredential(ctx, tx, &entity.ApplicationCredential{ID: &id, ApplicationId: applicationId, SecretHash: secretHash})
+	if err != nil {
+		return "", err
+	}
+	return accessToken, nil
+}
+
+func applicationScopes(app *entity.Application) []string {
+	if app.Scopes == "" {
+		return nil
+	}
+	return strings.Split(app.Scopes, ",")
+}
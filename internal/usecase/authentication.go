@@ -2,9 +2,7 @@ package usecase
 
 import (
 	"context"
-	"fmt"
-
-	"errors"
+	"sort"
 	"time"
 
 	"firebase.google.com/go/messaging"
@@ -12,10 +10,11 @@ import (
 	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/notification"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
-	smtp "github.com/daniarmas/api_go/utils/smtp"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/twpayne/go-geom"
@@ -33,9 +32,13 @@ type AuthenticationService interface {
 	SignOut(ctx context.Context, req *pb.SignOutRequest, md *utils.ClientMetadata) (*gp.Empty, error)
 	CheckSession(ctx context.Context, md *utils.ClientMetadata) (*pb.CheckSessionResponse, error)
 	ListSession(ctx context.Context, md *utils.ClientMetadata) (*pb.ListSessionResponse, error)
+	RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	RevokeAllOtherSessions(ctx context.Context, md *utils.ClientMetadata) (*gp.Empty, error)
 	RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest, md *utils.ClientMetadata) (*pb.RefreshTokenResponse, error)
 	SessionExists(ctx context.Context, req *pb.SessionExistsRequest, md *utils.ClientMetadata) (*pb.SessionExistsResponse, error)
 	SendPushNotification(ctx context.Context, req *pb.SendPushNotificationRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	ListJwk(ctx context.Context) (*pb.ListJwkResponse, error)
+	PurgeVerificationCodes(ctx context.Context) (int, error)
 }
 
 type authenticationService struct {
@@ -73,26 +76,31 @@ func (v *authenticationService) SendPushNotification(ctx context.Context, req *p
 	return &gp.Empty{}, nil
 }
 
+func (v *authenticationService) ListJwk(ctx context.Context) (*pb.ListJwkResponse, error) {
+	jwks := v.dao.NewJwtTokenRepository().ListJwk()
+	res := pb.ListJwkResponse{Keys: make([]*pb.Jwk, 0, len(jwks))}
+	for _, item := range jwks {
+		res.Keys = append(res.Keys, &pb.Jwk{Kty: item.Kty, Use: item.Use, Alg: item.Alg, Kid: item.Kid, N: item.N, E: item.E, Crv: item.Crv, X: item.X})
+	}
+	return &res, nil
+}
+
 func (v *authenticationService) SessionExists(ctx context.Context, req *pb.SessionExistsRequest, md *utils.ClientMetadata) (*pb.SessionExistsResponse, error) {
 	var res pb.SessionExistsResponse
-	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		_, err = v.dao.NewVerificationCodeRepository().GetVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Code: req.Code, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignIn"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("verification code not found")
-		}
+	_, err := checkVerificationCode(ctx, v.sqldb, v.dao, v.config, req.Code, &entity.VerificationCode{Email: req.Email, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignIn"})
+	if err != nil {
+		return nil, err
+	}
+	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
 		user, err := v.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{Email: req.Email})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("user not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user not found")
 		} else if err != nil {
 			return err
 		}
 		authorizationToken, err := v.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: user.ID})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("session not exists")
+		if apperror.IsNotFound(err) {
+			return apperror.Unauthenticated("session not exists")
 		} else if err != nil {
 			return err
 		}
@@ -106,9 +114,17 @@ func (v *authenticationService) SessionExists(ctx context.Context, req *pb.Sessi
 }
 
 func (v *authenticationService) CreateVerificationCode(ctx context.Context, req *pb.CreateVerificationCodeRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
-	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+	err := checkVerificationCodeLockout(ctx, v.dao, req.Email, *md.DeviceIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	expirationTime, err := newVerificationCodeExpirationTime(v.config)
+	if err != nil {
+		return nil, err
+	}
+	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
 		device, err := v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if device == nil {
 			_, err = v.dao.NewDeviceRepository().CreateDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, Platform: *md.Platform, SystemVersion: *md.SystemVersion, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId, Model: *md.Model})
@@ -121,27 +137,28 @@ func (v *authenticationService) CreateVerificationCode(ctx context.Context, req
 				return err
 			}
 		}
-		_, err = v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
 		user, err := v.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{Email: req.Email})
 		if err != nil {
-			if err.Error() == "record not found" && (req.Type.String() == "SignIn") {
-				return errors.New("user not found")
+			if apperror.IsNotFound(err) && (req.Type.String() == "SignIn") {
+				return apperror.NotFound("user not found")
 			}
 		} else if user != nil && (req.Type.String() == "SignUp" || req.Type.String() == "ChangeUserEmail") {
-			return errors.New("user already exists")
+			return apperror.AlreadyExists("user already exists")
 		}
 		v.dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Type: req.Type.String(), DeviceIdentifier: *md.DeviceIdentifier}, nil)
-		createVerificationCodeRes, err := v.dao.NewVerificationCodeRepository().CreateVerificationCode(ctx, tx, &entity.VerificationCode{Code: utils.EncodeToString(6), Email: req.Email, Type: req.Type.Enum().String(), DeviceIdentifier: *md.DeviceIdentifier, CreateTime: time.Now(), UpdateTime: time.Now()})
+		createVerificationCodeRes, err := v.dao.NewVerificationCodeRepository().CreateVerificationCode(ctx, tx, &entity.VerificationCode{Code: utils.EncodeToString(6), Email: req.Email, Type: req.Type.Enum().String(), DeviceIdentifier: *md.DeviceIdentifier, ExpirationTime: expirationTime, CreateTime: time.Now(), UpdateTime: time.Now()})
 		if err != nil {
 			return err
 		}
-		verificationCodeMsg := fmt.Sprintf("Su código de verificación es %s", createVerificationCodeRes.Code)
-		err = smtp.SendMail(req.Email, v.config.EmailAddress, v.config.EmailAddressPassword, "Código de Verificación", verificationCodeMsg, v.config)
+		locale := notification.DefaultLocale
+		if user != nil {
+			locale, err = userLocale(ctx, tx, v.dao, user.ID)
+			if err != nil {
+				return err
+			}
+		}
+		err = enqueueOutbox(tx, v.dao, entity.OutboxChannelEmail, &notification.Message{Event: notification.EventVerificationCode, Locale: locale, To: req.Email, Data: map[string]string{"code": createVerificationCodeRes.Code}})
 		if err != nil {
-			log.Errorf(err.Error())
 			return err
 		}
 		return nil
@@ -152,20 +169,18 @@ func (v *authenticationService) CreateVerificationCode(ctx context.Context, req
 	return &gp.Empty{}, nil
 }
 
+// PurgeVerificationCodes deletes the expired and the used verification codes,
+// and returns how many were deleted.
+func (v *authenticationService) PurgeVerificationCodes(ctx context.Context) (int, error) {
+	purged, err := v.dao.NewVerificationCodeRepository().PurgeVerificationCode(ctx, v.sqldb.Gorm.WithContext(ctx), time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
 func (v *authenticationService) GetVerificationCode(ctx context.Context, req *pb.GetVerificationCodeRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
-	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		_, err = v.dao.NewVerificationCodeRepository().GetVerificationCode(ctx, tx, &entity.VerificationCode{Code: req.Code, Email: req.Email, Type: req.Type.String(), DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("verification code not found")
-		} else if err != nil {
-			return err
-		}
-		return nil
-	})
+	_, err := checkVerificationCode(ctx, v.sqldb, v.dao, v.config, req.Code, &entity.VerificationCode{Email: req.Email, Type: req.Type.String(), DeviceIdentifier: *md.DeviceIdentifier})
 	if err != nil {
 		return nil, err
 	}
@@ -177,18 +192,21 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 	var cartItems *[]entity.CartItem
 	var configuration *entity.UserConfiguration
 	var app *entity.Application
-	var authToken *entity.AuthorizationToken
 	var actualDevice *entity.Device
-	var signOutDevice *entity.Device
+	var signOutDevices []entity.Device
 	var existsUpcomingOrders *bool
 	var err error
 	var (
 		jwtRefreshToken       *datasource.JsonWebTokenMetadata
 		jwtAuthorizationToken *datasource.JsonWebTokenMetadata
 	)
+	_, err = checkVerificationCode(ctx, v.sqldb, v.dao, v.config, req.Code, &entity.VerificationCode{Email: req.Email, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignIn"})
+	if err != nil {
+		return nil, err
+	}
 	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
 		actualDevice, err = v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if actualDevice == nil {
 			actualDevice, err = v.dao.NewDeviceRepository().CreateDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, Platform: *md.Platform, SystemVersion: *md.SystemVersion, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId, Model: *md.Model})
@@ -201,66 +219,52 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 				return err
 			}
 		}
-		app, err = v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		app, err = applicationFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		_, err = v.dao.NewVerificationCodeRepository().GetVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Code: req.Code, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignIn"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("verification code not found")
+		user, err = v.dao.NewUserRepository().GetUserWithAddress(ctx, tx, &entity.User{Email: req.Email})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user not found")
 		} else if err != nil {
 			return err
 		}
-		user, err = v.dao.NewUserRepository().GetUserWithAddress(ctx, tx, &entity.User{Email: req.Email})
+		err = checkUserBan(ctx, tx, v.dao, user.ID)
 		if err != nil {
-			switch err.Error() {
-			case "record not found":
-				return errors.New("user not found")
-			default:
-				return err
-			}
+			return err
 		}
-		// Limit session to one by device
-		authToken, err = v.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: user.ID})
-		if err != nil && err.Error() != "record not found" {
+		// The session of this device is replaced, the sessions of the other
+		// devices count against the limit of the app.
+		sessions, err := v.dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: user.ID})
+		if err != nil {
 			return err
 		}
-		if authToken != nil && !req.Logout {
-			return errors.New("session limit reached")
-		} else if authToken != nil && req.Logout {
-			deleteRefreshTokenRes, err := v.dao.NewRefreshTokenRepository().DeleteRefreshToken(ctx, tx, &entity.RefreshToken{UserId: user.ID}, nil)
-			if err != nil && err.Error() != "record not found" {
-				return err
+		var deviceSessions, appSessions []entity.AuthorizationToken
+		for _, session := range *sessions {
+			if *session.DeviceId == *actualDevice.ID {
+				deviceSessions = append(deviceSessions, session)
+			} else if session.App != nil && *session.App == app.Name {
+				appSessions = append(appSessions, session)
 			}
-			if deleteRefreshTokenRes != nil && len(*deleteRefreshTokenRes) != 0 {
-				deleteAuthorizationTokenRes, deleteAuthorizationTokenErr := v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{RefreshTokenId: (*deleteRefreshTokenRes)[0].ID}, nil)
-				if deleteAuthorizationTokenErr != nil {
-					return deleteAuthorizationTokenErr
-				}
-				if authToken != nil && req.Logout {
-					deviceId := *deleteAuthorizationTokenRes
-					signOutDevice, err = v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{ID: deviceId[0].DeviceId})
-					if err != nil && err.Error() != "record not found" {
-						return err
-					}
-				}
+		}
+		overflow := len(appSessions) - app.SessionLimit() + 1
+		if overflow > 0 && !req.Logout {
+			return apperror.PermissionDenied("session limit reached")
+		} else if overflow > 0 {
+			sort.Slice(appSessions, func(i, j int) bool { return appSessions[i].CreateTime.Before(appSessions[j].CreateTime) })
+			signOutDevices, err = revokeSessions(ctx, tx, v.dao, appSessions[:overflow])
+			if err != nil {
+				return err
 			}
 		}
-		//
-		_, err = v.dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Type: "SignIn", DeviceIdentifier: *md.DeviceIdentifier}, nil)
+		_, err = revokeSessions(ctx, tx, v.dao, deviceSessions)
 		if err != nil {
 			return err
 		}
-		deleteRefreshTokenRes, err := v.dao.NewRefreshTokenRepository().DeleteRefreshToken(ctx, tx, &entity.RefreshToken{UserId: user.ID, DeviceId: actualDevice.ID}, nil)
-		if err != nil && err.Error() != "record not found" {
+		_, err = v.dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Type: "SignIn", DeviceIdentifier: *md.DeviceIdentifier}, nil)
+		if err != nil {
 			return err
 		}
-		if deleteRefreshTokenRes != nil && len(*deleteRefreshTokenRes) != 0 {
-			_, err = v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{RefreshTokenId: (*deleteRefreshTokenRes)[0].ID}, nil)
-			if err != nil {
-				return err
-			}
-		}
 		createRefreshTokenRes, err := v.dao.NewRefreshTokenRepository().CreateRefreshToken(ctx, tx, &entity.RefreshToken{UserId: user.ID, DeviceId: actualDevice.ID})
 		if err != nil {
 			return err
@@ -291,6 +295,26 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 		if err != nil {
 			return err
 		}
+		var locale string
+		locale, err = userLocale(ctx, tx, v.dao, user.ID)
+		if err != nil {
+			return err
+		}
+		signInAlert := map[string]string{
+			"time":          time.Now().UTC().String(),
+			"model":         *md.Model,
+			"platform":      *md.Platform,
+			"systemVersion": *md.SystemVersion,
+			"appName":       v.config.AppName,
+		}
+		err = notifySessionDelete(tx, v.dao, signOutDevices, notification.EventSignInAlert, locale, signInAlert)
+		if err != nil {
+			return err
+		}
+		err = enqueueOutbox(tx, v.dao, entity.OutboxChannelEmail, &notification.Message{Event: notification.EventSignInAlert, Locale: locale, To: req.Email, Data: signInAlert})
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 	if err != nil {
@@ -350,35 +374,6 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 		thumbnailUrl = v.config.UsersBulkName + "/" + user.Thumbnail
 
 	}
-	if authToken != nil && req.Logout {
-		bodyMsg := "Su sesión ha sido cerrada. Se ha iniciado una nueva sesión en un nuevo dispositivo"
-		// See documentation on defining a message payload.
-		message := &messaging.Message{
-			Notification: &messaging.Notification{
-				Title: "Inició de sesión",
-				Body:  bodyMsg,
-			},
-			Data: map[string]string{
-				"res_id": "",
-				"res":    "session",
-				"verb":   "delete",
-			},
-			Token: signOutDevice.FirebaseCloudMessagingId,
-		}
-		// Send a message to the device corresponding to the provided
-		// registration token.
-		response, err := v.fcmMoolShoppingClient.Send(ctx, message)
-		if err != nil && v.config.Environment != "development" && v.config.Environment != "staging" {
-			return nil, err
-		}
-		// Response is a message ID string.
-		fmt.Println("Successfully sent message:", response)
-	}
-	err = smtp.SendSignInMail(req.Email, time.Now(), v.config, md)
-	if err != nil {
-		log.Errorf(err.Error())
-		return nil, err
-	}
 	return &pb.SignInResponse{AuthorizationToken: *jwtAuthorizationToken.Token, RefreshToken: *jwtRefreshToken.Token, User: &pb.User{
 		Id:                   user.ID.String(),
 		FullName:             user.FullName,
@@ -401,6 +396,8 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 			HighQualityImagesData: *configuration.HighQualityImagesData,
 			UserId:                configuration.UserId.String(),
 			PaymentMethod:         *utils.ParsePaymentMethodType(&configuration.PaymentMethod),
+			Language:              configuration.Language,
+			OrderNotifications:    configuration.OrderNotifications,
 			CreateTime:            timestamppb.New(configuration.CreateTime),
 			UpdateTime:            timestamppb.New(configuration.UpdateTime),
 		},
@@ -412,36 +409,33 @@ func (v *authenticationService) SignIn(ctx context.Context, req *pb.SignInReques
 func (v *authenticationService) SignUp(ctx context.Context, req *pb.SignUpRequest, md *utils.ClientMetadata) (*pb.SignUpResponse, error) {
 	var getUserRes *entity.User
 	var getDeviceRes *entity.Device
-	var getVerificationCode *entity.VerificationCode
 	var createUserAddressRes *entity.UserAddress
 	var createUserRes *entity.User
 	var (
 		jwtRefreshToken       *datasource.JsonWebTokenMetadata
 		jwtAuthorizationToken *datasource.JsonWebTokenMetadata
 	)
-	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		app, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+	getVerificationCode, err := checkVerificationCode(ctx, v.sqldb, v.dao, v.config, req.Code, &entity.VerificationCode{Email: req.Email, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignUp"})
+	if err != nil {
+		return nil, err
+	}
+	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		app, err := applicationFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		getVerificationCode, err = v.dao.NewVerificationCodeRepository().GetVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.Email, Code: req.Code, DeviceIdentifier: *md.DeviceIdentifier, Type: "SignUp"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("verification code not found")
-		} else if err != nil {
-			return err
-		}
 		getUserRes, err = v.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{Email: req.Email})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if getUserRes != nil {
-			return errors.New("user exists")
+			return apperror.AlreadyExists("user exists")
 		}
 		_, err = v.dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{ID: getVerificationCode.ID}, nil)
 		if err != nil {
 			return err
 		}
 		getDeviceRes, err = v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if getDeviceRes == nil {
 			getDeviceRes, err = v.dao.NewDeviceRepository().CreateDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, Platform: *md.Platform, SystemVersion: *md.SystemVersion, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId, Model: *md.Model})
@@ -458,12 +452,12 @@ func (v *authenticationService) SignUp(ctx context.Context, req *pb.SignUpReques
 		falseValue := false
 		coordinates := ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.UserAddress.Coordinates.Latitude, req.UserAddress.Coordinates.Longitude}).SetSRID(4326)}
 		muncipalityRes, err := v.dao.NewMunicipalityRepository().GetMunicipalityByCoordinate(tx, coordinates)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("municipality not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("municipality not found")
 		} else if err != nil {
 			return err
 		}
-		createUserRes, err = v.dao.NewUserRepository().CreateUser(ctx, tx, &entity.User{Email: req.Email, IsLegalAge: true, FullName: req.FullName, UserConfiguration: entity.UserConfiguration{PaymentMethod: "PaymentMethodTypeCash", DataSaving: &falseValue, HighQualityImagesWifi: &trueValue, HighQualityImagesData: &trueValue}})
+		createUserRes, err = v.dao.NewUserRepository().CreateUser(ctx, tx, &entity.User{Email: req.Email, IsLegalAge: true, FullName: req.FullName, UserConfiguration: entity.UserConfiguration{PaymentMethod: "PaymentMethodTypeCash", DataSaving: &falseValue, HighQualityImagesWifi: &trueValue, HighQualityImagesData: &trueValue, OrderNotifications: &trueValue}})
 		if err != nil {
 			return err
 		}
@@ -532,6 +526,8 @@ func (v *authenticationService) SignUp(ctx context.Context, req *pb.SignUpReques
 			HighQualityImagesData: *createUserRes.UserConfiguration.HighQualityImagesData,
 			UserId:                createUserRes.UserConfiguration.UserId.String(),
 			PaymentMethod:         *utils.ParsePaymentMethodType(&createUserRes.UserConfiguration.PaymentMethod),
+			Language:              createUserRes.UserConfiguration.Language,
+			OrderNotifications:    createUserRes.UserConfiguration.OrderNotifications,
 			CreateTime:            timestamppb.New(createUserRes.UserConfiguration.CreateTime),
 			UpdateTime:            timestamppb.New(createUserRes.UserConfiguration.UpdateTime),
 		},
@@ -544,7 +540,7 @@ func (v *authenticationService) CheckSession(ctx context.Context, md *utils.Clie
 	var res pb.CheckSessionResponse
 	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
 		deviceRes, err := v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if deviceRes == nil {
 			_, err = v.dao.NewDeviceRepository().CreateDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, Platform: *md.Platform, SystemVersion: *md.SystemVersion, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId, Model: *md.Model})
@@ -563,36 +559,17 @@ func (v *authenticationService) CheckSession(ctx context.Context, md *utils.Clie
 		return nil, err
 	}
 	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		app, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		if md.Authorization != nil && *md.Authorization != "" {
-			jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-			authorizationTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-			if authorizationTokenParseErr != nil {
-				switch authorizationTokenParseErr.Error() {
-				case "Token is expired":
-					return errors.New("authorization token expired")
-				case "signature is invalid":
-					return errors.New("authorization token signature is invalid")
-				case "token contains an invalid number of segments":
-					return errors.New("authorization token contains an invalid number of segments")
-				default:
-					return authorizationTokenParseErr
-				}
-			}
-			authorizationTokenRes, err := v.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-			if err != nil && err.Error() == "record not found" {
-				return errors.New("unauthenticated user")
-			} else if err != nil {
-				return err
-			}
+		app, err := applicationFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if principal, ok := PrincipalFromContext(ctx); ok && principal.AuthorizationToken != nil {
+			authorizationTokenRes := principal.AuthorizationToken
 			userRes, err := v.dao.NewUserRepository().GetUserWithAddress(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
 			if err != nil {
 				return err
 			} else if userRes == nil {
-				return errors.New("unauthenticated user")
+				return apperror.ErrUnauthenticatedUser
 			}
 			cartItems, err := v.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
 			if err != nil {
@@ -681,6 +658,8 @@ func (v *authenticationService) CheckSession(ctx context.Context, md *utils.Clie
 					HighQualityImagesData: *configuration.HighQualityImagesData,
 					UserId:                configuration.UserId.String(),
 					PaymentMethod:         *utils.ParsePaymentMethodType(&configuration.PaymentMethod),
+					Language:              configuration.Language,
+					OrderNotifications:    configuration.OrderNotifications,
 					CreateTime:            timestamppb.New(configuration.CreateTime),
 					UpdateTime:            timestamppb.New(configuration.UpdateTime),
 				},
@@ -702,33 +681,13 @@ func (v *authenticationService) SignOut(ctx context.Context, req *pb.SignOutRequ
 		if req.AuthorizationTokenId != "" {
 			authorizationTokenId = uuid.MustParse(req.AuthorizationTokenId)
 		}
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		authorizationTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if authorizationTokenParseErr != nil {
-			switch authorizationTokenParseErr.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return authorizationTokenParseErr
-			}
-		}
-		authorizationTokenRes, authorizationTokenErr := v.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if authorizationTokenErr != nil && authorizationTokenErr.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if authorizationTokenErr != nil {
-			return authorizationTokenErr
-		}
 		if req.All {
 			var refreshTokenIds []uuid.UUID
-			deleteRefreshTokenRes, deleteRefreshTokenErr := v.dao.NewRefreshTokenRepository().DeleteRefreshTokenDeviceIdNotEqual(ctx, tx, &entity.RefreshToken{DeviceId: authorizationTokenRes.DeviceId}, nil)
+			deleteRefreshTokenRes, deleteRefreshTokenErr := v.dao.NewRefreshTokenRepository().DeleteRefreshTokenDeviceIdNotEqual(ctx, tx, &entity.RefreshToken{DeviceId: principal.AuthorizationToken.DeviceId}, nil)
 			if deleteRefreshTokenErr != nil {
 				return deleteRefreshTokenErr
 			}
@@ -755,11 +714,11 @@ func (v *authenticationService) SignOut(ctx context.Context, req *pb.SignOutRequ
 			}
 			return nil
 		} else {
-			_, deleteRefreshTokenErr := v.dao.NewRefreshTokenRepository().DeleteRefreshToken(ctx, tx, &entity.RefreshToken{UserId: authorizationTokenRes.UserId, DeviceId: authorizationTokenRes.DeviceId}, nil)
+			_, deleteRefreshTokenErr := v.dao.NewRefreshTokenRepository().DeleteRefreshToken(ctx, tx, &entity.RefreshToken{UserId: principal.AuthorizationToken.UserId, DeviceId: principal.AuthorizationToken.DeviceId}, nil)
 			if deleteRefreshTokenErr != nil {
 				return deleteRefreshTokenErr
 			}
-			_, deleteAuthorizationTokenErr := v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: authorizationTokenRes.ID}, nil)
+			_, deleteAuthorizationTokenErr := v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: principal.AuthorizationToken.ID}, nil)
 			if deleteAuthorizationTokenErr != nil {
 				return deleteAuthorizationTokenErr
 			}
@@ -777,30 +736,11 @@ func (v *authenticationService) ListSession(ctx context.Context, md *utils.Clien
 	var authorizationTokenRes *entity.AuthorizationToken
 	var listSessionErr error
 	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		authorizationTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if authorizationTokenParseErr != nil {
-			switch authorizationTokenParseErr.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return authorizationTokenParseErr
-			}
-		}
-		authorizationTokenRes, err := v.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
+		authorizationTokenRes = principal.AuthorizationToken
 		listSessionRes, listSessionErr = v.dao.NewSessionRepository().ListSession(tx, &entity.Session{UserId: authorizationTokenRes.UserId})
 		if listSessionErr != nil {
 			return listSessionErr
@@ -810,44 +750,111 @@ func (v *authenticationService) ListSession(ctx context.Context, md *utils.Clien
 	if err != nil {
 		return nil, err
 	}
-	var actualSession pb.Session
+	var actualSession *pb.Session
 	otherSessions := make([]*pb.Session, 0, len(*listSessionRes))
 	for _, e := range *listSessionRes {
-		if *e.DeviceId != *authorizationTokenRes.DeviceId {
-			otherSessions = append(otherSessions, &pb.Session{
-				Id:            e.ID.String(),
-				Platform:      *utils.ParsePlatformType(&e.Platform),
-				SystemVersion: e.SystemVersion,
-				Model:         e.Model,
-				App:           *utils.ParseAppType(&e.App),
-				AppVersion:    e.AppVersion,
-				DeviceId:      e.DeviceId.String(),
-			})
+		session := &pb.Session{
+			Id:            e.ID.String(),
+			Platform:      *utils.ParsePlatformType(&e.Platform),
+			SystemVersion: e.SystemVersion,
+			Model:         e.Model,
+			App:           *utils.ParseAppType(&e.App),
+			AppVersion:    e.AppVersion,
+			DeviceId:      e.DeviceId.String(),
+			LastSeenIp:    e.LastSeenIp,
+			CreateTime:    timestamppb.New(e.CreateTime),
+		}
+		if e.LastSeenTime != nil {
+			session.LastSeenTime = timestamppb.New(*e.LastSeenTime)
+		}
+		if *e.ID != *authorizationTokenRes.ID {
+			otherSessions = append(otherSessions, session)
 		} else {
-			actualSession = pb.Session{
-				Id:            e.ID.String(),
-				Platform:      *utils.ParsePlatformType(&e.Platform),
-				SystemVersion: e.SystemVersion,
-				Model:         e.Model,
-				App:           *utils.ParseAppType(&e.App),
-				AppVersion:    e.AppVersion,
-				DeviceId:      e.DeviceId.String(),
+			actualSession = session
+		}
+	}
+	return &pb.ListSessionResponse{OtherSessions: otherSessions, ActualSession: actualSession}, nil
+}
+
+func (v *authenticationService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
+	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		principal, err := principalFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		sessionId := uuid.MustParse(req.SessionId)
+		if sessionId == *principal.AuthorizationToken.ID {
+			return apperror.InvalidArgument("the current session is closed with sign out")
+		}
+		sessions, err := v.dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: &sessionId, UserId: principal.AuthorizationToken.UserId})
+		if err != nil {
+			return err
+		} else if len(*sessions) == 0 {
+			return apperror.NotFound("session not found")
+		}
+		return v.closeSessions(ctx, tx, *sessions, principal.AuthorizationToken.UserId, md)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gp.Empty{}, nil
+}
+
+func (v *authenticationService) RevokeAllOtherSessions(ctx context.Context, md *utils.ClientMetadata) (*gp.Empty, error) {
+	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		principal, err := principalFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		sessions, err := v.dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: principal.AuthorizationToken.UserId})
+		if err != nil {
+			return err
+		}
+		otherSessions := make([]entity.AuthorizationToken, 0, len(*sessions))
+		for _, session := range *sessions {
+			if *session.ID != *principal.AuthorizationToken.ID {
+				otherSessions = append(otherSessions, session)
 			}
 		}
+		return v.closeSessions(ctx, tx, otherSessions, principal.AuthorizationToken.UserId, md)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &pb.ListSessionResponse{OtherSessions: otherSessions, ActualSession: &actualSession}, nil
+	return &gp.Empty{}, nil
+}
+
+// closeSessions revokes the sessions closed by the user from another device
+// and sends the "session delete" push to their devices.
+func (v *authenticationService) closeSessions(ctx context.Context, tx *gorm.DB, sessions []entity.AuthorizationToken, userId *uuid.UUID, md *utils.ClientMetadata) error {
+	devices, err := revokeSessions(ctx, tx, v.dao, sessions)
+	if err != nil {
+		return err
+	}
+	locale, err := userLocale(ctx, tx, v.dao, userId)
+	if err != nil {
+		return err
+	}
+	return notifySessionDelete(tx, v.dao, devices, notification.EventSessionClosed, locale, map[string]string{
+		"time":          time.Now().UTC().String(),
+		"model":         *md.Model,
+		"platform":      *md.Platform,
+		"systemVersion": *md.SystemVersion,
+		"appName":       v.config.AppName,
+	})
 }
 
 func (v *authenticationService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest, md *utils.ClientMetadata) (*pb.RefreshTokenResponse, error) {
 	var jwtAuthorizationTokenErr, jwtRefreshTokenErr error
 	var jwtRefreshTokenNew, jwtAuthorizationTokenNew *datasource.JsonWebTokenMetadata
+	var reused bool
 	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		app, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		app, err := applicationFromContext(ctx)
 		if err != nil {
 			return err
 		}
 		deviceRes, err := v.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if deviceRes == nil {
 			deviceRes, err = v.dao.NewDeviceRepository().CreateDevice(ctx, tx, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, Platform: *md.Platform, SystemVersion: *md.SystemVersion, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId, Model: *md.Model})
@@ -863,36 +870,38 @@ func (v *authenticationService) RefreshToken(ctx context.Context, req *pb.Refres
 		jwtRefreshToken := &datasource.JsonWebTokenMetadata{Token: &req.RefreshToken}
 		refreshTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtRefreshToken(jwtRefreshToken)
 		if refreshTokenParseErr != nil {
-			switch refreshTokenParseErr.Error() {
-			case "Token is expired":
-				return errors.New("refresh token expired")
-			case "signature is invalid":
-				return errors.New("refresh token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("refresh token contains an invalid number of segments")
-			default:
-				return refreshTokenParseErr
-			}
-		}
-		refreshTokenRes, refreshTokenErr := v.dao.NewRefreshTokenRepository().GetRefreshToken(ctx, tx, &entity.RefreshToken{ID: jwtRefreshToken.TokenId})
-		if refreshTokenErr != nil && refreshTokenErr.Error() == "record not found" {
-			return errors.New("refresh token not found")
+			return refreshTokenParseErr
+		}
+		refreshTokenRes, refreshTokenErr := v.dao.NewRefreshTokenRepository().GetRefreshTokenForUpdate(ctx, tx, &entity.RefreshToken{ID: jwtRefreshToken.TokenId})
+		if apperror.IsNotFound(refreshTokenErr) {
+			return apperror.Unauthenticated("refresh token not found")
 		} else if refreshTokenErr != nil {
 			return refreshTokenErr
 		}
+		// The refresh tokens are used once. Presenting one again means it was
+		// copied, so the sessions of its family are closed.
+		if refreshTokenRes.UsedTime != nil {
+			reused = true
+			return v.revokeRefreshTokenFamily(ctx, tx, refreshTokenRes, md)
+		}
 		userRes, userErr := v.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: refreshTokenRes.UserId})
 		if userErr != nil {
 			return userErr
 		}
-		deleteRefreshTokenRes, deleteRefreshTokenErr := v.dao.NewRefreshTokenRepository().DeleteRefreshToken(ctx, tx, &entity.RefreshToken{ID: refreshTokenRes.ID}, nil)
-		if deleteRefreshTokenErr != nil {
-			return deleteRefreshTokenErr
+		familyId := refreshTokenRes.FamilyId
+		if familyId == nil {
+			familyId = refreshTokenRes.ID
+		}
+		usedTime := time.Now().UTC()
+		_, refreshTokenErr = v.dao.NewRefreshTokenRepository().UpdateRefreshToken(ctx, tx, &entity.RefreshToken{ID: refreshTokenRes.ID}, &entity.RefreshToken{FamilyId: familyId, UsedTime: &usedTime})
+		if refreshTokenErr != nil {
+			return refreshTokenErr
 		}
-		_, deleteAuthorizationTokenErr := v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{RefreshTokenId: (*deleteRefreshTokenRes)[0].ID}, nil)
-		if deleteAuthorizationTokenErr != nil {
+		_, deleteAuthorizationTokenErr := v.dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, &entity.AuthorizationToken{RefreshTokenId: refreshTokenRes.ID}, nil)
+		if deleteAuthorizationTokenErr != nil && !apperror.IsNotFound(deleteAuthorizationTokenErr) {
 			return deleteAuthorizationTokenErr
 		}
-		refreshTokenRes, refreshTokenErr = v.dao.NewRefreshTokenRepository().CreateRefreshToken(ctx, tx, &entity.RefreshToken{UserId: userRes.ID, DeviceId: deviceRes.ID})
+		refreshTokenRes, refreshTokenErr = v.dao.NewRefreshTokenRepository().CreateRefreshToken(ctx, tx, &entity.RefreshToken{UserId: userRes.ID, DeviceId: deviceRes.ID, FamilyId: familyId, ParentId: refreshTokenRes.ID})
 		if refreshTokenErr != nil {
 			return refreshTokenErr
 		}
@@ -914,6 +923,46 @@ func (v *authenticationService) RefreshToken(ctx context.Context, req *pb.Refres
 	})
 	if err != nil {
 		return nil, err
+	} else if reused {
+		return nil, apperror.Unauthenticated("refresh token reused")
 	}
 	return &pb.RefreshTokenResponse{RefreshToken: *jwtRefreshTokenNew.Token, AuthorizationToken: *jwtAuthorizationTokenNew.Token}, nil
 }
+
+// revokeRefreshTokenFamily deletes the refresh tokens of the family of the
+// reused token with their authorization tokens, and tells the user through
+// the devices of the family and by email.
+func (v *authenticationService) revokeRefreshTokenFamily(ctx context.Context, tx *gorm.DB, refreshToken *entity.RefreshToken, md *utils.ClientMetadata) error {
+	refreshTokens, err := deleteRefreshTokenFamily(ctx, tx, v.dao, refreshToken)
+	if err != nil {
+		return err
+	}
+	deviceIds := make([]uuid.UUID, 0, len(*refreshTokens))
+	for _, item := range *refreshTokens {
+		deviceIds = append(deviceIds, *item.DeviceId)
+	}
+	user, err := v.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: refreshToken.UserId})
+	if err != nil {
+		return err
+	}
+	locale, err := userLocale(ctx, tx, v.dao, user.ID)
+	if err != nil {
+		return err
+	}
+	sessionRevoked := map[string]string{
+		"time":          time.Now().UTC().String(),
+		"model":         *md.Model,
+		"platform":      *md.Platform,
+		"systemVersion": *md.SystemVersion,
+		"appName":       v.config.AppName,
+	}
+	devices, err := v.dao.NewDeviceRepository().ListDeviceInIds(tx, deviceIds)
+	if err != nil {
+		return err
+	}
+	err = notifySessionDelete(tx, v.dao, *devices, notification.EventSessionRevoked, locale, sessionRevoked)
+	if err != nil {
+		return err
+	}
+	return enqueueOutbox(tx, v.dao, entity.OutboxChannelEmail, &notification.Message{Event: notification.EventSessionRevoked, Locale: locale, To: user.Email, Data: sessionRevoked})
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+	gp "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// bannedError tells the client until when the ban lasts. Permanent bans
+// don't carry the expiration.
+func bannedError(message string, expirationTime *time.Time) error {
+	err := apperror.PermissionDenied(message)
+	if expirationTime == nil {
+		return err
+	}
+	return err.WithMetadata("expiration_time", expirationTime.Format(time.RFC3339)).WithDetails(&epb.RetryInfo{RetryDelay: durationpb.New(time.Until(*expirationTime))})
+}
+
+// checkDeviceBan fails while the device is banned.
+func checkDeviceBan(ctx context.Context, tx *gorm.DB, dao repository.Repository, deviceIdentifier string) error {
+	ban, err := dao.NewBannedDeviceRepository().GetActiveBannedDevice(ctx, tx, deviceIdentifier)
+	if apperror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return bannedError("device banned", ban.ExpirationTime)
+}
+
+// checkUserBan fails while the user is banned.
+func checkUserBan(ctx context.Context, tx *gorm.DB, dao repository.Repository, userId *uuid.UUID) error {
+	ban, err := dao.NewBannedUserRepository().GetActiveBannedUser(ctx, tx, userId)
+	if apperror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return bannedError("user banned", ban.ExpirationTime)
+}
+
+type BanService interface {
+	BanDevice(ctx context.Context, req *pb.BanDeviceRequest, md *utils.ClientMetadata) (*pb.BannedDevice, error)
+	UnbanDevice(ctx context.Context, req *pb.UnbanDeviceRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	BanUser(ctx context.Context, req *pb.BanUserRequest, md *utils.ClientMetadata) (*pb.BannedUser, error)
+	UnbanUser(ctx context.Context, req *pb.UnbanUserRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+}
+
+type banService struct {
+	dao   repository.Repository
+	sqldb *sqldb.Sql
+}
+
+func NewBanService(dao repository.Repository, sqldb *sqldb.Sql) BanService {
+	return &banService{dao: dao, sqldb: sqldb}
+}
+
+// moderatorFromContext returns the signed in user when it has the permission.
+func (i *banService) moderatorFromContext(ctx context.Context, tx *gorm.DB, permission string) (*Principal, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: permission})
+	if apperror.IsNotFound(err) {
+		return nil, apperror.ErrPermissionDenied
+	} else if err != nil {
+		return nil, err
+	}
+	return principal, nil
+}
+
+func (i *banService) BanDevice(ctx context.Context, req *pb.BanDeviceRequest, md *utils.ClientMetadata) (*pb.BannedDevice, error) {
+	var res *pb.BannedDevice
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		moderator, err := i.moderatorFromContext(ctx, tx, "ban_device")
+		if err != nil {
+			return err
+		}
+		if moderator.Device != nil && moderator.Device.DeviceIdentifier == req.DeviceIdentifier {
+			return apperror.InvalidArgument("can't ban the current device")
+		}
+		// A new ban replaces the previous one
+		_, err = i.dao.NewBannedDeviceRepository().DeleteBannedDevice(ctx, tx, &entity.BannedDevice{DeviceIdentifier: req.DeviceIdentifier})
+		if err != nil && !apperror.IsNotFound(err) {
+			return err
+		}
+		ban := &entity.BannedDevice{
+			DeviceIdentifier: req.DeviceIdentifier,
+			Reason:           req.Reason,
+			ModeratorId:      moderator.AuthorizationToken.UserId,
+		}
+		if req.ExpirationTime != nil {
+			expirationTime := req.ExpirationTime.AsTime().UTC()
+			ban.ExpirationTime = &expirationTime
+		}
+		banRes, err := i.dao.NewBannedDeviceRepository().CreateBannedDevice(ctx, tx, ban)
+		if err != nil {
+			return err
+		}
+		device, err := i.dao.NewDeviceRepository().GetDevice(ctx, tx, &entity.Device{DeviceIdentifier: req.DeviceIdentifier})
+		if err != nil && !apperror.IsNotFound(err) {
+			return err
+		} else if device != nil {
+			sessions, err := i.dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{DeviceId: device.ID})
+			if err != nil {
+				return err
+			}
+			_, err = revokeSessions(ctx, tx, i.dao, *sessions)
+			if err != nil {
+				return err
+			}
+		}
+		res = &pb.BannedDevice{
+			Id:               banRes.ID.String(),
+			Reason:           banRes.Reason,
+			DeviceIdentifier: banRes.DeviceIdentifier,
+			ModeratorId:      banRes.ModeratorId.String(),
+			CreateTime:       timestamppb.New(banRes.CreateTime),
+			UpdateTime:       timestamppb.New(banRes.UpdateTime),
+		}
+		if banRes.ExpirationTime != nil {
+			res.ExpirationTime = timestamppb.New(*banRes.ExpirationTime)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *banService) UnbanDevice(ctx context.Context, req *pb.UnbanDeviceRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.moderatorFromContext(ctx, tx, "ban_device")
+		if err != nil {
+			return err
+		}
+		_, err = i.dao.NewBannedDeviceRepository().DeleteBannedDevice(ctx, tx, &entity.BannedDevice{DeviceIdentifier: req.DeviceIdentifier})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("banned device not found")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gp.Empty{}, nil
+}
+
+func (i *banService) BanUser(ctx context.Context, req *pb.BanUserRequest, md *utils.ClientMetadata) (*pb.BannedUser, error) {
+	var res *pb.BannedUser
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		moderator, err := i.moderatorFromContext(ctx, tx, "ban_user")
+		if err != nil {
+			return err
+		}
+		userId := uuid.MustParse(req.UserId)
+		if userId == *moderator.AuthorizationToken.UserId {
+			return apperror.InvalidArgument("can't ban yourself")
+		}
+		_, err = i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: &userId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user not found")
+		} else if err != nil {
+			return err
+		}
+		// A new ban replaces the previous one
+		_, err = i.dao.NewBannedUserRepository().DeleteBannedUser(ctx, tx, &entity.BannedUser{UserId: &userId})
+		if err != nil && !apperror.IsNotFound(err) {
+			return err
+		}
+		ban := &entity.BannedUser{
+			UserId:      &userId,
+			Reason:      req.Reason,
+			ModeratorId: moderator.AuthorizationToken.UserId,
+		}
+		if req.ExpirationTime != nil {
+			expirationTime := req.ExpirationTime.AsTime().UTC()
+			ban.ExpirationTime = &expirationTime
+		}
+		banRes, err := i.dao.NewBannedUserRepository().CreateBannedUser(ctx, tx, ban)
+		if err != nil {
+			return err
+		}
+		sessions, err := i.dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: &userId})
+		if err != nil {
+			return err
+		}
+		_, err = revokeSessions(ctx, tx, i.dao, *sessions)
+		if err != nil {
+			return err
+		}
+		res = &pb.BannedUser{
+			Id:          banRes.ID.String(),
+			Reason:      banRes.Reason,
+			UserId:      banRes.UserId.String(),
+			ModeratorId: banRes.ModeratorId.String(),
+			CreateTime:  timestamppb.New(banRes.CreateTime),
+			UpdateTime:  timestamppb.New(banRes.UpdateTime),
+		}
+		if banRes.ExpirationTime != nil {
+			res.ExpirationTime = timestamppb.New(*banRes.ExpirationTime)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *banService) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.moderatorFromContext(ctx, tx, "ban_user")
+		if err != nil {
+			return err
+		}
+		userId := uuid.MustParse(req.UserId)
+		_, err = i.dao.NewBannedUserRepository().DeleteBannedUser(ctx, tx, &entity.BannedUser{UserId: &userId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("banned user not found")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gp.Empty{}, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestSession signs the user in on the device and returns the
+// authorization token of the session.
+func newTestSession(t *testing.T, dao *memoryRepository, user *entity.User, deviceIdentifier string) string {
+	ctx := context.Background()
+	device, err := dao.NewDeviceRepository().GetDevice(ctx, nil, &entity.Device{DeviceIdentifier: deviceIdentifier})
+	if apperror.IsNotFound(err) {
+		device, _ = dao.NewDeviceRepository().CreateDevice(ctx, nil, &entity.Device{DeviceIdentifier: deviceIdentifier})
+	}
+	refreshToken, _ := dao.NewRefreshTokenRepository().CreateRefreshToken(ctx, nil, &entity.RefreshToken{UserId: user.ID, DeviceId: device.ID})
+	authorizationToken, _ := dao.NewAuthorizationTokenRepository().CreateAuthorizationToken(ctx, nil, &entity.AuthorizationToken{RefreshTokenId: refreshToken.ID, UserId: user.ID, DeviceId: device.ID})
+	jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{TokenId: authorizationToken.ID}
+	if err := repository.Datasource.NewJwtTokenDatasource().CreateJwtAuthorizationToken(jwtAuthorizationToken); err != nil {
+		t.Fatal(err)
+	}
+	return *jwtAuthorizationToken.Token
+}
+
+// testRequestContext returns the context of a request of the device.
+func testRequestContext(deviceIdentifier string, authorization string) context.Context {
+	md := metadata.Pairs("Access-Token", "token", "Device-Id", deviceIdentifier, "Platform", "Android", "System-Version", "12", "Model", "Pixel 6", "Firebase-Cloud-Messaging-Id", "")
+	if authorization != "" {
+		md.Set("Authorization", "Bearer "+authorization)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestBan(t *testing.T) {
+	setTestKeyring(t)
+	dao := newMemoryRepository()
+	sqldb := newTestSqldb(t)
+	service := NewBanService(dao, sqldb)
+	authenticator := NewAuthenticator(dao, sqldb, map[string]AuthPolicy{"/main.ItemService/ListItem": AuthPolicyApplication})
+	const userMethod, applicationMethod = "/main.UserService/GetUser", "/main.ItemService/ListItem"
+
+	moderator := &entity.User{ID: newTestId(), Email: "moderator@example.com"}
+	user := &entity.User{ID: newTestId(), Email: "user@example.com"}
+	dao.users[*moderator.ID], dao.users[*user.ID] = moderator, user
+	dao.permissions = []entity.UserPermission{{UserId: moderator.ID, Name: "ban_device"}, {UserId: moderator.ID, Name: "ban_user"}}
+	moderatorCtx, err := authenticator.Authenticate(testRequestContext("moderator-device", newTestSession(t, dao, moderator, "moderator-device")), userMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userCtx, err := authenticator.Authenticate(testRequestContext("device", newTestSession(t, dao, user, "device")), userMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the moderators ban, and not themselves.
+	if _, err := service.BanUser(userCtx, &pb.BanUserRequest{UserId: moderator.ID.String()}, nil); err != apperror.ErrPermissionDenied {
+		t.Fatalf("BanUser() by a user = %v, want %v", err, apperror.ErrPermissionDenied)
+	}
+	if _, err := service.BanUser(moderatorCtx, &pb.BanUserRequest{UserId: moderator.ID.String()}, nil); err == nil {
+		t.Fatal("the moderator banned themselves")
+	}
+
+	// A user ban closes the sessions of the user and rejects the new ones
+	// until it expires or is lifted.
+	expirationTime := time.Now().UTC().Add(time.Hour)
+	ban, err := service.BanUser(moderatorCtx, &pb.BanUserRequest{UserId: user.ID.String(), Reason: "spam", ExpirationTime: timestamppb.New(expirationTime)}, nil)
+	if err != nil {
+		t.Fatalf("BanUser() = %v", err)
+	}
+	if ban.UserId != user.ID.String() || ban.ModeratorId != moderator.ID.String() || !ban.ExpirationTime.AsTime().Equal(expirationTime) {
+		t.Fatalf("BanUser() = %v", ban)
+	}
+	sessions, _ := dao.NewAuthorizationTokenRepository().ListAuthorizationToken(context.Background(), nil, &entity.AuthorizationToken{UserId: user.ID})
+	if len(*sessions) != 0 {
+		t.Fatalf("%d sessions of the banned user survived", len(*sessions))
+	}
+	authorization := newTestSession(t, dao, user, "device")
+	_, err = authenticator.Authenticate(testRequestContext("device", authorization), userMethod)
+	if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.PermissionDenied || appErr.Message != "user banned" || appErr.Metadata["expiration_time"] != expirationTime.Format(time.RFC3339) {
+		t.Fatalf("Authenticate() of a banned user = %v, want user banned until %v", err, expirationTime)
+	}
+	if _, err := service.UnbanUser(moderatorCtx, &pb.UnbanUserRequest{UserId: user.ID.String()}, nil); err != nil {
+		t.Fatalf("UnbanUser() = %v", err)
+	}
+	if _, err := authenticator.Authenticate(testRequestContext("device", authorization), userMethod); err != nil {
+		t.Fatalf("Authenticate() of an unbanned user = %v", err)
+	}
+	if _, err := service.UnbanUser(moderatorCtx, &pb.UnbanUserRequest{UserId: user.ID.String()}, nil); err == nil || err.(*apperror.Error).Code != codes.NotFound {
+		t.Fatalf("UnbanUser() of a user that isn't banned = %v, want not found", err)
+	}
+
+	// A permanent device ban rejects every request of the device, even those
+	// without a user.
+	if _, err := service.BanDevice(moderatorCtx, &pb.BanDeviceRequest{DeviceIdentifier: "device", Reason: "fraud"}, nil); err != nil {
+		t.Fatalf("BanDevice() = %v", err)
+	}
+	if len(dao.authorizationTokens) != 1 {
+		t.Fatalf("got %d sessions, want the one of the moderator", len(dao.authorizationTokens))
+	}
+	_, err = authenticator.Authenticate(testRequestContext("device", ""), applicationMethod)
+	if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.PermissionDenied || appErr.Message != "device banned" || appErr.Metadata["expiration_time"] != "" {
+		t.Fatalf("Authenticate() of a banned device = %v, want device banned for good", err)
+	}
+	if _, err := authenticator.Authenticate(testRequestContext("other-device", ""), applicationMethod); err != nil {
+		t.Fatalf("Authenticate() of another device = %v", err)
+	}
+	if _, err := service.UnbanDevice(moderatorCtx, &pb.UnbanDeviceRequest{DeviceIdentifier: "device"}, nil); err != nil {
+		t.Fatalf("UnbanDevice() = %v", err)
+	}
+	if _, err := authenticator.Authenticate(testRequestContext("device", ""), applicationMethod); err != nil {
+		t.Fatalf("Authenticate() of an unbanned device = %v", err)
+	}
+}
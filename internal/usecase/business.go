@@ -10,7 +10,9 @@ import (
 	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/notification"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
 	"github.com/google/uuid"
@@ -37,6 +39,11 @@ type BusinessService interface {
 	UpdateBusinessRole(ctx context.Context, req *pb.UpdateBusinessRoleRequest, md *utils.ClientMetadata) (*pb.BusinessRole, error)
 	DeleteBusinessRole(ctx context.Context, req *pb.DeleteBusinessRoleRequest, md *utils.ClientMetadata) (*gp.Empty, error)
 	ModifyBusinessRolePermission(ctx context.Context, req *pb.ModifyBusinessRolePermissionRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	SearchBusiness(ctx context.Context, req *pb.SearchBusinessRequest, md *utils.ClientMetadata) (*pb.SearchBusinessResponse, error)
+	ListBusinessInBounds(ctx context.Context, req *pb.ListBusinessInBoundsRequest, md *utils.ClientMetadata) (*pb.ListBusinessInBoundsResponse, error)
+	UpdateBusinessDeliveryZone(ctx context.Context, req *pb.UpdateBusinessDeliveryZoneRequest, md *utils.ClientMetadata) (*pb.BusinessDeliveryZone, error)
+	GetBusinessDeliveryZone(ctx context.Context, req *pb.GetBusinessDeliveryZoneRequest, md *utils.ClientMetadata) (*pb.BusinessDeliveryZone, error)
+	ImportBusinessDeliveryZone(ctx context.Context, businessId uuid.UUID, zone geom.T) (*pb.BusinessDeliveryZone, error)
 }
 
 type businessService struct {
@@ -52,37 +59,17 @@ func NewBusinessService(dao repository.Repository, config *config.Config, stDb *
 
 func (i *businessService) BusinessIsInRange(ctx context.Context, req *pb.BusinessIsInRangeRequest, meta *utils.ClientMetadata) error {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *meta.AccessToken)
+		_, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: meta.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		businessId := uuid.MustParse(req.BusinessId)
 		businessIsInRange, err := i.dao.NewBusinessRepository().BusinessIsInRange(tx, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Coordinates.Latitude, req.Coordinates.Longitude}).SetSRID(4326)}, &businessId)
 		if err != nil {
 			return err
 		}
 		if !*businessIsInRange {
-			return errors.New("business is not in range")
+			return apperror.FailedPrecondition("business is not in range")
 		}
 		return nil
 	})
@@ -95,38 +82,18 @@ func (i *businessService) BusinessIsInRange(ctx context.Context, req *pb.Busines
 func (i *businessService) ModifyBusinessRolePermission(ctx context.Context, req *pb.ModifyBusinessRolePermissionRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	var res gp.Empty
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		businessRoleId := uuid.MustParse(req.BusinessRoleId)
 		businessRoleRes, err := i.dao.NewBusinessRoleRepository().GetBusinessRole(ctx, tx, &entity.BusinessRole{ID: &businessRoleId})
 		if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "update_role", BusinessId: businessRoleRes.BusinessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "update_role", BusinessId: businessRoleRes.BusinessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		unionBusinessRoleAndPermission := make([]entity.UnionBusinessRoleAndPermission, 0, len(req.PermissionIds))
 		permissionIds := make([]uuid.UUID, 0, len(req.PermissionIds))
@@ -163,8 +130,8 @@ func (i *businessService) ModifyBusinessRolePermission(ctx context.Context, req
 			}
 		}
 		_, err = i.dao.NewUnionBusinessRoleAndPermissionRepository().DeleteUnionBusinessRoleAndPermission(tx, &entity.UnionBusinessRoleAndPermission{BusinessRoleId: &businessRoleId}, nil)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business role not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business role not found")
 		} else if err != nil {
 			return err
 		}
@@ -191,40 +158,20 @@ func (i *businessService) ModifyBusinessRolePermission(ctx context.Context, req
 func (i *businessService) UpdateBusinessRole(ctx context.Context, req *pb.UpdateBusinessRoleRequest, md *utils.ClientMetadata) (*pb.BusinessRole, error) {
 	var res pb.BusinessRole
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Id)
 		businessRolesRes, err := i.dao.NewBusinessRoleRepository().UpdateBusinessRole(ctx, tx, &entity.BusinessRole{ID: &id}, &entity.BusinessRole{Name: req.BusinessRole.Name})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business role not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business role not found")
 		} else if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "update_role", BusinessId: businessRolesRes.BusinessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "update_role", BusinessId: businessRolesRes.BusinessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		res = pb.BusinessRole{
 			Id:         businessRolesRes.ID.String(),
@@ -243,40 +190,20 @@ func (i *businessService) UpdateBusinessRole(ctx context.Context, req *pb.Update
 
 func (i *businessService) DeleteBusinessRole(ctx context.Context, req *pb.DeleteBusinessRoleRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		id := uuid.MustParse(req.Id)
 		businessRolesRes, err := i.dao.NewBusinessRoleRepository().DeleteBusinessRole(ctx, tx, &entity.BusinessRole{ID: &id}, nil)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business role not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business role not found")
 		} else if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "delete_role", BusinessId: (*businessRolesRes)[0].BusinessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "delete_role", BusinessId: (*businessRolesRes)[0].BusinessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		unionBusinessRoleAndPermRes, err := i.dao.NewUnionBusinessRoleAndPermissionRepository().DeleteUnionBusinessRoleAndPermission(tx, &entity.UnionBusinessRoleAndPermission{BusinessRoleId: (*businessRolesRes)[0].ID}, nil)
 		if err != nil {
@@ -301,34 +228,14 @@ func (i *businessService) DeleteBusinessRole(ctx context.Context, req *pb.Delete
 func (i *businessService) CreateBusinessRole(ctx context.Context, req *pb.CreateBusinessRoleRequest, md *utils.ClientMetadata) (*pb.BusinessRole, error) {
 	var res pb.BusinessRole
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		businessId := uuid.MustParse(req.BusinessRole.BusinessId)
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "create_role", BusinessId: &businessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "create_role", BusinessId: &businessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		businessRolesRes, err := i.dao.NewBusinessRoleRepository().CreateBusinessRole(ctx, tx, &entity.BusinessRole{Name: req.BusinessRole.Name, BusinessId: &businessId})
 		if err != nil {
@@ -370,34 +277,14 @@ func (i *businessService) ListBusinessRole(ctx context.Context, req *pb.ListBusi
 		nextPage = req.NextPage.AsTime()
 	}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		authorizationTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if authorizationTokenParseErr != nil {
-			switch authorizationTokenParseErr.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return authorizationTokenParseErr
-			}
-		}
-		authorizationTokenRes, authorizationTokenErr := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if authorizationTokenErr != nil && authorizationTokenErr.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if authorizationTokenErr != nil {
-			return authorizationTokenErr
-		}
 		businessId := uuid.MustParse(req.BusinessId)
-		_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "read_role", BusinessId: &businessId})
-		if permissionErr != nil && permissionErr.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "read_role", BusinessId: &businessId})
+		if apperror.IsNotFound(permissionErr) {
+			return apperror.ErrPermissionDenied
 		}
 		businessRolesRes, err := i.dao.NewBusinessRoleRepository().ListBusinessRole(ctx, tx, &entity.BusinessRole{}, &nextPage)
 		if err != nil {
@@ -432,41 +319,21 @@ func (i *businessService) ListBusinessRole(ctx context.Context, req *pb.ListBusi
 func (i *businessService) UpdatePartnerApplication(ctx context.Context, req *pb.UpdatePartnerApplicationRequest, md *utils.ClientMetadata) (*pb.PartnerApplication, error) {
 	var res pb.PartnerApplication
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Id)
 		getPartnerAppRes, err := i.dao.NewPartnerApplicationRepository().GetPartnerApplication(tx, &entity.PartnerApplication{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("partner application not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("partner application not found")
 		} else if err != nil {
 			return err
 		}
 		if req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusApproved || req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusRejected {
-			_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "update_partner_application"})
-			if permissionErr != nil && permissionErr.Error() == "record not found" {
-				return errors.New("permission denied")
+			_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "update_partner_application"})
+			if apperror.IsNotFound(permissionErr) {
+				return apperror.ErrPermissionDenied
 			}
 			if req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusApproved {
 				userId := uuid.MustParse(req.PartnerApplication.UserId)
@@ -476,14 +343,20 @@ func (i *businessService) UpdatePartnerApplication(ctx context.Context, req *pb.
 				}
 			}
 		} else if req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusCanceled {
-			if *getPartnerAppRes.UserId != *authorizationTokenRes.UserId {
-				return errors.New("permission denied")
+			if *getPartnerAppRes.UserId != *principal.AuthorizationToken.UserId {
+				return apperror.ErrPermissionDenied
 			}
 		}
 		updatePartnerAppRes, err := i.dao.NewPartnerApplicationRepository().UpdatePartnerApplication(tx, &entity.PartnerApplication{ID: &id}, &entity.PartnerApplication{Status: req.PartnerApplication.Status.String()})
 		if err != nil {
 			return err
 		}
+		if req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusApproved || req.PartnerApplication.Status == pb.PartnerApplicationStatus_PartnerApplicationStatusRejected {
+			err = i.notifyPartnerApplicationDecided(ctx, tx, updatePartnerAppRes)
+			if err != nil {
+				return err
+			}
+		}
 		res = pb.PartnerApplication{
 			Id:             updatePartnerAppRes.ID.String(),
 			BusinessName:   updatePartnerAppRes.BusinessName,
@@ -504,6 +377,29 @@ func (i *businessService) UpdatePartnerApplication(ctx context.Context, req *pb.
 	return &res, nil
 }
 
+// notifyPartnerApplicationDecided queues an email telling the applicant whether
+// the application was approved or rejected.
+func (i *businessService) notifyPartnerApplicationDecided(ctx context.Context, tx *gorm.DB, application *entity.PartnerApplication) error {
+	user, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: application.UserId})
+	if err != nil {
+		return err
+	}
+	locale, err := userLocale(ctx, tx, i.dao, application.UserId)
+	if err != nil {
+		return err
+	}
+	return enqueueOutbox(tx, i.dao, entity.OutboxChannelEmail, &notification.Message{
+		Event:  notification.EventPartnerApplicationDecided,
+		Locale: locale,
+		To:     user.Email,
+		Data: map[string]string{
+			"businessName": application.BusinessName,
+			"status":       application.Status,
+			"appName":      i.config.AppName,
+		},
+	})
+}
+
 func (i *businessService) ListPartnerApplication(ctx context.Context, req *pb.ListPartnerApplicationRequest, md *utils.ClientMetadata) (*pb.ListPartnerApplicationResponse, error) {
 	var res pb.ListPartnerApplicationResponse
 	var nextPage time.Time
@@ -513,33 +409,13 @@ func (i *businessService) ListPartnerApplication(ctx context.Context, req *pb.Li
 		nextPage = req.NextPage.AsTime()
 	}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		authorizationTokenParseErr := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if authorizationTokenParseErr != nil {
-			switch authorizationTokenParseErr.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return authorizationTokenParseErr
-			}
-		}
-		authorizationTokenRes, authorizationTokenErr := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if authorizationTokenErr != nil && authorizationTokenErr.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if authorizationTokenErr != nil {
-			return authorizationTokenErr
-		}
-		_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "read_partner_application"})
-		if permissionErr != nil && permissionErr.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, permissionErr := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "read_partner_application"})
+		if apperror.IsNotFound(permissionErr) {
+			return apperror.ErrPermissionDenied
 		}
 		partnerApplicationsRes, partnerApplicationsErr := i.dao.NewPartnerApplicationRepository().ListPartnerApplication(tx, nil, &nextPage)
 		if partnerApplicationsErr != nil {
@@ -579,48 +455,28 @@ func (i *businessService) ListPartnerApplication(ctx context.Context, req *pb.Li
 func (i *businessService) CreatePartnerApplication(ctx context.Context, req *pb.CreatePartnerApplicationRequest, md *utils.ClientMetadata) (*pb.PartnerApplication, error) {
 	var res pb.PartnerApplication
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		businessUserRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: authorizationTokenRes.UserId})
-		if err != nil && err.Error() != "record not found" {
+		businessUserRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: principal.AuthorizationToken.UserId})
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		}
 		if businessUserRes != nil {
-			return errors.New("already register as business user")
+			return apperror.AlreadyExists("already register as business user")
 		}
 		businessRes, err := i.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{Name: req.PartnerApplication.BusinessName})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		}
 		if businessRes != nil {
-			return errors.New("already exists a business with that name")
+			return apperror.AlreadyExists("already exists a business with that name")
 		}
 		municipalityId := uuid.MustParse(req.PartnerApplication.MunicipalityId)
 		provinceId := uuid.MustParse(req.PartnerApplication.ProvinceId)
 		location := ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.PartnerApplication.Coordinates.Latitude, req.PartnerApplication.Coordinates.Longitude}).SetSRID(4326)}
-		createPartnerAppRes, err := i.dao.NewPartnerApplicationRepository().CreatePartnerApplication(tx, &entity.PartnerApplication{BusinessName: req.PartnerApplication.BusinessName, Description: req.PartnerApplication.Description, ProvinceId: &provinceId, MunicipalityId: &municipalityId, UserId: authorizationTokenRes.UserId, Coordinates: location})
+		createPartnerAppRes, err := i.dao.NewPartnerApplicationRepository().CreatePartnerApplication(tx, &entity.PartnerApplication{BusinessName: req.PartnerApplication.BusinessName, Description: req.PartnerApplication.Description, ProvinceId: &provinceId, MunicipalityId: &municipalityId, UserId: principal.AuthorizationToken.UserId, Coordinates: location})
 		if err != nil {
 			return err
 		}
@@ -648,48 +504,28 @@ func (i *businessService) UpdateBusiness(ctx context.Context, req *pb.UpdateBusi
 	var businessRes *entity.Business
 	id := uuid.MustParse(req.Id)
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		businessOwnerRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: authorizationTokenRes.UserId})
+		businessOwnerRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
 		if !businessOwnerRes.IsBusinessOwner {
-			return errors.New("permission denied")
+			return apperror.ErrPermissionDenied
 		}
 		businessIsOpenRes, err := i.dao.NewBusinessScheduleRepository().BusinessIsOpen(tx, &entity.BusinessSchedule{BusinessId: &id})
-		if err != nil && err.Error() != "business closed" {
+		if err != nil && !errors.Is(err, datasource.ErrBusinessClosed) {
 			return err
 		} else if businessIsOpenRes {
-			return errors.New("business is open")
+			return apperror.InvalidArgument("business is open")
 		}
 		getCartItemRes, getCartItemErr := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{BusinessId: &id})
-		if getCartItemErr != nil && getCartItemErr.Error() != "record not found" {
+		if getCartItemErr != nil && !apperror.IsNotFound(getCartItemErr) {
 			return getCartItemErr
 		} else if getCartItemRes != nil {
-			return errors.New("item in the cart")
+			return apperror.InvalidArgument("item in the cart")
 		}
 		getBusinessRes, getBusinessErr := i.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: &id})
 		if getBusinessErr != nil {
@@ -697,20 +533,20 @@ func (i *businessService) UpdateBusiness(ctx context.Context, req *pb.UpdateBusi
 		}
 		if req.HighQualityPhoto != "" || req.LowQualityPhoto != "" || req.Thumbnail != "" {
 			_, hqErr := i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.HighQualityPhoto)
-			if hqErr != nil && hqErr.Error() == "ObjectMissing" {
-				return errors.New("HighQualityPhotoObject missing")
+			if errors.Is(hqErr, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("HighQualityPhotoObject missing")
 			} else if hqErr != nil {
 				return hqErr
 			}
 			_, lqErr := i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.LowQualityPhoto)
-			if lqErr != nil && lqErr.Error() == "ObjectMissing" {
-				return errors.New("LowQualityPhotoObject missing")
+			if errors.Is(lqErr, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("LowQualityPhotoObject missing")
 			} else if lqErr != nil {
 				return lqErr
 			}
 			_, tnErr := i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.Thumbnail)
-			if tnErr != nil && tnErr.Error() == "ObjectMissing" {
-				return errors.New("ThumbnailObject missing")
+			if errors.Is(tnErr, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("ThumbnailObject missing")
 			} else if tnErr != nil {
 				return tnErr
 			}
@@ -778,56 +614,36 @@ func (i *businessService) UpdateBusiness(ctx context.Context, req *pb.UpdateBusi
 func (i *businessService) CreateBusiness(ctx context.Context, req *pb.CreateBusinessRequest, md *utils.ClientMetadata) (*pb.CreateBusinessResponse, error) {
 	var res pb.CreateBusinessResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authToken, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authToken.UserId, Name: "create_business"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "create_business"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
-		businessOwnerRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: authToken.UserId})
+		businessOwnerRes, err := i.dao.NewBusinessUserRepository().GetBusinessUser(ctx, tx, &entity.BusinessUser{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
 		if !businessOwnerRes.IsBusinessOwner {
-			return errors.New("permission denied")
+			return apperror.ErrPermissionDenied
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.HighQualityPhoto)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("HighQualityPhotoObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("HighQualityPhotoObject missing")
 		} else if err != nil {
 			return err
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.LowQualityPhoto)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("LowQualityPhotoObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("LowQualityPhotoObject missing")
 		} else if err != nil {
 			return err
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.BusinessAvatarBulkName, req.Thumbnail)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("ThumbnailObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("ThumbnailObject missing")
 		} else if err != nil {
 			return err
 		}
@@ -887,97 +703,42 @@ func (i *businessService) CreateBusiness(ctx context.Context, req *pb.CreateBusi
 }
 
 func (v *businessService) Feed(ctx context.Context, req *pb.FeedRequest, meta *utils.ClientMetadata) (*pb.FeedResponse, error) {
-	var businessRes *[]entity.Business
-	var businessResAdd *[]entity.Business
 	var res pb.FeedResponse
 	var businessCategories []*pb.BusinessCategory
 	provinceId := uuid.MustParse(req.ProvinceId)
-	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *meta.AccessToken)
+	feed := datasource.BusinessFeed{Order: businessFeedOrders[req.Order], Location: ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}, ProvinceId: &provinceId, HomeDelivery: req.HomeDelivery, ToPickUp: req.ToPickUp, OrderStatuses: feedOrderStatuses, Limit: feedPageSize + 1}
+	pageToken, err := decodeFeedPageToken(req.PageToken, req.Order)
+	if err != nil {
+		return nil, err
+	}
+	if pageToken != nil {
+		feed.Time = time.Unix(pageToken.Time, 0).UTC()
+		feed.Cursor = &pageToken.FeedCursor
+	} else {
+		feed.Time = time.Now().UTC().Truncate(time.Second)
+	}
+	if req.MunicipalityId != "" {
+		municipalityId := uuid.MustParse(req.MunicipalityId)
+		feed.MunicipalityId = &municipalityId
+	}
+	if req.BusinessCategoryId != "" {
+		businessCategoryId := uuid.MustParse(req.BusinessCategoryId)
+		feed.BusinessCategoryId = &businessCategoryId
+	}
+	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		businessRes, err := v.dao.NewBusinessRepository().Feed(tx, &feed)
 		if err != nil {
 			return err
 		}
-		if req.SearchMunicipalityType == pb.SearchMunicipalityType_More {
-			businessRes, err = v.dao.NewBusinessRepository().Feed(tx, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}, 5, req.ProvinceId, req.MunicipalityId, req.NextPage, false, req.HomeDelivery, req.ToPickUp)
-			if err != nil {
-				return err
-			}
-			if businessRes == nil {
-				res.NextPage = int32(req.NextPage)
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
-			} else if len(*businessRes) > 5 {
-				*businessRes = (*businessRes)[:len(*businessRes)-1]
-				res.NextPage = int32((*businessRes)[len(*businessRes)-1].Cursor)
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_More
-			} else if len(*businessRes) <= 5 && len(*businessRes) != 0 {
-				length := 5 - len(*businessRes)
-				businessResAdd, err = v.dao.NewBusinessRepository().Feed(tx, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}, int32(length), req.ProvinceId, req.MunicipalityId, 0, true, req.HomeDelivery, req.ToPickUp)
-				if err != nil {
-					return err
-				}
-				if businessResAdd != nil {
-					if len(*businessResAdd) > length {
-						*businessResAdd = (*businessResAdd)[:len(*businessResAdd)-1]
-					}
-					*businessRes = append(*businessRes, *businessResAdd...)
-				}
-				res.NextPage = int32((*businessRes)[len(*businessRes)-1].Cursor)
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
-			} else if len(*businessRes) == 0 {
-				businessRes, err = v.dao.NewBusinessRepository().Feed(tx, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}, 5, req.ProvinceId, req.MunicipalityId, 0, true, req.HomeDelivery, req.ToPickUp)
-				if err != nil {
-					return err
-				}
-				if len(*businessRes) > 5 {
-					*businessRes = (*businessRes)[:len(*businessRes)-1]
-					res.NextPage = int32((*businessRes)[len(*businessRes)-1].Cursor)
-					res.SearchMunicipalityType = pb.SearchMunicipalityType_More
-				}
-			}
-		} else {
-			businessRes, err = v.dao.NewBusinessRepository().Feed(tx, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}, 5, req.ProvinceId, req.MunicipalityId, req.NextPage, true, req.HomeDelivery, req.ToPickUp)
-			if err != nil {
-				return err
-			}
-			if businessRes != nil && len(*businessRes) > 5 {
-				*businessRes = (*businessRes)[:len(*businessRes)-1]
-				res.NextPage = int32((*businessRes)[len(*businessRes)-1].Cursor)
-			} else if businessRes != nil && len(*businessRes) <= 5 && len(*businessRes) != 0 {
-				res.NextPage = int32((*businessRes)[len(*businessRes)-1].Cursor)
-			} else {
-				res.NextPage = req.NextPage
-			}
-			res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
+		if len(*businessRes) > feedPageSize {
+			*businessRes = (*businessRes)[:feedPageSize]
+			res.NextPageToken = encodeFeedPageToken(req.Order, feed.Time, (*businessRes)[feedPageSize-1].Cursor())
 		}
-		if businessRes != nil {
-			businessResponse := make([]*pb.Business, 0, len(*businessRes))
-			for _, e := range *businessRes {
-				businessResponse = append(businessResponse, &pb.Business{
-					Id:                    e.ID.String(),
-					Name:                  e.Name,
-					HighQualityPhoto:      e.HighQualityPhoto,
-					HighQualityPhotoUrl:   v.config.BusinessAvatarBulkName + "/" + e.HighQualityPhoto,
-					LowQualityPhoto:       e.LowQualityPhoto,
-					LowQualityPhotoUrl:    v.config.BusinessAvatarBulkName + "/" + e.LowQualityPhoto,
-					Thumbnail:             e.Thumbnail,
-					ThumbnailUrl:          v.config.BusinessAvatarBulkName + "/" + e.Thumbnail,
-					BlurHash:              e.BlurHash,
-					Address:               e.Address,
-					DeliveryPriceCup:      e.DeliveryPriceCup,
-					TimeMarginOrderMonth:  e.TimeMarginOrderMonth,
-					TimeMarginOrderDay:    e.TimeMarginOrderDay,
-					TimeMarginOrderHour:   e.TimeMarginOrderHour,
-					TimeMarginOrderMinute: e.TimeMarginOrderMinute,
-					ToPickUp:              e.ToPickUp,
-					HomeDelivery:          e.HomeDelivery,
-					BusinessBrandId:       e.BusinessBrandId.String(),
-					ProvinceId:            e.ProvinceId.String(),
-					MunicipalityId:        e.MunicipalityId.String(),
-					OpenFlag:              e.OpenFlag,
-					Cursor:                int32(e.Cursor),
-				})
-			}
-			res.Businesses = businessResponse
+		res.Businesses = make([]*pb.Business, 0, len(*businessRes))
+		for index := range *businessRes {
+			business := v.businessSummaryResponse(&(*businessRes)[index].Business)
+			business.Distance = (*businessRes)[index].Distance
+			res.Businesses = append(res.Businesses, business)
 		}
 		if req.Categories {
 			businessCategoriesRes, err := v.dao.NewBusinessCategoryRepository().ListBusinessCategory(tx, &entity.BusinessCategory{ProvinceId: &provinceId})
@@ -1010,14 +771,10 @@ func (v *businessService) GetBusiness(ctx context.Context, req *pb.GetBusinessRe
 	var itemsCategoryResponse []*pb.BusinessCollection
 	var schedule *entity.BusinessSchedule
 	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *meta.AccessToken)
-		if err != nil {
-			return err
-		}
 		businessId := uuid.MustParse(req.Id)
-		businessRes, err = v.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: &businessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business not found")
+		businessRes, err := v.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: &businessId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business not found")
 		} else if err != nil {
 			return err
 		}
@@ -1050,37 +807,7 @@ func (v *businessService) GetBusiness(ctx context.Context, req *pb.GetBusinessRe
 	lowQualityPhotoUrl = v.config.BusinessAvatarBulkName + "/" + businessRes.LowQualityPhoto
 	thumbnailUrl = v.config.BusinessAvatarBulkName + "/" + businessRes.Thumbnail
 	nowTime := time.Now().UTC()
-	return &pb.Business{Id: businessRes.ID.String(), OpenFlag: businessRes.OpenFlag, Name: businessRes.Name, Address: businessRes.Address, HighQualityPhoto: businessRes.HighQualityPhoto, LowQualityPhoto: businessRes.LowQualityPhoto, Thumbnail: businessRes.Thumbnail, BlurHash: businessRes.BlurHash, ToPickUp: businessRes.ToPickUp, DeliveryPriceCup: businessRes.DeliveryPriceCup, HomeDelivery: businessRes.HomeDelivery, ProvinceId: businessRes.ProvinceId.String(), MunicipalityId: businessRes.MunicipalityId.String(), BusinessBrandId: businessRes.BusinessBrandId.String(), Coordinates: &pb.Point{Latitude: businessRes.Coordinates.Coords()[1], Longitude: businessRes.Coordinates.Coords()[0]}, HighQualityPhotoUrl: highQualityPhotoUrl, LowQualityPhotoUrl: lowQualityPhotoUrl, ThumbnailUrl: thumbnailUrl, BusinessCollections: itemsCategoryResponse, NowTime: timestamppb.New(nowTime), BusinessSchedule: &pb.BusinessSchedule{
-		Id:                         schedule.ID.String(),
-		FirstOpeningTimeSunday:     timestamppb.New(schedule.FirstOpeningTimeSunday),
-		FirstClosingTimeSunday:     timestamppb.New(schedule.FirstClosingTimeSunday),
-		FirstOpeningTimeMonday:     timestamppb.New(schedule.FirstOpeningTimeMonday),
-		FirstClosingTimeMonday:     timestamppb.New(schedule.FirstClosingTimeMonday),
-		FirstOpeningTimeTuesday:    timestamppb.New(schedule.FirstOpeningTimeTuesday),
-		FirstClosingTimeTuesday:    timestamppb.New(schedule.FirstClosingTimeTuesday),
-		FirstOpeningTimeWednesday:  timestamppb.New(schedule.FirstOpeningTimeWednesday),
-		FirstClosingTimeWednesday:  timestamppb.New(schedule.FirstClosingTimeWednesday),
-		FirstOpeningTimeThursday:   timestamppb.New(schedule.FirstOpeningTimeThursday),
-		FirstClosingTimeThursday:   timestamppb.New(schedule.FirstClosingTimeThursday),
-		FirstOpeningTimeFriday:     timestamppb.New(schedule.FirstOpeningTimeFriday),
-		FirstClosingTimeFriday:     timestamppb.New(schedule.FirstClosingTimeFriday),
-		FirstOpeningTimeSaturday:   timestamppb.New(schedule.FirstOpeningTimeSaturday),
-		FirstClosingTimeSaturday:   timestamppb.New(schedule.FirstClosingTimeSaturday),
-		SecondOpeningTimeSunday:    timestamppb.New(schedule.SecondOpeningTimeSunday),
-		SecondClosingTimeSunday:    timestamppb.New(schedule.SecondClosingTimeSunday),
-		SecondOpeningTimeMonday:    timestamppb.New(schedule.SecondOpeningTimeMonday),
-		SecondClosingTimeMonday:    timestamppb.New(schedule.SecondClosingTimeMonday),
-		SecondOpeningTimeTuesday:   timestamppb.New(schedule.SecondOpeningTimeTuesday),
-		SecondClosingTimeTuesday:   timestamppb.New(schedule.SecondClosingTimeTuesday),
-		SecondOpeningTimeWednesday: timestamppb.New(schedule.SecondOpeningTimeWednesday),
-		SecondClosingTimeWednesday: timestamppb.New(schedule.SecondClosingTimeWednesday),
-		SecondOpeningTimeThursday:  timestamppb.New(schedule.SecondOpeningTimeThursday),
-		SecondClosingTimeThursday:  timestamppb.New(schedule.SecondClosingTimeThursday),
-		SecondOpeningTimeFriday:    timestamppb.New(schedule.SecondOpeningTimeFriday),
-		SecondClosingTimeFriday:    timestamppb.New(schedule.SecondClosingTimeFriday),
-		SecondOpeningTimeSaturday:  timestamppb.New(schedule.SecondOpeningTimeSaturday),
-		SecondClosingTimeSaturday:  timestamppb.New(schedule.SecondClosingTimeSaturday),
-	}}, nil
+	return &pb.Business{Id: businessRes.ID.String(), OpenFlag: businessRes.OpenFlag, Name: businessRes.Name, Address: businessRes.Address, HighQualityPhoto: businessRes.HighQualityPhoto, LowQualityPhoto: businessRes.LowQualityPhoto, Thumbnail: businessRes.Thumbnail, BlurHash: businessRes.BlurHash, ToPickUp: businessRes.ToPickUp, DeliveryPriceCup: businessRes.DeliveryPriceCup, HomeDelivery: businessRes.HomeDelivery, ProvinceId: businessRes.ProvinceId.String(), MunicipalityId: businessRes.MunicipalityId.String(), BusinessBrandId: businessRes.BusinessBrandId.String(), Coordinates: &pb.Point{Latitude: businessRes.Coordinates.Coords()[1], Longitude: businessRes.Coordinates.Coords()[0]}, HighQualityPhotoUrl: highQualityPhotoUrl, LowQualityPhotoUrl: lowQualityPhotoUrl, ThumbnailUrl: thumbnailUrl, BusinessCollections: itemsCategoryResponse, NowTime: timestamppb.New(nowTime), BusinessSchedule: businessScheduleResponse(schedule, nowTime)}, nil
 }
 
 func (v *businessService) GetBusinessWithDistance(ctx context.Context, req *pb.GetBusinessWithDistanceRequest, md *utils.ClientMetadata) (*pb.Business, error) {
@@ -1089,14 +816,10 @@ func (v *businessService) GetBusinessWithDistance(ctx context.Context, req *pb.G
 	var businessErr, businessCollectionErr error
 	var businessCollectionResponse []*pb.BusinessCollection
 	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := v.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
 		businessId := uuid.MustParse(req.Id)
 		businessRes, businessErr = v.dao.NewBusinessRepository().GetBusinessWithDistance(tx, &entity.Business{ID: &businessId}, ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)})
-		if businessErr != nil && businessErr.Error() == "record not found" {
-			return errors.New("business not found")
+		if apperror.IsNotFound(businessErr) {
+			return apperror.NotFound("business not found")
 		} else if businessErr != nil {
 			return businessErr
 		}
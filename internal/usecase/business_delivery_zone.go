@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// maxDeliveryZonePoints bounds the size of a delivery zone, every order
+// checks its address against it.
+const maxDeliveryZonePoints = 10000
+
+var errInvalidDeliveryZoneType = apperror.InvalidArgument("the delivery zone must be a Polygon or a MultiPolygon")
+
+// parseDeliveryZone decodes a GeoJSON Polygon or MultiPolygon and checks it
+// with checkDeliveryZone.
+func parseDeliveryZone(data string) (*geom.MultiPolygon, error) {
+	var zone geom.T
+	err := geojson.Unmarshal([]byte(data), &zone)
+	if err != nil {
+		return nil, apperror.InvalidArgument("invalid geoJson").WithMetadata("error", err.Error())
+	}
+	return checkDeliveryZone(zone)
+}
+
+// checkDeliveryZone returns the zone as a MultiPolygon after checking that its
+// coordinates are longitudes and latitudes and that its rings are closed. The
+// self-intersections are checked by the database.
+func checkDeliveryZone(zone geom.T) (*geom.MultiPolygon, error) {
+	var res *geom.MultiPolygon
+	switch zone := zone.(type) {
+	case *geom.Polygon:
+		res = geom.NewMultiPolygon(zone.Layout())
+		if err := res.Push(zone); err != nil {
+			return nil, errInvalidDeliveryZoneType
+		}
+	case *geom.MultiPolygon:
+		res = zone
+	default:
+		return nil, errInvalidDeliveryZoneType
+	}
+	if res.Layout() != geom.XY {
+		return nil, apperror.InvalidArgument("the delivery zone coordinates must be a longitude and a latitude")
+	}
+	if res.NumPolygons() == 0 {
+		return nil, apperror.InvalidArgument("the delivery zone is empty")
+	}
+	if res.NumCoords() > maxDeliveryZonePoints {
+		return nil, apperror.InvalidArgument(fmt.Sprintf("the delivery zone has more than %d points", maxDeliveryZonePoints))
+	}
+	for polygonIndex := 0; polygonIndex < res.NumPolygons(); polygonIndex++ {
+		polygon := res.Polygon(polygonIndex)
+		if polygon.NumLinearRings() == 0 {
+			return nil, apperror.InvalidArgument(fmt.Sprintf("the polygon %d of the delivery zone is empty", polygonIndex))
+		}
+		for ringIndex := 0; ringIndex < polygon.NumLinearRings(); ringIndex++ {
+			ring := polygon.LinearRing(ringIndex)
+			if ring.NumCoords() < 4 {
+				return nil, apperror.InvalidArgument(fmt.Sprintf("the ring %d of the polygon %d of the delivery zone has less than 4 points", ringIndex, polygonIndex))
+			}
+			if !ring.Coord(0).Equal(geom.XY, ring.Coord(ring.NumCoords()-1)) {
+				return nil, apperror.InvalidArgument(fmt.Sprintf("the ring %d of the polygon %d of the delivery zone isn't closed", ringIndex, polygonIndex))
+			}
+			for coordIndex := 0; coordIndex < ring.NumCoords(); coordIndex++ {
+				longitude, latitude := ring.Coord(coordIndex).X(), ring.Coord(coordIndex).Y()
+				if math.IsNaN(longitude) || longitude < -180 || longitude > 180 || math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+					return nil, apperror.InvalidArgument(fmt.Sprintf("the point %d of the ring %d of the polygon %d of the delivery zone is out of range", coordIndex, ringIndex, polygonIndex))
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// setBusinessDeliveryZone stores the zone once the database finds it valid and
+// covered by the municipalities the business serves.
+func (i *businessService) setBusinessDeliveryZone(tx *gorm.DB, businessId *uuid.UUID, zone *geom.MultiPolygon) (*pb.BusinessDeliveryZone, error) {
+	data, err := geojson.Marshal(zone)
+	if err != nil {
+		return nil, err
+	}
+	checkRes, err := i.dao.NewBusinessRepository().CheckBusinessDeliveryZone(tx, businessId, string(data))
+	if err != nil {
+		return nil, err
+	}
+	if !checkRes.Valid {
+		return nil, apperror.InvalidArgument("the delivery zone isn't valid: " + checkRes.Reason)
+	}
+	if !checkRes.Covered {
+		return nil, apperror.InvalidArgument("the delivery zone goes beyond the municipalities the business serves")
+	}
+	zoneRes, err := i.dao.NewBusinessRepository().UpdateBusinessDeliveryZone(tx, businessId, string(data))
+	if err != nil {
+		return nil, err
+	}
+	return businessDeliveryZoneResponse(zoneRes), nil
+}
+
+func businessDeliveryZoneResponse(e *datasource.BusinessDeliveryZone) *pb.BusinessDeliveryZone {
+	return &pb.BusinessDeliveryZone{BusinessId: e.BusinessId.String(), GeoJson: e.GeoJson, UpdateTime: timestamppb.New(e.UpdateTime)}
+}
+
+func (i *businessService) UpdateBusinessDeliveryZone(ctx context.Context, req *pb.UpdateBusinessDeliveryZoneRequest, md *utils.ClientMetadata) (*pb.BusinessDeliveryZone, error) {
+	var res *pb.BusinessDeliveryZone
+	businessId := uuid.MustParse(req.BusinessId)
+	zone, err := parseDeliveryZone(req.GeoJson)
+	if err != nil {
+		return nil, err
+	}
+	err = i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		principal, err := principalFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "update_business", BusinessId: &businessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
+		} else if err != nil {
+			return err
+		}
+		res, err = i.setBusinessDeliveryZone(tx, &businessId, zone)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (i *businessService) GetBusinessDeliveryZone(ctx context.Context, req *pb.GetBusinessDeliveryZoneRequest, md *utils.ClientMetadata) (*pb.BusinessDeliveryZone, error) {
+	var res *pb.BusinessDeliveryZone
+	businessId := uuid.MustParse(req.BusinessId)
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		zoneRes, err := i.dao.NewBusinessRepository().GetBusinessDeliveryZone(tx, &businessId)
+		if err != nil {
+			return err
+		}
+		res = businessDeliveryZoneResponse(zoneRes)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ImportBusinessDeliveryZone stores the zone of the business without the
+// permission check of UpdateBusinessDeliveryZone, for the command line.
+func (i *businessService) ImportBusinessDeliveryZone(ctx context.Context, businessId uuid.UUID, zone geom.T) (*pb.BusinessDeliveryZone, error) {
+	var res *pb.BusinessDeliveryZone
+	multiPolygon, err := checkDeliveryZone(zone)
+	if err != nil {
+		return nil, err
+	}
+	err = i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		res, err = i.setBusinessDeliveryZone(tx, &businessId, multiPolygon)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import "testing"
+
+func TestParseDeliveryZone(t *testing.T) {
+	tests := []struct {
+		name     string
+		geoJson  string
+		polygons int
+		valid    bool
+	}{
+		{"polygon", `{"type":"Polygon","coordinates":[[[-82.4,23.1],[-82.3,23.1],[-82.3,23.2],[-82.4,23.1]]]}`, 1, true},
+		{"multipolygon", `{"type":"MultiPolygon","coordinates":[[[[-82.4,23.1],[-82.3,23.1],[-82.3,23.2],[-82.4,23.1]]],[[[-82.2,23.1],[-82.1,23.1],[-82.1,23.2],[-82.2,23.1]]]]}`, 2, true},
+		{"open ring", `{"type":"Polygon","coordinates":[[[-82.4,23.1],[-82.3,23.1],[-82.3,23.2],[-82.4,23.2]]]}`, 0, false},
+		{"short ring", `{"type":"Polygon","coordinates":[[[-82.4,23.1],[-82.3,23.1],[-82.4,23.1]]]}`, 0, false},
+		{"latitude first", `{"type":"Polygon","coordinates":[[[23.1,-182.4],[23.1,-82.3],[23.2,-82.3],[23.1,-182.4]]]}`, 0, false},
+		{"three dimensions", `{"type":"Polygon","coordinates":[[[-82.4,23.1,0],[-82.3,23.1,0],[-82.3,23.2,0],[-82.4,23.1,0]]]}`, 0, false},
+		{"point", `{"type":"Point","coordinates":[-82.4,23.1]}`, 0, false},
+		{"not geojson", `POLYGON((-82.4 23.1, -82.3 23.1, -82.3 23.2, -82.4 23.1))`, 0, false},
+	}
+	for _, test := range tests {
+		zone, err := parseDeliveryZone(test.geoJson)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: parseDeliveryZone() error = %v, want valid %v", test.name, err, test.valid)
+			continue
+		}
+		if err == nil && zone.NumPolygons() != test.polygons {
+			t.Errorf("%s: %d polygons, want %d", test.name, zone.NumPolygons(), test.polygons)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+)
+
+// feedPageSize is the number of businesses of a feed page.
+const feedPageSize = 5
+
+// businessFeedOrders maps the orders of the requests to the feed orders, the
+// unspecified order is the nearest first.
+var businessFeedOrders = map[pb.FeedOrder]datasource.BusinessFeedOrder{
+	pb.FeedOrder_FeedOrderUnspecified: datasource.BusinessFeedNearest,
+	pb.FeedOrder_FeedOrderNearest:     datasource.BusinessFeedNearest,
+	pb.FeedOrder_FeedOrderOpenNow:     datasource.BusinessFeedOpenNow,
+	pb.FeedOrder_FeedOrderPopularity:  datasource.BusinessFeedPopularity,
+}
+
+// feedOrderStatuses are the statuses of the orders the popularity counts, the
+// orders that weren't paid or didn't go through aren't.
+var feedOrderStatuses = []string{
+	pb.OrderStatusType_OrderStatusTypeOrdered.String(),
+	pb.OrderStatusType_OrderStatusTypeAccepted.String(),
+	pb.OrderStatusType_OrderStatusTypeReady.String(),
+	pb.OrderStatusType_OrderStatusTypeAssignedMessenger.String(),
+	pb.OrderStatusType_OrderStatusTypeDelivered.String(),
+}
+
+// feedPageToken is the opaque cursor of the next page of the feed. It keeps
+// the order and the time of the first page, the ranks of the next pages are
+// computed at that time.
+type feedPageToken struct {
+	Order pb.FeedOrder `json:"o"`
+	Time  int64        `json:"t"`
+	datasource.FeedCursor
+}
+
+func encodeFeedPageToken(order pb.FeedOrder, t time.Time, cursor datasource.FeedCursor) string {
+	data, _ := json.Marshal(feedPageToken{Order: order, Time: t.Unix(), FeedCursor: cursor})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeFeedPageToken returns the token, nil for the first page.
+func decodeFeedPageToken(token string, order pb.FeedOrder) (*feedPageToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	var pageToken feedPageToken
+	err = json.Unmarshal(data, &pageToken)
+	if err != nil || pageToken.Order != order {
+		return nil, errInvalidPageToken
+	}
+	return &pageToken, nil
+}
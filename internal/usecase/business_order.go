@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// businessOrderActors returns the actors that the permissions of the user grant
+// over the orders of the business.
+func (i *orderService) businessOrderActors(ctx context.Context, tx *gorm.DB, userId *uuid.UUID, businessId *uuid.UUID) ([]OrderActor, error) {
+	actors := make([]OrderActor, 0, 2)
+	_, err := i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: updateOrderPermission, UserId: userId, BusinessId: businessId})
+	if err == nil {
+		actors = append(actors, OrderActorBusiness)
+	} else if !apperror.IsNotFound(err) {
+		return nil, err
+	}
+	_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: deliverOrderPermission, UserId: userId, BusinessId: businessId})
+	if err == nil {
+		actors = append(actors, OrderActorMessenger)
+	} else if !apperror.IsNotFound(err) {
+		return nil, err
+	}
+	return actors, nil
+}
+
+// authorizeBusinessOrder returns the actors of the caller over the orders of
+// the business, or "permission denied" when the caller doesn't manage them.
+func (i *orderService) authorizeBusinessOrder(ctx context.Context, tx *gorm.DB, businessId *uuid.UUID) ([]OrderActor, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actors, err := i.businessOrderActors(ctx, tx, principal.AuthorizationToken.UserId, businessId)
+	if err != nil {
+		return nil, err
+	}
+	if len(actors) == 0 {
+		return nil, apperror.ErrPermissionDenied
+	}
+	return actors, nil
+}
+
+// getBusinessOrder returns the order only if it belongs to the business.
+func (i *orderService) getBusinessOrder(tx *gorm.DB, id *uuid.UUID, businessId *uuid.UUID) (*entity.Order, error) {
+	order, err := i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: id})
+	if apperror.IsNotFound(err) {
+		return nil, apperror.NotFound("order not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if *order.BusinessId != *businessId {
+		return nil, apperror.NotFound("order not found")
+	}
+	return order, nil
+}
+
+func (i *orderService) businessOrderResponse(order *entity.Order) *pb.Order {
+	var coordinates *pb.Point
+	if order.Coordinates.Point != nil {
+		coordinates = &pb.Point{Latitude: order.Coordinates.Coords()[1], Longitude: order.Coordinates.Coords()[0]}
+	}
+	return &pb.Order{
+		Id:                order.ID.String(),
+		ShortId:           order.ShortId,
+		CancelReasons:     order.CancelReasons,
+		BusinessName:      order.BusinessName,
+		ItemsQuantity:     order.ItemsQuantity,
+		PriceCup:          order.PriceCup,
+		Number:            order.Number,
+		Address:           order.Address,
+		Instructions:      order.Instructions,
+		UserId:            order.UserId.String(),
+		StartOrderTime:    timestamppb.New(order.StartOrderTime),
+		EndOrderTime:      timestamppb.New(order.EndOrderTime),
+		Status:            *utils.ParseOrderStatusType(&order.Status),
+		OrderType:         *utils.ParseOrderType(&order.OrderType),
+		Coordinates:       coordinates,
+		BusinessId:        order.BusinessId.String(),
+		BusinessThumbnail: i.config.BusinessAvatarBulkName + "/" + order.BusinessThumbnail,
+		DeliveryPriceCup:  order.DeliveryPriceCup,
+		CreateTime:        timestamppb.New(order.CreateTime),
+		UpdateTime:        timestamppb.New(order.UpdateTime),
+	}
+}
+
+// businessOrderPageSize is the number of orders of a page of the orders of a
+// business.
+const businessOrderPageSize = 10
+
+// businessOrderPageToken is the opaque cursor of the next page of the orders
+// of a business, a token is only valid for the business that returned it.
+type businessOrderPageToken struct {
+	BusinessId uuid.UUID `json:"b"`
+	datasource.BusinessOrderCursor
+}
+
+func encodeBusinessOrderPageToken(businessId uuid.UUID, cursor datasource.BusinessOrderCursor) string {
+	data, _ := json.Marshal(businessOrderPageToken{BusinessId: businessId, BusinessOrderCursor: cursor})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeBusinessOrderPageToken returns the cursor of the token, nil for the
+// first page.
+func decodeBusinessOrderPageToken(token string, businessId uuid.UUID) (*datasource.BusinessOrderCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	var pageToken businessOrderPageToken
+	err = json.Unmarshal(data, &pageToken)
+	if err != nil || pageToken.BusinessId != businessId {
+		return nil, errInvalidPageToken
+	}
+	return &pageToken.BusinessOrderCursor, nil
+}
+
+func (i *orderService) ListBusinessOrder(ctx context.Context, req *pb.ListBusinessOrderRequest, md *utils.ClientMetadata) (*pb.ListBusinessOrderResponse, error) {
+	var res pb.ListBusinessOrderResponse
+	businessId := uuid.MustParse(req.BusinessId)
+	cursor, err := decodeBusinessOrderPageToken(req.PageToken, businessId)
+	if err != nil {
+		return nil, err
+	}
+	err = i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.authorizeBusinessOrder(ctx, tx, &businessId)
+		if err != nil {
+			return err
+		}
+		filter := datasource.BusinessOrderFilter{BusinessId: &businessId, Cursor: cursor, Limit: businessOrderPageSize + 1}
+		for _, item := range req.Statuses {
+			filter.Statuses = append(filter.Statuses, item.String())
+		}
+		if req.OrderType != pb.OrderType_OrderTypeUnspecified {
+			filter.OrderType = req.OrderType.String()
+		}
+		if req.StartTime != nil {
+			startTime := req.StartTime.AsTime()
+			filter.StartTime = &startTime
+		}
+		if req.EndTime != nil {
+			endTime := req.EndTime.AsTime()
+			filter.EndTime = &endTime
+		}
+		ordersRes, err := i.dao.NewOrderRepository().ListBusinessOrder(tx, &filter)
+		if err != nil {
+			return err
+		}
+		if len(*ordersRes) > businessOrderPageSize {
+			*ordersRes = (*ordersRes)[:businessOrderPageSize]
+			last := (*ordersRes)[businessOrderPageSize-1]
+			res.NextPageToken = encodeBusinessOrderPageToken(businessId, datasource.BusinessOrderCursor{CreateTime: last.CreateTime, Id: *last.ID})
+		}
+		ordersResponse := make([]*pb.Order, 0, len(*ordersRes))
+		for index := range *ordersRes {
+			ordersResponse = append(ordersResponse, i.businessOrderResponse(&(*ordersRes)[index]))
+		}
+		res.Orders = ordersResponse
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (i *orderService) GetBusinessOrder(ctx context.Context, req *pb.GetBusinessOrderRequest, md *utils.ClientMetadata) (*pb.Order, error) {
+	var res *pb.Order
+	businessId := uuid.MustParse(req.BusinessId)
+	id := uuid.MustParse(req.Id)
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := i.authorizeBusinessOrder(ctx, tx, &businessId)
+		if err != nil {
+			return err
+		}
+		order, err := i.getBusinessOrder(tx, &id, &businessId)
+		if err != nil {
+			return err
+		}
+		unionOrderedItems, err := i.dao.NewUnionOrderAndOrderedItemRepository().ListUnionOrderAndOrderedItem(tx, &entity.UnionOrderAndOrderedItem{OrderId: order.ID})
+		if err != nil {
+			return err
+		}
+		orderedItemIds := make([]uuid.UUID, 0, len(*unionOrderedItems))
+		for _, item := range *unionOrderedItems {
+			orderedItemIds = append(orderedItemIds, *item.OrderedItemId)
+		}
+		orderedItemsRes, err := i.dao.NewOrderedRepository().ListOrderedItemByIds(tx, orderedItemIds)
+		if err != nil {
+			return err
+		}
+		orderedItems := make([]*pb.OrderedItem, 0, len(*orderedItemsRes))
+		for _, item := range *orderedItemsRes {
+			orderedItems = append(orderedItems, &pb.OrderedItem{
+				Id:         item.ID.String(),
+				Name:       item.Name,
+				PriceCup:   item.PriceCup,
+				CartItemId: item.CartItemId.String(),
+				ItemId:     item.ItemId.String(),
+				Quantity:   item.Quantity,
+				UserId:     item.UserId.String(),
+				CreateTime: timestamppb.New(item.CreateTime),
+				UpdateTime: timestamppb.New(item.UpdateTime),
+			})
+		}
+		res = i.businessOrderResponse(order)
+		res.OrderedItems = orderedItems
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateBusinessOrder moves an order of the business to another status on
+// behalf of its staff. Unlike UpdateOrder the caller never acts as the
+// customer, even when the order is their own.
+func (i *orderService) UpdateBusinessOrder(ctx context.Context, req *pb.UpdateBusinessOrderRequest, md *utils.ClientMetadata) (*pb.Order, error) {
+	var res *pb.Order
+	var orderLifecycleRes *entity.OrderLifecycle
+	businessId := uuid.MustParse(req.BusinessId)
+	id := uuid.MustParse(req.Id)
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		actors, err := i.authorizeBusinessOrder(ctx, tx, &businessId)
+		if err != nil {
+			return err
+		}
+		order, err := i.getBusinessOrder(tx, &id, &businessId)
+		if err != nil {
+			return err
+		}
+		updateOrderRes, lifecycleRes, err := i.changeOrderStatus(ctx, tx, order, req.Status, req.CancelReasons, actors...)
+		if err != nil {
+			return err
+		}
+		orderLifecycleRes = lifecycleRes
+		res = i.businessOrderResponse(updateOrderRes)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.publishOrderLifecycles(ctx, orderLifecycleRes)
+	return res, nil
+}
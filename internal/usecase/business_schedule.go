@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// scheduleTime formats minutes since midnight as HH:MM.
+func scheduleTime(minute int32) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func businessScheduleResponse(schedule *entity.BusinessSchedule, now time.Time) *pb.BusinessSchedule {
+	res := &pb.BusinessSchedule{
+		Id:         schedule.ID.String(),
+		Timezone:   schedule.Timezone,
+		Intervals:  make([]*pb.BusinessScheduleInterval, 0, len(schedule.Intervals)),
+		Exceptions: make([]*pb.BusinessScheduleException, 0, len(schedule.Exceptions)),
+		Open:       schedule.IsOpenAt(now),
+	}
+	for _, item := range schedule.Intervals {
+		res.Intervals = append(res.Intervals, &pb.BusinessScheduleInterval{
+			Weekday:     int32(item.Weekday),
+			OpeningTime: scheduleTime(item.OpeningMinute),
+			ClosingTime: scheduleTime(item.ClosingMinute),
+		})
+	}
+	for _, item := range schedule.Exceptions {
+		exception := &pb.BusinessScheduleException{
+			Date:        item.Date.Format("2006-01-02"),
+			Closed:      item.Closed,
+			Description: item.Description,
+		}
+		if !item.Closed {
+			exception.OpeningTime = scheduleTime(item.OpeningMinute)
+			exception.ClosingTime = scheduleTime(item.ClosingMinute)
+		}
+		res.Exceptions = append(res.Exceptions, exception)
+	}
+	if nextOpening, ok := schedule.NextOpening(now); ok {
+		res.NextOpeningTime = timestamppb.New(nextOpening)
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/ewkb"
+	"gorm.io/gorm"
+)
+
+const (
+	// businessSearchPageSize is the number of businesses of a search page.
+	businessSearchPageSize = 10
+	// From businessClusterMaxZoom on the businesses of the bounds are listed
+	// rather than clustered, as long as there are up to maxBusinessInBounds.
+	businessClusterMaxZoom = 15
+	maxBusinessInBounds    = 500
+	// businessClusterPixels is the side of the cells the businesses are
+	// clustered by, in pixels of the 256 pixels map tiles.
+	businessClusterPixels = 64
+)
+
+// businessClusterCellSize returns the side in degrees of the cells the
+// businesses are clustered by at the zoom, a tile spans 360 / 2^zoom degrees.
+func businessClusterCellSize(zoom int32) float64 {
+	return 360 / math.Exp2(float64(zoom)) * businessClusterPixels / 256
+}
+
+func (v *businessService) SearchBusiness(ctx context.Context, req *pb.SearchBusinessRequest, md *utils.ClientMetadata) (*pb.SearchBusinessResponse, error) {
+	var res pb.SearchBusinessResponse
+	cursor, err := decodeSearchPageToken(req.PageToken, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	provinceId := uuid.MustParse(req.ProvinceId)
+	search := datasource.BusinessSearch{Query: req.Query, ProvinceId: &provinceId, HomeDelivery: req.HomeDelivery, ToPickUp: req.ToPickUp, Open: req.Open, Cursor: cursor, Limit: businessSearchPageSize + 1}
+	if req.MunicipalityId != "" {
+		municipalityId := uuid.MustParse(req.MunicipalityId)
+		search.MunicipalityId = &municipalityId
+	}
+	if req.BusinessCategoryId != "" {
+		businessCategoryId := uuid.MustParse(req.BusinessCategoryId)
+		search.BusinessCategoryId = &businessCategoryId
+	}
+	if req.Location != nil {
+		search.Location = &ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}
+	}
+	err = v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		searchRes, err := v.dao.NewBusinessRepository().SearchBusiness(tx, &search)
+		if err != nil {
+			return err
+		}
+		if len(*searchRes) > businessSearchPageSize {
+			*searchRes = (*searchRes)[:businessSearchPageSize]
+			res.NextPageToken = encodeSearchPageToken(req.Query, (*searchRes)[businessSearchPageSize-1].Cursor())
+		}
+		res.Businesses = make([]*pb.Business, 0, len(*searchRes))
+		for index := range *searchRes {
+			business := v.businessSummaryResponse(&(*searchRes)[index].Business)
+			if search.Location != nil {
+				business.Distance = float64((*searchRes)[index].Proximity)
+			}
+			res.Businesses = append(res.Businesses, business)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListBusinessInBounds returns the businesses of the map viewport, or their
+// clusters when the zoom is low or there are too many of them to draw.
+func (v *businessService) ListBusinessInBounds(ctx context.Context, req *pb.ListBusinessInBoundsRequest, md *utils.ClientMetadata) (*pb.ListBusinessInBoundsResponse, error) {
+	var res pb.ListBusinessInBoundsResponse
+	bounds := datasource.BusinessBounds{West: req.Bounds.SouthWest.Longitude, South: req.Bounds.SouthWest.Latitude, East: req.Bounds.NorthEast.Longitude, North: req.Bounds.NorthEast.Latitude}
+	err := v.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		if req.Zoom >= businessClusterMaxZoom {
+			businessRes, err := v.dao.NewBusinessRepository().ListBusinessInBounds(tx, &bounds, maxBusinessInBounds+1)
+			if err != nil {
+				return err
+			}
+			if len(*businessRes) <= maxBusinessInBounds {
+				res.Businesses = make([]*pb.Business, 0, len(*businessRes))
+				for index := range *businessRes {
+					res.Businesses = append(res.Businesses, v.businessSummaryResponse(&(*businessRes)[index]))
+				}
+				return nil
+			}
+		}
+		clusterRes, err := v.dao.NewBusinessRepository().ClusterBusinessInBounds(tx, &bounds, businessClusterCellSize(req.Zoom))
+		if err != nil {
+			return err
+		}
+		res.Clusters = make([]*pb.BusinessCluster, 0, len(*clusterRes))
+		for _, item := range *clusterRes {
+			cluster := &pb.BusinessCluster{
+				Coordinates: &pb.Point{Latitude: item.Latitude, Longitude: item.Longitude},
+				Count:       int32(item.Count),
+				Bounds: &pb.BoundingBox{
+					SouthWest: &pb.Point{Latitude: item.South, Longitude: item.West},
+					NorthEast: &pb.Point{Latitude: item.North, Longitude: item.East},
+				},
+			}
+			if item.BusinessId != nil {
+				cluster.BusinessId = item.BusinessId.String()
+			}
+			res.Clusters = append(res.Clusters, cluster)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// businessSummaryResponse is a business of a list or a map, without its
+// collections and schedule.
+func (v *businessService) businessSummaryResponse(e *entity.Business) *pb.Business {
+	res := &pb.Business{
+		Id:                    e.ID.String(),
+		Name:                  e.Name,
+		HighQualityPhoto:      e.HighQualityPhoto,
+		HighQualityPhotoUrl:   v.config.BusinessAvatarBulkName + "/" + e.HighQualityPhoto,
+		LowQualityPhoto:       e.LowQualityPhoto,
+		LowQualityPhotoUrl:    v.config.BusinessAvatarBulkName + "/" + e.LowQualityPhoto,
+		Thumbnail:             e.Thumbnail,
+		ThumbnailUrl:          v.config.BusinessAvatarBulkName + "/" + e.Thumbnail,
+		BlurHash:              e.BlurHash,
+		Address:               e.Address,
+		DeliveryPriceCup:      e.DeliveryPriceCup,
+		TimeMarginOrderMonth:  e.TimeMarginOrderMonth,
+		TimeMarginOrderDay:    e.TimeMarginOrderDay,
+		TimeMarginOrderHour:   e.TimeMarginOrderHour,
+		TimeMarginOrderMinute: e.TimeMarginOrderMinute,
+		ToPickUp:              e.ToPickUp,
+		HomeDelivery:          e.HomeDelivery,
+		BusinessBrandId:       e.BusinessBrandId.String(),
+		ProvinceId:            e.ProvinceId.String(),
+		MunicipalityId:        e.MunicipalityId.String(),
+		OpenFlag:              e.OpenFlag,
+	}
+	if e.Coordinates.Point != nil && len(e.Coordinates.FlatCoords()) >= 2 {
+		res.Coordinates = &pb.Point{Latitude: e.Coordinates.Y(), Longitude: e.Coordinates.X()}
+	}
+	return res
+}
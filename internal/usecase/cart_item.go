@@ -2,23 +2,36 @@ package usecase
 
 import (
 	"context"
-	"errors"
 	"strconv"
 	"time"
 
 	"github.com/daniarmas/api_go/config"
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
 	"github.com/google/uuid"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	gp "google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+// errNoAvailability is returned when the item doesn't have enough units left
+// for the requested quantity.
+func errNoAvailability(availability int64) error {
+	return apperror.InvalidArgument("no availability").WithMetadata("availability", strconv.FormatInt(availability, 10)).WithDetails(
+		&epb.QuotaFailure{
+			Violations: []*epb.QuotaFailure_Violation{{
+				Subject:     "Availability",
+				Description: strconv.FormatInt(availability, 10),
+			}},
+		},
+	)
+}
+
 type CartItemService interface {
 	ListCartItem(ctx context.Context, req *pb.ListCartItemRequest, md *utils.ClientMetadata) (*pb.ListCartItemResponse, error)
 	AddCartItem(ctx context.Context, req *pb.AddCartItemRequest, md *utils.ClientMetadata) (*pb.CartItem, error)
@@ -26,8 +39,11 @@ type CartItemService interface {
 	IsEmptyCartItem(ctx context.Context, req *gp.Empty, md *utils.ClientMetadata) (*pb.IsEmptyCartItemResponse, error)
 	DeleteCartItem(ctx context.Context, req *pb.DeleteCartItemRequest, md *utils.ClientMetadata) (*gp.Empty, error)
 	EmptyCartItem(ctx context.Context, md *utils.ClientMetadata) (*gp.Empty, error)
+	SweepExpiredCartItems(ctx context.Context) (int, error)
 }
 
+const sweepCartItemsBatchSize = 100
+
 type cartItemService struct {
 	dao    repository.Repository
 	config *config.Config
@@ -38,91 +54,113 @@ func NewCartItemService(dao repository.Repository, config *config.Config, sqldb
 	return &cartItemService{dao: dao, config: config, sqldb: sqldb}
 }
 
+// SweepExpiredCartItems gives the reserved quantities of the cart items whose
+// reservation expired back to the items and deletes the cart items, in
+// batches, and returns how many cart items were deleted.
+func (i *cartItemService) SweepExpiredCartItems(ctx context.Context) (int, error) {
+	var swept int
+	for {
+		var batch int
+		err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+			cartItemsRes, err := i.dao.NewCartItemRepository().ListExpiredCartItem(tx, time.Now().UTC(), sweepCartItemsBatchSize)
+			if err != nil {
+				return err
+			}
+			if len(*cartItemsRes) == 0 {
+				return nil
+			}
+			err = i.releaseCartItems(ctx, tx, cartItemsRes)
+			if err != nil {
+				return err
+			}
+			ids := make([]uuid.UUID, 0, len(*cartItemsRes))
+			for _, item := range *cartItemsRes {
+				ids = append(ids, *item.ID)
+			}
+			_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, nil, &ids)
+			if err != nil {
+				return err
+			}
+			batch = len(*cartItemsRes)
+			return nil
+		})
+		if err != nil {
+			return swept, err
+		}
+		swept += batch
+		if batch < sweepCartItemsBatchSize || ctx.Err() != nil {
+			return swept, ctx.Err()
+		}
+	}
+}
+
+// releaseCartItems gives the quantities reserved by the cart items back to the
+// availability of the items.
+func (i *cartItemService) releaseCartItems(ctx context.Context, tx *gorm.DB, cartItems *[]entity.CartItem) error {
+	quantities := make(map[uuid.UUID]int64, len(*cartItems))
+	for _, item := range *cartItems {
+		quantities[*item.ItemId] += int64(item.Quantity)
+	}
+	return i.dao.NewItemRepository().RestockItems(ctx, tx, quantities)
+}
+
 func (i *cartItemService) EmptyAndAddCartItem(ctx context.Context, req *pb.EmptyAndAddCartItemRequest, md *utils.ClientMetadata) (*pb.CartItem, error) {
 	var res pb.CartItem
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
-		listCartItemsRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
+		listCartItemsRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
-		itemFks := make([]uuid.UUID, 0, len(*listCartItemsRes))
-		for _, item := range *listCartItemsRes {
-			itemFks = append(itemFks, *item.ItemId)
-		}
-		itemsRes, err := i.dao.NewItemRepository().ListItemInIds(ctx, tx, itemFks)
+		err = i.releaseCartItems(ctx, tx, listCartItemsRes)
 		if err != nil {
 			return err
 		}
-		for _, item := range *listCartItemsRes {
-			var index = -1
-			for i, n := range *itemsRes {
-				if *n.ID == *item.ItemId {
-					index = i
-				}
-			}
-			(*itemsRes)[index].Availability += int64(item.Quantity)
-		}
-		for _, item := range *itemsRes {
-			_, err := i.dao.NewItemRepository().UpdateItem(ctx, tx, &entity.ItemBusiness{ID: item.ID}, &item)
-			if err != nil {
-				return err
-			}
-		}
-		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId}, nil)
-		if err != nil && err.Error() != "record not found" {
+		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId}, nil)
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		}
 		// Add CartItem
 		itemId := uuid.MustParse(req.ItemId)
 		item, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: &itemId})
 		var itemAvailability int64
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("item not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("item not found")
 		} else if err != nil {
 			return err
 		}
+		// The availability is read from the locked row, the cached one may be
+		// stale and the expiration jobs give units back concurrently.
+		item.Availability, err = i.dao.NewItemRepository().GetItemAvailabilityForUpdate(ctx, tx, item.ID)
+		if err != nil {
+			return err
+		}
 		business, err := i.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: item.BusinessId})
 		if err != nil {
 			return err
 		}
 		if !business.OpenFlag {
-			return errors.New("business is closed")
+			return apperror.FailedPrecondition("business is closed")
+		}
+		reservationTtl, err := i.config.CartItemReservationTtlFor(item.BusinessId.String())
+		if err != nil {
+			return err
 		}
+		expirationTime := time.Now().UTC().Add(reservationTtl)
 		var result *entity.CartItem
 		cartItemRes, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{ItemId: &itemId})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if cartItemRes != nil {
 			// Restoring the itemAvailability
-			item.Availability = item.Availability + int64(cartItemRes.Quantity)
+			item.Restock(int64(cartItemRes.Quantity))
 			if (item.Availability - int64(req.Quantity)) < 0 {
-				return errors.New("no_availability:availability:" + strconv.Itoa(int(item.Availability)))
+				return errNoAvailability(item.Availability)
 			} else if item.Availability-int64(req.Quantity) == 0 {
-				itemAvailability = -1
+				itemAvailability = entity.ItemAvailabilityExhausted
 			} else {
 				itemAvailability = item.Availability - int64(req.Quantity)
 			}
@@ -130,12 +168,12 @@ func (i *cartItemService) EmptyAndAddCartItem(ctx context.Context, req *pb.Empty
 			if err != nil {
 				return err
 			}
-			result, err = i.dao.NewCartItemRepository().UpdateCartItem(tx, &entity.CartItem{ItemId: &itemId}, &entity.CartItem{Quantity: req.Quantity})
+			result, err = i.dao.NewCartItemRepository().UpdateCartItem(tx, &entity.CartItem{ItemId: &itemId}, &entity.CartItem{Quantity: req.Quantity, ExpirationTime: expirationTime})
 			if err != nil {
 				return err
 			}
-		} else if cartItemRes == nil && err.Error() == "record not found" {
-			result, err = i.dao.NewCartItemRepository().CreateCartItem(tx, &entity.CartItem{Name: item.Name, PriceCup: item.PriceCup, Quantity: req.Quantity, ItemId: item.ID, UserId: authorizationTokenRes.UserId, AuthorizationTokenId: authorizationTokenRes.ID, BusinessId: item.BusinessId, Thumbnail: item.Thumbnail, BlurHash: item.BlurHash})
+		} else if cartItemRes == nil && apperror.IsNotFound(err) {
+			result, err = i.dao.NewCartItemRepository().CreateCartItem(tx, &entity.CartItem{Name: item.Name, PriceCup: item.PriceCup, Quantity: req.Quantity, ItemId: item.ID, UserId: principal.AuthorizationToken.UserId, AuthorizationTokenId: principal.AuthorizationToken.ID, BusinessId: item.BusinessId, Thumbnail: item.Thumbnail, BlurHash: item.BlurHash, ExpirationTime: expirationTime})
 			if err != nil {
 				return err
 			}
@@ -154,6 +192,7 @@ func (i *cartItemService) EmptyAndAddCartItem(ctx context.Context, req *pb.Empty
 			Quantity:             result.Quantity,
 			CreateTime:           timestamppb.New(result.CreateTime),
 			UpdateTime:           timestamppb.New(result.UpdateTime),
+			ExpirationTime:       timestamppb.New(result.ExpirationTime),
 			Thumbnail:            result.Thumbnail,
 			ThumbnailUrl:         i.config.ItemsBulkName + "/" + result.Thumbnail,
 			BlurHash:             result.BlurHash,
@@ -168,58 +207,19 @@ func (i *cartItemService) EmptyAndAddCartItem(ctx context.Context, req *pb.Empty
 
 func (i *cartItemService) EmptyCartItem(ctx context.Context, md *utils.ClientMetadata) (*gp.Empty, error) {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		listCartItemsRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
-		listCartItemsRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
+		err = i.releaseCartItems(ctx, tx, listCartItemsRes)
 		if err != nil {
 			return err
 		}
-		itemFks := make([]uuid.UUID, 0, len(*listCartItemsRes))
-		for _, item := range *listCartItemsRes {
-			itemFks = append(itemFks, *item.ItemId)
-		}
-		itemsRes, err := i.dao.NewItemRepository().ListItemInIds(ctx, tx, itemFks)
-		if err != nil {
-			return err
-		}
-		for _, item := range *listCartItemsRes {
-			var index = -1
-			for i, n := range *itemsRes {
-				if *n.ID == *item.ItemId {
-					index = i
-				}
-			}
-			(*itemsRes)[index].Availability += int64(item.Quantity)
-		}
-		for _, item := range *itemsRes {
-			_, err := i.dao.NewItemRepository().UpdateItem(ctx, tx, &entity.ItemBusiness{ID: item.ID}, &item)
-			if err != nil {
-				return err
-			}
-		}
-		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId}, nil)
+		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId}, nil)
 		if err != nil {
 			return err
 		}
@@ -234,31 +234,11 @@ func (i *cartItemService) EmptyCartItem(ctx context.Context, md *utils.ClientMet
 func (i *cartItemService) IsEmptyCartItem(ctx context.Context, req *gp.Empty, md *utils.ClientMetadata) (*pb.IsEmptyCartItemResponse, error) {
 	var cartItemQuantityRes *bool
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
-		cartItemQuantityRes, err = i.dao.NewCartItemRepository().CartItemIsEmpty(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
+		cartItemQuantityRes, err = i.dao.NewCartItemRepository().CartItemIsEmpty(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
@@ -273,28 +253,8 @@ func (i *cartItemService) IsEmptyCartItem(ctx context.Context, req *gp.Empty, md
 func (i *cartItemService) ListCartItem(ctx context.Context, req *pb.ListCartItemRequest, md *utils.ClientMetadata) (*pb.ListCartItemResponse, error) {
 	var res pb.ListCartItemResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		var nextPage time.Time
@@ -303,7 +263,7 @@ func (i *cartItemService) ListCartItem(ctx context.Context, req *pb.ListCartItem
 		} else {
 			nextPage = req.NextPage.AsTime()
 		}
-		items, err := i.dao.NewCartItemRepository().ListCartItem(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId}, &nextPage)
+		items, err := i.dao.NewCartItemRepository().ListCartItem(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId}, &nextPage)
 		if err != nil {
 			return err
 		} else if len(*items) > 10 {
@@ -329,6 +289,7 @@ func (i *cartItemService) ListCartItem(ctx context.Context, req *pb.ListCartItem
 				BlurHash:             item.BlurHash,
 				CreateTime:           timestamppb.New(item.CreateTime),
 				UpdateTime:           timestamppb.New(item.UpdateTime),
+				ExpirationTime:       timestamppb.New(item.ExpirationTime),
 			})
 		}
 		res.CartItems = itemsResponse
@@ -343,56 +304,47 @@ func (i *cartItemService) ListCartItem(ctx context.Context, req *pb.ListCartItem
 func (i *cartItemService) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest, md *utils.ClientMetadata) (*pb.CartItem, error) {
 	var res pb.CartItem
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		itemId := uuid.MustParse(req.ItemId)
 		item, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: &itemId})
 		var itemAvailability int64
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("item not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("item not found")
 		} else if err != nil {
 			return err
 		}
+		// The availability is read from the locked row, the cached one may be
+		// stale and the expiration jobs give units back concurrently.
+		item.Availability, err = i.dao.NewItemRepository().GetItemAvailabilityForUpdate(ctx, tx, item.ID)
+		if err != nil {
+			return err
+		}
 		business, err := i.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: item.BusinessId})
 		if err != nil {
 			return err
 		}
 		if !business.OpenFlag {
-			return errors.New("business is closed")
+			return apperror.FailedPrecondition("business is closed")
+		}
+		reservationTtl, err := i.config.CartItemReservationTtlFor(item.BusinessId.String())
+		if err != nil {
+			return err
 		}
+		expirationTime := time.Now().UTC().Add(reservationTtl)
 		var result *entity.CartItem
 		cartItemRes, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{ItemId: &itemId})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if cartItemRes != nil {
 			// Restoring the itemAvailability
-			item.Availability = item.Availability + int64(cartItemRes.Quantity)
+			item.Restock(int64(cartItemRes.Quantity))
 			if (item.Availability - int64(req.Quantity)) < 0 {
-				return errors.New("no_availability:availability:" + strconv.Itoa(int(item.Availability)))
+				return errNoAvailability(item.Availability)
 			} else if item.Availability-int64(req.Quantity) == 0 {
-				itemAvailability = -1
+				itemAvailability = entity.ItemAvailabilityExhausted
 			} else {
 				itemAvailability = item.Availability - int64(req.Quantity)
 			}
@@ -400,18 +352,18 @@ func (i *cartItemService) AddCartItem(ctx context.Context, req *pb.AddCartItemRe
 			if err != nil {
 				return err
 			}
-			result, err = i.dao.NewCartItemRepository().UpdateCartItem(tx, &entity.CartItem{ItemId: &itemId}, &entity.CartItem{Quantity: req.Quantity})
+			result, err = i.dao.NewCartItemRepository().UpdateCartItem(tx, &entity.CartItem{ItemId: &itemId}, &entity.CartItem{Quantity: req.Quantity, ExpirationTime: expirationTime})
 			if err != nil {
 				return err
 			}
-		} else if cartItemRes == nil && err.Error() == "record not found" {
-			cartItemExists, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
-			if err != nil && err.Error() != "record not found" {
+		} else if cartItemRes == nil && apperror.IsNotFound(err) {
+			cartItemExists, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId})
+			if err != nil && !apperror.IsNotFound(err) {
 				return err
  			} else if cartItemExists != nil && *cartItemExists.BusinessId != *item.BusinessId {
-				return errors.New("the items in the cart can only be from one business")
+				return apperror.InvalidArgument("the items in the cart can only be from one business")
 			}
-			result, err = i.dao.NewCartItemRepository().CreateCartItem(tx, &entity.CartItem{Name: item.Name, PriceCup: item.PriceCup, Quantity: req.Quantity, ItemId: item.ID, UserId: authorizationTokenRes.UserId, AuthorizationTokenId: authorizationTokenRes.ID, BusinessId: item.BusinessId, Thumbnail: item.Thumbnail, BlurHash: item.BlurHash})
+			result, err = i.dao.NewCartItemRepository().CreateCartItem(tx, &entity.CartItem{Name: item.Name, PriceCup: item.PriceCup, Quantity: req.Quantity, ItemId: item.ID, UserId: principal.AuthorizationToken.UserId, AuthorizationTokenId: principal.AuthorizationToken.ID, BusinessId: item.BusinessId, Thumbnail: item.Thumbnail, BlurHash: item.BlurHash, ExpirationTime: expirationTime})
 			if err != nil {
 				return err
 			}
@@ -430,6 +382,7 @@ func (i *cartItemService) AddCartItem(ctx context.Context, req *pb.AddCartItemRe
 			Quantity:             result.Quantity,
 			CreateTime:           timestamppb.New(result.CreateTime),
 			UpdateTime:           timestamppb.New(result.UpdateTime),
+			ExpirationTime:       timestamppb.New(result.ExpirationTime),
 			Thumbnail:            result.Thumbnail,
 			ThumbnailUrl:         i.config.ItemsBulkName + "/" + result.Thumbnail,
 			BlurHash:             result.BlurHash,
@@ -444,30 +397,10 @@ func (i *cartItemService) AddCartItem(ctx context.Context, req *pb.AddCartItemRe
 
 func (i *cartItemService) DeleteCartItem(ctx context.Context, req *pb.DeleteCartItemRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		var whereCartItem entity.CartItem
 		if req.ItemId != "" {
 			value := uuid.MustParse(req.ItemId)
@@ -477,19 +410,12 @@ func (i *cartItemService) DeleteCartItem(ctx context.Context, req *pb.DeleteCart
 			value := uuid.MustParse(req.Id)
 			whereCartItem.ID = &value
 		}
-		whereCartItem.UserId = authorizationTokenRes.UserId
+		whereCartItem.UserId = principal.AuthorizationToken.UserId
 		cartItemRes, err := i.dao.NewCartItemRepository().GetCartItem(tx, &whereCartItem)
-		if err != nil && err.Error() != "record not found" {
-			return errors.New("cart item not found")
-		}
-		item, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: cartItemRes.ItemId})
-		if err != nil {
-			return err
-		}
-		if item.Availability == -1 {
-			item.Availability += 1
+		if err != nil && !apperror.IsNotFound(err) {
+			return apperror.NotFound("cart item not found")
 		}
-		_, err = i.dao.NewItemRepository().UpdateItem(ctx, tx, &entity.ItemBusiness{ID: item.ID}, &entity.ItemBusiness{Availability: item.Availability + int64(cartItemRes.Quantity)})
+		err = i.dao.NewItemRepository().RestockItems(ctx, tx, map[uuid.UUID]int64{*cartItemRes.ItemId: int64(cartItemRes.Quantity)})
 		if err != nil {
 			return err
 		}
@@ -10,11 +10,14 @@ import (
 	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/ewkb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -24,6 +27,7 @@ type ItemService interface {
 	ListItem(ctx context.Context, req *pb.ListItemRequest, md *utils.ClientMetadata) (*pb.ListItemResponse, error)
 	SearchItem(ctx context.Context, req *pb.SearchItemRequest, md *utils.ClientMetadata) (*pb.SearchItemResponse, error)
 	SearchItemByBusiness(ctx context.Context, req *pb.SearchItemByBusinessRequest, md *utils.ClientMetadata) (*pb.SearchItemByBusinessResponse, error)
+	SuggestSearch(ctx context.Context, req *pb.SuggestSearchRequest, md *utils.ClientMetadata) (*pb.SuggestSearchResponse, error)
 	CreateItem(ctx context.Context, req *pb.CreateItemRequest, md *utils.ClientMetadata) (*pb.Item, error)
 	UpdateItem(ctx context.Context, req *pb.UpdateItemRequest, md *utils.ClientMetadata) (*pb.Item, error)
 	DeleteItem(ctx context.Context, req *pb.DeleteItemRequest, md *utils.ClientMetadata) error
@@ -43,63 +47,43 @@ func NewItemService(dao repository.Repository, config *config.Config, stDb *sql.
 func (i *itemService) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest, md *utils.ClientMetadata) (*pb.Item, error) {
 	var res pb.Item
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Item.Id)
 		getItemRes, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("item not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("item not found")
 		} else if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "update_item", BusinessId: getItemRes.BusinessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "update_item", BusinessId: getItemRes.BusinessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		getCartItemRes, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{ItemId: &id})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if getCartItemRes != nil {
-			return errors.New("item in the cart")
+			return apperror.InvalidArgument("item in the cart")
 		}
 		if req.Item.HighQualityPhoto != "" || req.Item.LowQualityPhoto != "" || req.Item.Thumbnail != "" {
 			_, err := i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.HighQualityPhoto)
-			if err != nil && err.Error() == "ObjectMissing" {
-				return errors.New("HighQualityPhotoObject missing")
+			if errors.Is(err, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("HighQualityPhotoObject missing")
 			} else if err != nil {
 				return err
 			}
 			_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.LowQualityPhoto)
-			if err != nil && err.Error() == "ObjectMissing" {
-				return errors.New("LowQualityPhotoObject missing")
+			if errors.Is(err, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("LowQualityPhotoObject missing")
 			} else if err != nil {
 				return err
 			}
 			_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.Thumbnail)
-			if err != nil && err.Error() == "ObjectMissing" {
-				return errors.New("ThumbnailObject missing")
+			if errors.Is(err, datasource.ErrObjectMissing) {
+				return apperror.InvalidArgument("ThumbnailObject missing")
 			} else if err != nil {
 				return err
 			}
@@ -159,54 +143,34 @@ func (i *itemService) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest,
 
 func (i *itemService) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest, md *utils.ClientMetadata) error {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Id)
 		getItemRes, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("item not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("item not found")
 		} else if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: "delete_item", UserId: authorizationTokenRes.UserId, BusinessId: getItemRes.BusinessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: "delete_item", UserId: principal.AuthorizationToken.UserId, BusinessId: getItemRes.BusinessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		} else if err != nil {
 			return err
 		}
 		businessIsOpenRes, err := i.dao.NewBusinessScheduleRepository().BusinessIsOpen(tx, &entity.BusinessSchedule{BusinessId: getItemRes.BusinessId})
-		if err != nil && err.Error() != "business closed" {
+		if err != nil && !errors.Is(err, datasource.ErrBusinessClosed) {
 			return err
 		} else if businessIsOpenRes {
-			return errors.New("business is open")
+			return apperror.InvalidArgument("business is open")
 		}
 		getCartItemRes, err := i.dao.NewCartItemRepository().GetCartItem(tx, &entity.CartItem{ItemId: &id})
-		if err != nil && err.Error() != "record not found" {
+		if err != nil && !apperror.IsNotFound(err) {
 			return err
 		} else if getCartItemRes != nil {
-			return errors.New("item in the cart")
+			return apperror.InvalidArgument("item in the cart")
 		}
 		_, err = i.dao.NewItemRepository().DeleteItem(ctx, tx, &entity.ItemBusiness{ID: &id}, nil)
 		if err != nil {
@@ -247,28 +211,8 @@ func (i *itemService) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest,
 func (i *itemService) CreateItem(ctx context.Context, req *pb.CreateItemRequest, md *utils.ClientMetadata) (*pb.Item, error) {
 	var res pb.Item
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		businessId := uuid.MustParse(req.Item.BusinessId)
@@ -276,27 +220,27 @@ func (i *itemService) CreateItem(ctx context.Context, req *pb.CreateItemRequest,
 		if err != nil {
 			return err
 		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: "create_item", UserId: authorizationTokenRes.UserId, BusinessId: &businessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{Name: "create_item", UserId: principal.AuthorizationToken.UserId, BusinessId: &businessId})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		} else if err != nil {
 			return err
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.HighQualityPhoto)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("highQualityPhotoObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("highQualityPhotoObject missing")
 		} else if err != nil {
 			return err
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.LowQualityPhoto)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("lowQualityPhotoObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("lowQualityPhotoObject missing")
 		} else if err != nil {
 			return err
 		}
 		_, err = i.dao.NewObjectStorageRepository().ObjectExists(context.Background(), i.config.ItemsBulkName, req.Item.Thumbnail)
-		if err != nil && err.Error() == "ObjectMissing" {
-			return errors.New("thumbnailObject missing")
+		if errors.Is(err, datasource.ErrObjectMissing) {
+			return apperror.InvalidArgument("thumbnailObject missing")
 		} else if err != nil {
 			return err
 		}
@@ -317,10 +261,6 @@ func (i *itemService) CreateItem(ctx context.Context, req *pb.CreateItemRequest,
 func (i *itemService) ListItem(ctx context.Context, req *pb.ListItemRequest, md *utils.ClientMetadata) (*pb.ListItemResponse, error) {
 	var res pb.ListItemResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
 		where := entity.ItemBusiness{}
 		var nextPage time.Time
 		if req.NextPage == nil || (req.NextPage.Nanos == 0 && req.NextPage.Seconds == 0) {
@@ -385,14 +325,10 @@ func (i *itemService) ListItem(ctx context.Context, req *pb.ListItemRequest, md
 func (i *itemService) GetItem(ctx context.Context, req *pb.GetItemRequest, md *utils.ClientMetadata) (*pb.Item, error) {
 	var res pb.Item
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Id)
 		item, err := i.dao.NewItemRepository().GetItem(ctx, tx, &entity.ItemBusiness{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("item not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("item not found")
 		} else if err != nil {
 			return err
 		}
@@ -427,119 +363,35 @@ func (i *itemService) GetItem(ctx context.Context, req *pb.GetItemRequest, md *u
 
 func (i *itemService) SearchItem(ctx context.Context, req *pb.SearchItemRequest, md *utils.ClientMetadata) (*pb.SearchItemResponse, error) {
 	var res pb.SearchItemResponse
+	provinceId := uuid.MustParse(req.ProvinceId)
+	search := datasource.ItemSearch{Query: req.Name, ProvinceId: &provinceId}
+	if req.MunicipalityId != "" {
+		municipalityId := uuid.MustParse(req.MunicipalityId)
+		search.MunicipalityId = &municipalityId
+	}
+	if req.Location != nil {
+		search.Location = &ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}
+	}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		var searchItemRes *[]entity.ItemBusiness
-		if req.SearchMunicipalityType.String() == "More" {
-			searchItemRes, err = i.dao.NewItemRepository().SearchItem(ctx, tx, req.Name, req.ProvinceId, req.MunicipalityId, int64(req.NextPage), false, 10)
-			if err != nil {
-				return err
-			}
-			if len(*searchItemRes) > 10 {
-				*searchItemRes = (*searchItemRes)[:len(*searchItemRes)-1]
-				res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_More
-			} else if len(*searchItemRes) <= 10 && len(*searchItemRes) != 0 {
-				length := 10 - len(*searchItemRes)
-				responseAdd, responseErr := i.dao.NewItemRepository().SearchItem(ctx, tx, req.Name, req.ProvinceId, req.MunicipalityId, int64(req.NextPage), true, int64(length))
-				if responseErr != nil {
-					return responseErr
-				}
-				if len(*responseAdd) > length {
-					*responseAdd = (*responseAdd)[:len(*responseAdd)-1]
-				}
-				*searchItemRes = append(*searchItemRes, *responseAdd...)
-				res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
-			} else if len(*searchItemRes) == 0 {
-				searchItemRes, err = i.dao.NewItemRepository().SearchItem(ctx, tx, req.Name, req.ProvinceId, req.MunicipalityId, int64(req.NextPage), true, 10)
-				if err != nil {
-					return err
-				}
-				if len(*searchItemRes) > 10 {
-					*searchItemRes = (*searchItemRes)[:len(*searchItemRes)-1]
-					res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-				} else if len(*searchItemRes) <= 10 && len(*searchItemRes) != 0 {
-					res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-				}
-				res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
-			}
-		} else {
-			searchItemRes, err = i.dao.NewItemRepository().SearchItem(ctx, tx, req.Name, req.ProvinceId, req.MunicipalityId, int64(req.NextPage), true, 10)
-			if err != nil {
-				return err
-			}
-			if len(*searchItemRes) > 10 {
-				*searchItemRes = (*searchItemRes)[:len(*searchItemRes)-1]
-				res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-			} else if len(*searchItemRes) <= 10 && len(*searchItemRes) != 0 {
-				res.NextPage = int32((*searchItemRes)[len(*searchItemRes)-1].Cursor)
-			}
-			res.SearchMunicipalityType = pb.SearchMunicipalityType_NoMore
-		}
-		if searchItemRes != nil {
-			itemsResponse := make([]*pb.SearchItem, 0, len(*searchItemRes))
-			for _, e := range *searchItemRes {
-				itemsResponse = append(itemsResponse, &pb.SearchItem{
-					Id:           e.ID.String(),
-					Name:         e.Name,
-					Thumbnail:    e.Thumbnail,
-					ThumbnailUrl: i.config.ItemsBulkName + "/" + e.Thumbnail,
-					BlurHash:     e.BlurHash,
-					PriceCup:     e.PriceCup,
-					BusinessId:   e.BusinessId.String(),
-					Cursor:       int32(e.Cursor),
-				})
-			}
-			res.Items = itemsResponse
-		}
-		return nil
+		var err error
+		res.Items, res.NextPageToken, err = i.searchItems(ctx, tx, &search, req.PageToken)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
+	i.recordSearchQuery(ctx, req.Name, req.PageToken)
 	return &res, nil
 }
 
 func (i *itemService) SearchItemByBusiness(ctx context.Context, req *pb.SearchItemByBusinessRequest, md *utils.ClientMetadata) (*pb.SearchItemByBusinessResponse, error) {
 	var res pb.SearchItemByBusinessResponse
+	businessId := uuid.MustParse(req.BusinessId)
+	search := datasource.ItemSearch{Query: req.Name, BusinessId: &businessId}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		var searchItemByBusinessRes *[]entity.ItemBusiness
-		searchItemByBusinessRes, err = i.dao.NewItemRepository().SearchItemByBusiness(ctx, tx, req.Name, int64(req.NextPage), req.BusinessId)
-		if err != nil {
-			return err
-		}
-		if len(*searchItemByBusinessRes) <= 10 && len(*searchItemByBusinessRes) > 1 {
-			*searchItemByBusinessRes = (*searchItemByBusinessRes)[:len(*searchItemByBusinessRes)]
-			res.NextPage = int32((*searchItemByBusinessRes)[len(*searchItemByBusinessRes)-1].Cursor)
-		} else if len(*searchItemByBusinessRes) == 1 {
-			*searchItemByBusinessRes = (*searchItemByBusinessRes)[:len(*searchItemByBusinessRes)]
-			res.NextPage = int32((*searchItemByBusinessRes)[len(*searchItemByBusinessRes)-1].Cursor)
-		}
-		if len(*searchItemByBusinessRes) != 0 {
-			itemsResponse := make([]*pb.SearchItem, 0, len(*searchItemByBusinessRes))
-			for _, e := range *searchItemByBusinessRes {
-				itemsResponse = append(itemsResponse, &pb.SearchItem{
-					Id:           e.ID.String(),
-					Name:         e.Name,
-					Thumbnail:    e.Thumbnail,
-					ThumbnailUrl: i.config.ItemsBulkName + "/" + e.Thumbnail,
-					BlurHash:     e.BlurHash,
-					PriceCup:     e.PriceCup,
-					Cursor:       int32(e.Cursor),
-					BusinessId:   e.BusinessId.String(),
-				})
-			}
-			res.Items = itemsResponse
-		}
-		return nil
+		var err error
+		res.Items, res.NextPageToken, err = i.searchItems(ctx, tx, &search, req.PageToken)
+		return err
 	})
 	if err != nil {
 		return nil, err
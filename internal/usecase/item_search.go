@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// itemSearchPageSize is the number of items of a search page.
+	itemSearchPageSize = 10
+	// maxSearchSuggestions is the number of suggestions of a prefix, up to
+	// maxRecentQuerySuggestions of them are recent queries of the user.
+	maxSearchSuggestions      = 10
+	maxRecentQuerySuggestions = 3
+)
+
+var searchSuggestionTypes = map[string]pb.SearchSuggestionType{
+	entity.SearchSuggestionTypeItem:        pb.SearchSuggestionType_SearchSuggestionTypeItem,
+	entity.SearchSuggestionTypeBusiness:    pb.SearchSuggestionType_SearchSuggestionTypeBusiness,
+	entity.SearchSuggestionTypeCategory:    pb.SearchSuggestionType_SearchSuggestionTypeCategory,
+	entity.SearchSuggestionTypeRecentQuery: pb.SearchSuggestionType_SearchSuggestionTypeRecentQuery,
+}
+
+// searchItems returns a page of the search and the token of the next one,
+// empty on the last page.
+func (i *itemService) searchItems(ctx context.Context, tx *gorm.DB, search *datasource.ItemSearch, pageToken string) ([]*pb.SearchItem, string, error) {
+	cursor, err := decodeSearchPageToken(pageToken, search.Query)
+	if err != nil {
+		return nil, "", err
+	}
+	search.Cursor = cursor
+	search.Limit = itemSearchPageSize + 1
+	searchRes, err := i.dao.NewItemRepository().SearchItem(ctx, tx, search)
+	if err != nil {
+		return nil, "", err
+	}
+	var nextPageToken string
+	if len(*searchRes) > itemSearchPageSize {
+		*searchRes = (*searchRes)[:itemSearchPageSize]
+		nextPageToken = encodeSearchPageToken(search.Query, (*searchRes)[itemSearchPageSize-1].Cursor())
+	}
+	items := make([]*pb.SearchItem, 0, len(*searchRes))
+	for _, e := range *searchRes {
+		items = append(items, &pb.SearchItem{
+			Id:                   e.ID.String(),
+			Name:                 e.Name,
+			Thumbnail:            e.Thumbnail,
+			ThumbnailUrl:         i.config.ItemsBulkName + "/" + e.Thumbnail,
+			BlurHash:             e.BlurHash,
+			PriceCup:             e.PriceCup,
+			BusinessId:           e.BusinessId.String(),
+			BusinessName:         e.BusinessName,
+			NameHighlight:        e.NameHighlight,
+			DescriptionHighlight: e.DescriptionHighlight,
+		})
+	}
+	return items, nextPageToken, nil
+}
+
+// recordSearchQuery keeps the query of the first page among the recent
+// queries of the signed in user.
+func (i *itemService) recordSearchQuery(ctx context.Context, query string, pageToken string) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.AuthorizationToken == nil || pageToken != "" {
+		return
+	}
+	err := i.dao.NewSearchSuggestionRepository().AddRecentSearchQuery(ctx, principal.AuthorizationToken.UserId, query)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func (i *itemService) SuggestSearch(ctx context.Context, req *pb.SuggestSearchRequest, md *utils.ClientMetadata) (*pb.SuggestSearchResponse, error) {
+	provinceId := uuid.MustParse(req.ProvinceId)
+	var municipalityId *uuid.UUID
+	if req.MunicipalityId != "" {
+		id := uuid.MustParse(req.MunicipalityId)
+		municipalityId = &id
+	}
+	var recentQueries []string
+	principal, ok := PrincipalFromContext(ctx)
+	if ok && principal.AuthorizationToken != nil {
+		var err error
+		recentQueries, err = i.dao.NewSearchSuggestionRepository().ListRecentSearchQuery(ctx, principal.AuthorizationToken.UserId, req.Prefix, maxRecentQuerySuggestions)
+		if err != nil {
+			return nil, err
+		}
+	}
+	suggestions, err := i.dao.NewSearchSuggestionRepository().ListSearchSuggestion(ctx, req.Prefix, &provinceId, 5*maxSearchSuggestions)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuggestSearchResponse{Suggestions: mergeSearchSuggestions(recentQueries, suggestions, municipalityId)}, nil
+}
+
+// mergeSearchSuggestions puts the recent queries first, then the suggestions
+// of the municipality, or of no municipality in particular, then the rest.
+// The shortest texts, the closest to the prefix, come first, and a text is
+// suggested once.
+func mergeSearchSuggestions(recentQueries []string, suggestions []entity.SearchSuggestion, municipalityId *uuid.UUID) []*pb.SearchSuggestion {
+	outside := func(suggestion *entity.SearchSuggestion) bool {
+		return municipalityId != nil && suggestion.MunicipalityId != nil && *suggestion.MunicipalityId != *municipalityId
+	}
+	sort.SliceStable(suggestions, func(a, b int) bool {
+		if outside(&suggestions[a]) != outside(&suggestions[b]) {
+			return outside(&suggestions[b])
+		}
+		return len(suggestions[a].Text) < len(suggestions[b].Text)
+	})
+	res := make([]*pb.SearchSuggestion, 0, maxSearchSuggestions)
+	seen := make(map[string]bool)
+	add := func(suggestionType string, text string, id *uuid.UUID) {
+		normalized := repository.NormalizeSearchText(text)
+		if len(res) == maxSearchSuggestions || seen[normalized] {
+			return
+		}
+		seen[normalized] = true
+		suggestion := &pb.SearchSuggestion{Text: text, Type: searchSuggestionTypes[suggestionType]}
+		if id != nil {
+			suggestion.Id = id.String()
+		}
+		res = append(res, suggestion)
+	}
+	for _, query := range recentQueries {
+		add(entity.SearchSuggestionTypeRecentQuery, query, nil)
+	}
+	for index := range suggestions {
+		add(suggestions[index].Type, suggestions[index].Text, suggestions[index].Id)
+	}
+	return res
+}
@@ -0,0 +1,614 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/keyring"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The usecase tests keep their data in the memory repositories below, the
+// transactions of the test database do nothing and its queries fail.
+
+var errTestQuery = errors.New("the test database doesn't run queries")
+
+type testConnPool struct{}
+
+func (testConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errTestQuery
+}
+
+func (testConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return nil, errTestQuery
+}
+
+func (testConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errTestQuery
+}
+
+func (testConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	panic(errTestQuery)
+}
+
+func (testConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	return &testTx{}, nil
+}
+
+type testTx struct {
+	testConnPool
+}
+
+func (*testTx) Commit() error {
+	return nil
+}
+
+func (*testTx) Rollback() error {
+	return nil
+}
+
+func newTestSqldb(t *testing.T) *sqldb.Sql {
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: testConnPool{}}), &gorm.Config{SkipDefaultTransaction: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &sqldb.Sql{Gorm: db}
+}
+
+// setTestKeyring signs and verifies the json web tokens of the test with a
+// new key.
+func setTestKeyring(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ring, err := keyring.NewKeyring("test", &keyring.Key{Id: "test", Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey, PublicKey: publicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.Datasource = datasource.New(nil, nil, nil, ring)
+}
+
+type memoryRepository struct {
+	repository.Repository
+	users               map[uuid.UUID]*entity.User
+	devices             map[uuid.UUID]*entity.Device
+	refreshTokens       map[uuid.UUID]*entity.RefreshToken
+	authorizationTokens map[uuid.UUID]*entity.AuthorizationToken
+	verificationCodes   map[uuid.UUID]*entity.VerificationCode
+	lockouts            map[string]time.Time
+	permissions         []entity.UserPermission
+	bannedDevices       map[uuid.UUID]*entity.BannedDevice
+	bannedUsers         map[uuid.UUID]*entity.BannedUser
+	orders              map[uuid.UUID]*entity.Order
+	orderItems          []entity.UnionOrderAndOrderedItem
+	orderedItems        map[uuid.UUID]*entity.OrderedItem
+	orderLifecycles     []entity.OrderLifecycle
+	items               map[uuid.UUID]*entity.ItemBusiness
+	outbox              []entity.Outbox
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		users:               map[uuid.UUID]*entity.User{},
+		devices:             map[uuid.UUID]*entity.Device{},
+		refreshTokens:       map[uuid.UUID]*entity.RefreshToken{},
+		authorizationTokens: map[uuid.UUID]*entity.AuthorizationToken{},
+		verificationCodes:   map[uuid.UUID]*entity.VerificationCode{},
+		lockouts:            map[string]time.Time{},
+		bannedDevices:       map[uuid.UUID]*entity.BannedDevice{},
+		bannedUsers:         map[uuid.UUID]*entity.BannedUser{},
+		orders:              map[uuid.UUID]*entity.Order{},
+		orderedItems:        map[uuid.UUID]*entity.OrderedItem{},
+		items:               map[uuid.UUID]*entity.ItemBusiness{},
+	}
+}
+
+func (r *memoryRepository) NewUserRepository() repository.UserRepository {
+	return memoryUsers{r: r}
+}
+
+func (r *memoryRepository) NewUserConfigurationRepository() repository.UserConfigurationRepository {
+	return memoryUserConfigurations{}
+}
+
+func (r *memoryRepository) NewDeviceRepository() repository.DeviceRepository {
+	return memoryDevices{r: r}
+}
+
+func (r *memoryRepository) NewRefreshTokenRepository() repository.RefreshTokenRepository {
+	return memoryRefreshTokens{r: r}
+}
+
+func (r *memoryRepository) NewAuthorizationTokenRepository() repository.AuthorizationTokenRepository {
+	return memoryAuthorizationTokens{r: r}
+}
+
+func (r *memoryRepository) NewVerificationCodeRepository() repository.VerificationCodeRepository {
+	return memoryVerificationCodes{r: r}
+}
+
+func (r *memoryRepository) NewApplicationRepository() repository.ApplicationRepository {
+	return memoryApplications{}
+}
+
+func (r *memoryRepository) NewUserPermissionRepository() repository.UserPermissionRepository {
+	return memoryUserPermissions{r: r}
+}
+
+func (r *memoryRepository) NewBannedDeviceRepository() repository.BannedDeviceRepository {
+	return memoryBannedDevices{r: r}
+}
+
+func (r *memoryRepository) NewBannedUserRepository() repository.BannedUserRepository {
+	return memoryBannedUsers{r: r}
+}
+
+func (r *memoryRepository) NewOrderRepository() repository.OrderRepository {
+	return memoryOrders{r: r}
+}
+
+func (r *memoryRepository) NewUnionOrderAndOrderedItemRepository() repository.UnionOrderAndOrderedItemRepository {
+	return memoryOrderItems{r: r}
+}
+
+func (r *memoryRepository) NewOrderedRepository() repository.OrderedRepository {
+	return memoryOrderedItems{r: r}
+}
+
+func (r *memoryRepository) NewOrderLifecycleRepository() repository.OrderLifecycleRepository {
+	return memoryOrderLifecycles{r: r}
+}
+
+func (r *memoryRepository) NewItemRepository() repository.ItemRepository {
+	return memoryItems{r: r}
+}
+
+func (r *memoryRepository) NewOutboxRepository() repository.OutboxRepository {
+	return memoryOutbox{r: r}
+}
+
+func newTestId() *uuid.UUID {
+	id := uuid.New()
+	return &id
+}
+
+type memoryUsers struct {
+	repository.UserRepository
+	r *memoryRepository
+}
+
+func (m memoryUsers) GetUser(ctx context.Context, tx *gorm.DB, where *entity.User) (*entity.User, error) {
+	for _, user := range m.r.users {
+		if (where.ID == nil || *where.ID == *user.ID) && (where.Email == "" || where.Email == user.Email) {
+			res := *user
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+type memoryUserConfigurations struct {
+	repository.UserConfigurationRepository
+}
+
+func (memoryUserConfigurations) GetUserConfiguration(ctx context.Context, tx *gorm.DB, where *entity.UserConfiguration) (*entity.UserConfiguration, error) {
+	return nil, apperror.ErrNotFound
+}
+
+type memoryDevices struct {
+	repository.DeviceRepository
+	r *memoryRepository
+}
+
+func (m memoryDevices) GetDevice(ctx context.Context, tx *gorm.DB, where *entity.Device) (*entity.Device, error) {
+	for _, device := range m.r.devices {
+		if device.DeviceIdentifier == where.DeviceIdentifier {
+			res := *device
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+func (m memoryDevices) CreateDevice(ctx context.Context, tx *gorm.DB, data *entity.Device) (*entity.Device, error) {
+	device := *data
+	device.ID = newTestId()
+	m.r.devices[*device.ID] = &device
+	res := device
+	return &res, nil
+}
+
+func (m memoryDevices) UpdateDevice(ctx context.Context, tx *gorm.DB, where *entity.Device, data *entity.Device) (*entity.Device, error) {
+	device, err := m.GetDevice(ctx, tx, where)
+	if err != nil {
+		return nil, err
+	}
+	device.Platform, device.SystemVersion, device.FirebaseCloudMessagingId, device.Model = data.Platform, data.SystemVersion, data.FirebaseCloudMessagingId, data.Model
+	m.r.devices[*device.ID] = device
+	return device, nil
+}
+
+func (m memoryDevices) ListDeviceInIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.Device, error) {
+	res := []entity.Device{}
+	for _, device := range m.r.devices {
+		for _, id := range ids {
+			if *device.ID == id {
+				res = append(res, *device)
+				break
+			}
+		}
+	}
+	return &res, nil
+}
+
+type memoryRefreshTokens struct {
+	repository.RefreshTokenRepository
+	r *memoryRepository
+}
+
+func (m memoryRefreshTokens) GetRefreshTokenForUpdate(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken) (*entity.RefreshToken, error) {
+	refreshToken, ok := m.r.refreshTokens[*where.ID]
+	if !ok {
+		return nil, apperror.ErrNotFound
+	}
+	res := *refreshToken
+	return &res, nil
+}
+
+func (m memoryRefreshTokens) UpdateRefreshToken(ctx context.Context, tx *gorm.DB, where *entity.RefreshToken, data *entity.RefreshToken) (*entity.RefreshToken, error) {
+	refreshToken, ok := m.r.refreshTokens[*where.ID]
+	if !ok {
+		return nil, apperror.ErrNotFound
+	}
+	if data.FamilyId != nil {
+		refreshToken.FamilyId = data.FamilyId
+	}
+	if data.UsedTime != nil {
+		refreshToken.UsedTime = data.UsedTime
+	}
+	res := *refreshToken
+	return &res, nil
+}
+
+func (m memoryRefreshTokens) CreateRefreshToken(ctx context.Context, tx *gorm.DB, data *entity.RefreshToken) (*entity.RefreshToken, error) {
+	refreshToken := *data
+	refreshToken.BeforeCreate(nil)
+	m.r.refreshTokens[*refreshToken.ID] = &refreshToken
+	res := refreshToken
+	return &res, nil
+}
+
+func (m memoryRefreshTokens) DeleteRefreshTokenFamily(ctx context.Context, tx *gorm.DB, familyId *uuid.UUID) (*[]entity.RefreshToken, error) {
+	res := []entity.RefreshToken{}
+	for id, refreshToken := range m.r.refreshTokens {
+		if id == *familyId || (refreshToken.FamilyId != nil && *refreshToken.FamilyId == *familyId) {
+			res = append(res, *refreshToken)
+			delete(m.r.refreshTokens, id)
+		}
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
+
+type memoryAuthorizationTokens struct {
+	repository.AuthorizationTokenRepository
+	r *memoryRepository
+}
+
+func (m memoryAuthorizationTokens) GetAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken) (*entity.AuthorizationToken, error) {
+	authorizationToken, ok := m.r.authorizationTokens[*where.ID]
+	if !ok {
+		return nil, apperror.ErrNotFound
+	}
+	res := *authorizationToken
+	return &res, nil
+}
+
+func (m memoryAuthorizationTokens) CreateAuthorizationToken(ctx context.Context, tx *gorm.DB, data *entity.AuthorizationToken) (*entity.AuthorizationToken, error) {
+	authorizationToken := *data
+	authorizationToken.ID = newTestId()
+	m.r.authorizationTokens[*authorizationToken.ID] = &authorizationToken
+	res := authorizationToken
+	return &res, nil
+}
+
+func (m memoryAuthorizationTokens) ListAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken) (*[]entity.AuthorizationToken, error) {
+	res := []entity.AuthorizationToken{}
+	for _, authorizationToken := range m.r.authorizationTokens {
+		if (where.UserId == nil || *where.UserId == *authorizationToken.UserId) && (where.DeviceId == nil || *where.DeviceId == *authorizationToken.DeviceId) {
+			res = append(res, *authorizationToken)
+		}
+	}
+	return &res, nil
+}
+
+func (m memoryAuthorizationTokens) DeleteAuthorizationToken(ctx context.Context, tx *gorm.DB, where *entity.AuthorizationToken, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error) {
+	if where != nil {
+		return m.DeleteAuthorizationTokenByRefreshTokenIds(ctx, tx, &[]uuid.UUID{*where.RefreshTokenId})
+	}
+	res := []entity.AuthorizationToken{}
+	for _, id := range *ids {
+		if authorizationToken, ok := m.r.authorizationTokens[id]; ok {
+			res = append(res, *authorizationToken)
+			delete(m.r.authorizationTokens, id)
+		}
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
+
+func (m memoryAuthorizationTokens) DeleteAuthorizationTokenByRefreshTokenIds(ctx context.Context, tx *gorm.DB, ids *[]uuid.UUID) (*[]entity.AuthorizationToken, error) {
+	res := []entity.AuthorizationToken{}
+	for id, authorizationToken := range m.r.authorizationTokens {
+		for _, refreshTokenId := range *ids {
+			if *authorizationToken.RefreshTokenId == refreshTokenId {
+				res = append(res, *authorizationToken)
+				delete(m.r.authorizationTokens, id)
+			}
+		}
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
+
+func (m memoryAuthorizationTokens) TouchAuthorizationToken(ctx context.Context, tx *gorm.DB, id *uuid.UUID, ip string) error {
+	now := time.Now().UTC()
+	m.r.authorizationTokens[*id].LastSeenTime = &now
+	m.r.authorizationTokens[*id].LastSeenIp = ip
+	return nil
+}
+
+// memoryApplications accepts every access token.
+type memoryApplications struct {
+	repository.ApplicationRepository
+}
+
+func (memoryApplications) CheckApplication(ctx context.Context, tx *gorm.DB, accessToken string) (*entity.Application, error) {
+	return &entity.Application{ID: newTestId(), Name: "mool", Version: "1.0.0", MaxSessions: 1}, nil
+}
+
+type memoryUserPermissions struct {
+	repository.UserPermissionRepository
+	r *memoryRepository
+}
+
+func (m memoryUserPermissions) GetUserPermission(ctx context.Context, tx *gorm.DB, where *entity.UserPermission) (*entity.UserPermission, error) {
+	for _, permission := range m.r.permissions {
+		if *permission.UserId == *where.UserId && permission.Name == where.Name {
+			res := permission
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+type memoryBannedDevices struct {
+	repository.BannedDeviceRepository
+	r *memoryRepository
+}
+
+func (m memoryBannedDevices) CreateBannedDevice(ctx context.Context, tx *gorm.DB, data *entity.BannedDevice) (*entity.BannedDevice, error) {
+	ban := *data
+	ban.BeforeCreate(nil)
+	m.r.bannedDevices[*ban.ID] = &ban
+	res := ban
+	return &res, nil
+}
+
+func (m memoryBannedDevices) GetActiveBannedDevice(ctx context.Context, tx *gorm.DB, deviceIdentifier string) (*entity.BannedDevice, error) {
+	for _, ban := range m.r.bannedDevices {
+		if ban.DeviceIdentifier == deviceIdentifier && (ban.ExpirationTime == nil || ban.ExpirationTime.After(time.Now())) {
+			res := *ban
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+func (m memoryBannedDevices) DeleteBannedDevice(ctx context.Context, tx *gorm.DB, where *entity.BannedDevice) (*[]entity.BannedDevice, error) {
+	res := []entity.BannedDevice{}
+	for id, ban := range m.r.bannedDevices {
+		if ban.DeviceIdentifier == where.DeviceIdentifier {
+			res = append(res, *ban)
+			delete(m.r.bannedDevices, id)
+		}
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
+
+type memoryBannedUsers struct {
+	repository.BannedUserRepository
+	r *memoryRepository
+}
+
+func (m memoryBannedUsers) CreateBannedUser(ctx context.Context, tx *gorm.DB, data *entity.BannedUser) (*entity.BannedUser, error) {
+	ban := *data
+	ban.BeforeCreate(nil)
+	m.r.bannedUsers[*ban.ID] = &ban
+	res := ban
+	return &res, nil
+}
+
+func (m memoryBannedUsers) GetActiveBannedUser(ctx context.Context, tx *gorm.DB, userId *uuid.UUID) (*entity.BannedUser, error) {
+	for _, ban := range m.r.bannedUsers {
+		if *ban.UserId == *userId && (ban.ExpirationTime == nil || ban.ExpirationTime.After(time.Now())) {
+			res := *ban
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+func (m memoryBannedUsers) DeleteBannedUser(ctx context.Context, tx *gorm.DB, where *entity.BannedUser) (*[]entity.BannedUser, error) {
+	res := []entity.BannedUser{}
+	for id, ban := range m.r.bannedUsers {
+		if *ban.UserId == *where.UserId {
+			res = append(res, *ban)
+			delete(m.r.bannedUsers, id)
+		}
+	}
+	if len(res) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+	return &res, nil
+}
+
+type memoryVerificationCodes struct {
+	repository.VerificationCodeRepository
+	r *memoryRepository
+}
+
+func (m memoryVerificationCodes) CreateVerificationCode(ctx context.Context, tx *gorm.DB, data *entity.VerificationCode) (*entity.VerificationCode, error) {
+	verificationCode := *data
+	verificationCode.ID = newTestId()
+	m.r.verificationCodes[*verificationCode.ID] = &verificationCode
+	res := verificationCode
+	return &res, nil
+}
+
+func (m memoryVerificationCodes) GetVerificationCodeForUpdate(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode) (*entity.VerificationCode, error) {
+	for _, verificationCode := range m.r.verificationCodes {
+		if verificationCode.Email == where.Email && verificationCode.Type == where.Type && verificationCode.DeviceIdentifier == where.DeviceIdentifier {
+			res := *verificationCode
+			return &res, nil
+		}
+	}
+	return nil, apperror.ErrNotFound
+}
+
+func (m memoryVerificationCodes) UpdateVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, data *entity.VerificationCode) (*entity.VerificationCode, error) {
+	verificationCode, ok := m.r.verificationCodes[*where.ID]
+	if !ok {
+		return nil, apperror.ErrNotFound
+	}
+	verificationCode.Attempts = data.Attempts
+	res := *verificationCode
+	return &res, nil
+}
+
+func (m memoryVerificationCodes) DeleteVerificationCode(ctx context.Context, tx *gorm.DB, where *entity.VerificationCode, ids *[]uuid.UUID) (*[]entity.VerificationCode, error) {
+	verificationCode, ok := m.r.verificationCodes[*where.ID]
+	if !ok {
+		return nil, apperror.ErrNotFound
+	}
+	delete(m.r.verificationCodes, *where.ID)
+	return &[]entity.VerificationCode{*verificationCode}, nil
+}
+
+func (m memoryVerificationCodes) LockVerificationCode(ctx context.Context, email string, deviceIdentifier string, lockout time.Duration) error {
+	m.r.lockouts[email+"/"+deviceIdentifier] = time.Now().Add(lockout)
+	return nil
+}
+
+func (m memoryVerificationCodes) GetVerificationCodeLockout(ctx context.Context, email string, deviceIdentifier string) (time.Duration, error) {
+	if until, ok := m.r.lockouts[email+"/"+deviceIdentifier]; ok && time.Now().Before(until) {
+		return time.Until(until), nil
+	}
+	return 0, nil
+}
+
+type memoryOrders struct {
+	repository.OrderRepository
+	r *memoryRepository
+}
+
+// UpdateOrder applies the status guard of the where like the datasource.
+func (m memoryOrders) UpdateOrder(tx *gorm.DB, where *entity.Order, data *entity.Order) (*entity.Order, error) {
+	order, ok := m.r.orders[*where.ID]
+	if !ok || (where.Status != "" && where.Status != order.Status) {
+		return nil, apperror.ErrNotFound
+	}
+	order.Status, order.CancelReasons, order.UpdateTime = data.Status, data.CancelReasons, time.Now().UTC()
+	res := *order
+	return &res, nil
+}
+
+type memoryOrderItems struct {
+	repository.UnionOrderAndOrderedItemRepository
+	r *memoryRepository
+}
+
+func (m memoryOrderItems) ListUnionOrderAndOrderedItem(tx *gorm.DB, where *entity.UnionOrderAndOrderedItem) (*[]entity.UnionOrderAndOrderedItem, error) {
+	res := []entity.UnionOrderAndOrderedItem{}
+	for _, item := range m.r.orderItems {
+		if *item.OrderId == *where.OrderId {
+			res = append(res, item)
+		}
+	}
+	return &res, nil
+}
+
+type memoryOrderedItems struct {
+	repository.OrderedRepository
+	r *memoryRepository
+}
+
+func (m memoryOrderedItems) ListOrderedItemByIds(tx *gorm.DB, ids []uuid.UUID) (*[]entity.OrderedItem, error) {
+	res := []entity.OrderedItem{}
+	for _, id := range ids {
+		if item, ok := m.r.orderedItems[id]; ok {
+			res = append(res, *item)
+		}
+	}
+	return &res, nil
+}
+
+type memoryOrderLifecycles struct {
+	repository.OrderLifecycleRepository
+	r *memoryRepository
+}
+
+func (m memoryOrderLifecycles) CreateOrderLifecycle(tx *gorm.DB, data *entity.OrderLifecycle) (*entity.OrderLifecycle, error) {
+	orderLifecycle := *data
+	orderLifecycle.ID = newTestId()
+	m.r.orderLifecycles = append(m.r.orderLifecycles, orderLifecycle)
+	return &orderLifecycle, nil
+}
+
+type memoryItems struct {
+	repository.ItemRepository
+	r *memoryRepository
+}
+
+func (m memoryItems) RestockItems(ctx context.Context, tx *gorm.DB, quantities map[uuid.UUID]int64) error {
+	for id, quantity := range quantities {
+		if item, ok := m.r.items[id]; ok {
+			item.Restock(quantity)
+		}
+	}
+	return nil
+}
+
+type memoryOutbox struct {
+	repository.OutboxRepository
+	r *memoryRepository
+}
+
+func (m memoryOutbox) CreateOutbox(tx *gorm.DB, data *entity.Outbox) (*entity.Outbox, error) {
+	m.r.outbox = append(m.r.outbox, *data)
+	return data, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/notification"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// userLocale returns the language chosen by the user for the notifications.
+func userLocale(ctx context.Context, tx *gorm.DB, dao repository.Repository, userId *uuid.UUID) (string, error) {
+	configuration, err := dao.NewUserConfigurationRepository().GetUserConfiguration(ctx, tx, &entity.UserConfiguration{UserId: userId})
+	if apperror.IsNotFound(err) {
+		return notification.DefaultLocale, nil
+	} else if err != nil {
+		return "", err
+	}
+	return configurationLocale(configuration), nil
+}
+
+func configurationLocale(configuration *entity.UserConfiguration) string {
+	if configuration.Language == "" {
+		return notification.DefaultLocale
+	}
+	return configuration.Language
+}
+
+// userDeviceTokens returns the push registration tokens of the devices where
+// the user has a session.
+func userDeviceTokens(ctx context.Context, tx *gorm.DB, dao repository.Repository, userId *uuid.UUID) ([]string, error) {
+	authorizationTokens, err := dao.NewAuthorizationTokenRepository().ListAuthorizationToken(ctx, tx, &entity.AuthorizationToken{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	if len(*authorizationTokens) == 0 {
+		return nil, nil
+	}
+	deviceIds := make([]uuid.UUID, 0, len(*authorizationTokens))
+	for _, item := range *authorizationTokens {
+		deviceIds = append(deviceIds, *item.DeviceId)
+	}
+	devices, err := dao.NewDeviceRepository().ListDeviceInIds(tx, deviceIds)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(*devices))
+	for _, item := range *devices {
+		if item.FirebaseCloudMessagingId != "" {
+			tokens = append(tokens, item.FirebaseCloudMessagingId)
+		}
+	}
+	return tokens, nil
+}
+
+// enqueueOrderPush queues the message as a push notification to every device
+// where the user has a session, in the language of the user. Nothing is queued
+// when the user turned the order notifications off.
+func enqueueOrderPush(ctx context.Context, tx *gorm.DB, dao repository.Repository, userId *uuid.UUID, message notification.Message) error {
+	message.Locale = notification.DefaultLocale
+	configuration, err := dao.NewUserConfigurationRepository().GetUserConfiguration(ctx, tx, &entity.UserConfiguration{UserId: userId})
+	if err != nil && !apperror.IsNotFound(err) {
+		return err
+	} else if configuration != nil {
+		if configuration.OrderNotifications != nil && !*configuration.OrderNotifications {
+			return nil
+		}
+		message.Locale = configurationLocale(configuration)
+	}
+	tokens, err := userDeviceTokens(ctx, tx, dao, userId)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		message.To = token
+		err = enqueueOutbox(tx, dao, entity.OutboxChannelPush, &message)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
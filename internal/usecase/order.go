@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"strconv"
 
 	"time"
 
@@ -10,13 +10,17 @@ import (
 	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/pkg/notification"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
 	"github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/ewkb"
+	"google.golang.org/grpc/codes"
 	gp "google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
@@ -30,8 +34,19 @@ type OrderService interface {
 	UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest, md *utils.ClientMetadata) (*pb.Order, error)
 	ListOrderedItemWithItem(ctx context.Context, req *pb.ListOrderedItemRequest, md *utils.ClientMetadata) (*pb.ListOrderedItemResponse, error)
 	CancelOrder(ctx context.Context, req *pb.CancelOrderRequest, md *utils.ClientMetadata) (*gp.Empty, error)
+	ExpireOrders(ctx context.Context) (int, error)
+	WatchOrder(ctx context.Context, req *pb.WatchOrderRequest, md *utils.ClientMetadata, send func(*pb.OrderEvent) error) error
+	ListBusinessOrder(ctx context.Context, req *pb.ListBusinessOrderRequest, md *utils.ClientMetadata) (*pb.ListBusinessOrderResponse, error)
+	GetBusinessOrder(ctx context.Context, req *pb.GetBusinessOrderRequest, md *utils.ClientMetadata) (*pb.Order, error)
+	UpdateBusinessOrder(ctx context.Context, req *pb.UpdateBusinessOrderRequest, md *utils.ClientMetadata) (*pb.Order, error)
 }
 
+const expireOrdersBatchSize = 100
+
+// errOrderStatusChanged is returned when another request changed the status
+// of the order after it was read.
+var errOrderStatusChanged = apperror.FailedPrecondition("the order status changed, try again").WithReason("ORDER_STATUS_CHANGED").WithDomain("order")
+
 type orderService struct {
 	dao    repository.Repository
 	config *config.Config
@@ -43,39 +58,24 @@ func NewOrderService(dao repository.Repository, sqldb *sqldb.Sql, config *config
 }
 
 func (i *orderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
+	var orderLifecycleRes *entity.OrderLifecycle
 	id := uuid.MustParse(req.Id)
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
+		orderRes, err := i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: &id})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("order not found")
 		} else if err != nil {
 			return err
 		}
-		updateOrderRes, err := i.dao.NewOrderRepository().UpdateOrder(tx, &entity.Order{ID: &id}, &entity.Order{Status: pb.OrderStatusType_OrderStatusTypeCancelled.String(), CancelReasons: req.CancelReasons})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("order not found")
-		} else if err != nil {
+		actors, err := i.orderActors(ctx, tx, principal.AuthorizationToken.UserId, orderRes)
+		if err != nil {
 			return err
 		}
-		_, err = i.dao.NewOrderLifecycleRepository().CreateOrderLifecycle(tx, &entity.OrderLifecycle{Status: pb.OrderStatusType_OrderStatusTypeCancelled.String(), OrderId: &id, CreateTime: updateOrderRes.UpdateTime})
+		_, orderLifecycleRes, err = i.changeOrderStatus(ctx, tx, orderRes, pb.OrderStatusType_OrderStatusTypeCancelled, req.CancelReasons, actors...)
 		if err != nil {
 			return err
 		}
@@ -84,40 +84,273 @@ func (i *orderService) CancelOrder(ctx context.Context, req *pb.CancelOrderReque
 	if err != nil {
 		return nil, err
 	}
+	i.publishOrderLifecycles(ctx, orderLifecycleRes)
 	return &gp.Empty{}, nil
 }
 
-func (i *orderService) GetCheckoutInfo(ctx context.Context, req *pb.GetCheckoutInfoRequest, md *utils.ClientMetadata) (*pb.GetCheckoutInfoResponse, error) {
-	var res pb.GetCheckoutInfoResponse
+// WatchOrder sends the lifecycle of the order and then every status change
+// until the order reaches a final status or the context is done.
+func (i *orderService) WatchOrder(ctx context.Context, req *pb.WatchOrderRequest, md *utils.ClientMetadata, send func(*pb.OrderEvent) error) error {
+	var orderRes *entity.Order
+	id := uuid.MustParse(req.OrderId)
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		orderRes, err = i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: &id})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("order not found")
+		} else if err != nil {
+			return err
+		}
+		_, err = i.orderActors(ctx, tx, principal.AuthorizationToken.UserId, orderRes)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Subscribe before reading the history so no change is lost in between,
+	// the changes already sent as history are skipped.
+	subscription, err := i.dao.NewOrderLifecycleRepository().SubscribeOrderLifecycle(ctx, &id)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+	orderLifecyclesRes, err := i.dao.NewOrderLifecycleRepository().ListOrderLifecycle(i.sqldb.Gorm.WithContext(ctx), &entity.OrderLifecycle{OrderId: &id})
+	if err != nil {
+		return err
+	}
+	sent := make(map[uuid.UUID]bool, len(*orderLifecyclesRes))
+	status := *utils.ParseOrderStatusType(&orderRes.Status)
+	for _, item := range *orderLifecyclesRes {
+		err = send(orderEvent(&item))
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
+			return err
+		}
+		sent[*item.ID] = true
+		status = *utils.ParseOrderStatusType(&item.Status)
+	}
+	for !OrderStatusIsFinal(status) {
+		select {
+		case <-ctx.Done():
+			return nil
+		case item, ok := <-subscription.C:
+			if !ok {
+				return apperror.New(codes.Unavailable, "order lifecycle subscription closed")
+			}
+			if sent[*item.ID] {
+				continue
+			}
+			err = send(orderEvent(item))
+			if err != nil {
 				return err
 			}
+			status = *utils.ParseOrderStatusType(&item.Status)
 		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
+	}
+	return nil
+}
+
+func orderEvent(item *entity.OrderLifecycle) *pb.OrderEvent {
+	return &pb.OrderEvent{Id: item.ID.String(), OrderId: item.OrderId.String(), Status: *utils.ParseOrderStatusType(&item.Status), CreateTime: timestamppb.New(item.CreateTime)}
+}
+
+// ExpireOrders moves the pending orders whose order window has passed to
+// expired, in batches, and returns how many orders were expired.
+func (i *orderService) ExpireOrders(ctx context.Context) (int, error) {
+	var expired int
+	for {
+		var batch int
+		var orderLifecycles []*entity.OrderLifecycle
+		err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+			ordersRes, err := i.dao.NewOrderRepository().ListExpiredOrder(tx, time.Now().UTC(), expireOrdersBatchSize)
+			if err != nil {
+				return err
+			}
+			for index := range *ordersRes {
+				_, orderLifecycleRes, err := i.changeOrderStatus(ctx, tx, &(*ordersRes)[index], pb.OrderStatusType_OrderStatusTypeExpired, "", OrderActorSystem)
+				if err == errOrderStatusChanged {
+					// The customer or the business moved it meanwhile
+					continue
+				} else if err != nil {
+					return err
+				}
+				orderLifecycles = append(orderLifecycles, orderLifecycleRes)
+			}
+			batch = len(*ordersRes)
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+		i.publishOrderLifecycles(ctx, orderLifecycles...)
+		expired += len(orderLifecycles)
+		if batch < expireOrdersBatchSize || ctx.Err() != nil {
+			return expired, ctx.Err()
+		}
+	}
+}
+
+// orderActors returns the roles the user plays on the order. It fails with
+// "permission denied" when the user is neither the customer nor has an order
+// permission on the business of the order.
+func (i *orderService) orderActors(ctx context.Context, tx *gorm.DB, userId *uuid.UUID, order *entity.Order) ([]OrderActor, error) {
+	actors, err := i.businessOrderActors(ctx, tx, userId, order.BusinessId)
+	if err != nil {
+		return nil, err
+	}
+	if *order.UserId == *userId {
+		actors = append(actors, OrderActorCustomer)
+	}
+	if len(actors) == 0 {
+		return nil, apperror.ErrPermissionDenied
+	}
+	return actors, nil
+}
+
+// changeOrderStatus moves the order to the status if the lifecycle allows it
+// for any of the actors, gives the stock back when the order won't be
+// fulfilled and records the change in the order lifecycle.
+func (i *orderService) changeOrderStatus(ctx context.Context, tx *gorm.DB, order *entity.Order, status pb.OrderStatusType, cancelReasons string, actors ...OrderActor) (*entity.Order, *entity.OrderLifecycle, error) {
+	err := CheckOrderTransition(*utils.ParseOrderStatusType(&order.Status), status, *utils.ParseOrderType(&order.OrderType), actors...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !OrderStatusReleasesStock(status) {
+		cancelReasons = ""
+	}
+	// The update only applies to the status the transition was checked from,
+	// so concurrent transitions can't both release the stock.
+	updateOrderRes, err := i.dao.NewOrderRepository().UpdateOrder(tx, &entity.Order{ID: order.ID, Status: order.Status}, &entity.Order{Status: status.String(), CancelReasons: cancelReasons})
+	if apperror.IsNotFound(err) {
+		return nil, nil, errOrderStatusChanged
+	} else if err != nil {
+		return nil, nil, err
+	}
+	if OrderStatusReleasesStock(status) {
+		err = i.releaseOrderStock(ctx, tx, order.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	orderLifecycleRes, err := i.dao.NewOrderLifecycleRepository().CreateOrderLifecycle(tx, &entity.OrderLifecycle{Status: status.String(), OrderId: order.ID, CreateTime: updateOrderRes.UpdateTime})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, actor := range actors {
+		if actor == OrderActorCustomer {
+			return updateOrderRes, orderLifecycleRes, nil
+		}
+	}
+	err = i.notifyOrderStatus(ctx, tx, order, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updateOrderRes, orderLifecycleRes, nil
+}
+
+// notifyOrderStatus queues a push notification about the new status of the
+// order to the devices of the customer.
+func (i *orderService) notifyOrderStatus(ctx context.Context, tx *gorm.DB, order *entity.Order, status pb.OrderStatusType) error {
+	return enqueueOrderPush(ctx, tx, i.dao, order.UserId, notification.Message{
+		Event: notification.EventOrderStatusChanged,
+		Data: map[string]string{
+			"shortId":      order.ShortId,
+			"businessName": order.BusinessName,
+			"status":       status.String(),
+			"appName":      i.config.AppName,
+		},
+		Payload: map[string]string{
+			"res_id": order.ID.String(),
+			"res":    "order",
+			"verb":   "update",
+		},
+	})
+}
+
+// notifyOrderCreated queues a push notification about a new order to the
+// devices of the users that manage the orders of the business.
+func (i *orderService) notifyOrderCreated(ctx context.Context, tx *gorm.DB, order *entity.Order) error {
+	permissions, err := i.dao.NewUserPermissionRepository().ListUserPermission(ctx, tx, &entity.UserPermission{Name: updateOrderPermission, BusinessId: order.BusinessId})
+	if err != nil {
+		return err
+	}
+	notified := make(map[uuid.UUID]bool, len(*permissions))
+	for _, item := range *permissions {
+		if notified[*item.UserId] {
+			continue
+		}
+		notified[*item.UserId] = true
+		err = enqueueOrderPush(ctx, tx, i.dao, item.UserId, notification.Message{
+			Event: notification.EventOrderCreated,
+			Data: map[string]string{
+				"shortId":       order.ShortId,
+				"businessName":  order.BusinessName,
+				"itemsQuantity": strconv.Itoa(int(order.ItemsQuantity)),
+				"priceCup":      order.PriceCup,
+				"appName":       i.config.AppName,
+			},
+			Payload: map[string]string{
+				"res_id": order.ID.String(),
+				"res":    "order",
+				"verb":   "create",
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishOrderLifecycles notifies the watchers of the orders about committed
+// status changes. A failure here doesn't undo the change, so it's only logged.
+func (i *orderService) publishOrderLifecycles(ctx context.Context, lifecycles ...*entity.OrderLifecycle) {
+	for _, item := range lifecycles {
+		err := i.dao.NewOrderLifecycleRepository().PublishOrderLifecycle(ctx, item)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// releaseOrderStock adds the quantities of the ordered items back to the
+// availability of the items.
+func (i *orderService) releaseOrderStock(ctx context.Context, tx *gorm.DB, orderId *uuid.UUID) error {
+	unionOrderAndOrderedItemRes, err := i.dao.NewUnionOrderAndOrderedItemRepository().ListUnionOrderAndOrderedItem(tx, &entity.UnionOrderAndOrderedItem{OrderId: orderId})
+	if err != nil {
+		return err
+	}
+	orderedItemFks := make([]uuid.UUID, 0, len(*unionOrderAndOrderedItemRes))
+	for _, item := range *unionOrderAndOrderedItemRes {
+		orderedItemFks = append(orderedItemFks, *item.OrderedItemId)
+	}
+	orderedItemsRes, err := i.dao.NewOrderedRepository().ListOrderedItemByIds(tx, orderedItemFks)
+	if err != nil {
+		return err
+	}
+	quantities := make(map[uuid.UUID]int64, len(*orderedItemsRes))
+	for _, item := range *orderedItemsRes {
+		quantities[*item.ItemId] += int64(item.Quantity)
+	}
+	return i.dao.NewItemRepository().RestockItems(ctx, tx, quantities)
+}
+
+func (i *orderService) GetCheckoutInfo(ctx context.Context, req *pb.GetCheckoutInfoRequest, md *utils.ClientMetadata) (*pb.GetCheckoutInfoResponse, error) {
+	var res pb.GetCheckoutInfoResponse
+	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		_, err := principalFromContext(ctx)
+		if err != nil {
 			return err
 		}
 		businessId := uuid.MustParse(req.BusinessId)
 		businessRes, err := i.dao.NewBusinessRepository().GetBusiness(tx, &entity.Business{ID: &businessId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business not found")
 		} else if err != nil {
 			return err
 		}
@@ -164,37 +397,7 @@ func (i *orderService) GetCheckoutInfo(ctx context.Context, req *pb.GetCheckoutI
 			TimeMarginOrderMinute:  businessRes.TimeMarginOrderMinute,
 			IsInRange:              *businessIsInRange,
 			Distance:               businessDistance.Distance,
-			BusinessSchedule: &pb.BusinessSchedule{
-				Id:                         schedule.ID.String(),
-				FirstOpeningTimeSunday:     timestamppb.New(schedule.FirstOpeningTimeSunday),
-				FirstClosingTimeSunday:     timestamppb.New(schedule.FirstClosingTimeSunday),
-				FirstOpeningTimeMonday:     timestamppb.New(schedule.FirstOpeningTimeMonday),
-				FirstClosingTimeMonday:     timestamppb.New(schedule.FirstClosingTimeMonday),
-				FirstOpeningTimeTuesday:    timestamppb.New(schedule.FirstOpeningTimeTuesday),
-				FirstClosingTimeTuesday:    timestamppb.New(schedule.FirstClosingTimeTuesday),
-				FirstOpeningTimeWednesday:  timestamppb.New(schedule.FirstOpeningTimeWednesday),
-				FirstClosingTimeWednesday:  timestamppb.New(schedule.FirstClosingTimeWednesday),
-				FirstOpeningTimeThursday:   timestamppb.New(schedule.FirstOpeningTimeThursday),
-				FirstClosingTimeThursday:   timestamppb.New(schedule.FirstClosingTimeThursday),
-				FirstOpeningTimeFriday:     timestamppb.New(schedule.FirstOpeningTimeFriday),
-				FirstClosingTimeFriday:     timestamppb.New(schedule.FirstClosingTimeFriday),
-				FirstOpeningTimeSaturday:   timestamppb.New(schedule.FirstOpeningTimeSaturday),
-				FirstClosingTimeSaturday:   timestamppb.New(schedule.FirstClosingTimeSaturday),
-				SecondOpeningTimeSunday:    timestamppb.New(schedule.SecondOpeningTimeSunday),
-				SecondClosingTimeSunday:    timestamppb.New(schedule.SecondClosingTimeSunday),
-				SecondOpeningTimeMonday:    timestamppb.New(schedule.SecondOpeningTimeMonday),
-				SecondClosingTimeMonday:    timestamppb.New(schedule.SecondClosingTimeMonday),
-				SecondOpeningTimeTuesday:   timestamppb.New(schedule.SecondOpeningTimeTuesday),
-				SecondClosingTimeTuesday:   timestamppb.New(schedule.SecondClosingTimeTuesday),
-				SecondOpeningTimeWednesday: timestamppb.New(schedule.SecondOpeningTimeWednesday),
-				SecondClosingTimeWednesday: timestamppb.New(schedule.SecondClosingTimeWednesday),
-				SecondOpeningTimeThursday:  timestamppb.New(schedule.SecondOpeningTimeThursday),
-				SecondClosingTimeThursday:  timestamppb.New(schedule.SecondClosingTimeThursday),
-				SecondOpeningTimeFriday:    timestamppb.New(schedule.SecondOpeningTimeFriday),
-				SecondClosingTimeFriday:    timestamppb.New(schedule.SecondClosingTimeFriday),
-				SecondOpeningTimeSaturday:  timestamppb.New(schedule.SecondOpeningTimeSaturday),
-				SecondClosingTimeSaturday:  timestamppb.New(schedule.SecondClosingTimeSaturday),
-			},
+			BusinessSchedule:       businessScheduleResponse(schedule, time.Now()),
 		}
 		return nil
 	})
@@ -207,34 +410,14 @@ func (i *orderService) GetCheckoutInfo(ctx context.Context, req *pb.GetCheckoutI
 func (i *orderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest, md *utils.ClientMetadata) (*pb.Order, error) {
 	var res pb.Order
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		id := uuid.MustParse(req.Id)
-		order, err := i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: &id, UserId: authorizationTokenRes.UserId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("order not found")
+		order, err := i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: &id, UserId: principal.AuthorizationToken.UserId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("order not found")
 		} else if err != nil {
 			return err
 		}
@@ -302,30 +485,10 @@ func (i *orderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest, md
 func (i *orderService) ListOrderedItemWithItem(ctx context.Context, req *pb.ListOrderedItemRequest, md *utils.ClientMetadata) (*pb.ListOrderedItemResponse, error) {
 	var res pb.ListOrderedItemResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		_, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		orderId := uuid.MustParse(req.OrderId)
 		unionOrderAndOrderedItemRes, err := i.dao.NewUnionOrderAndOrderedItemRepository().ListUnionOrderAndOrderedItem(tx, &entity.UnionOrderAndOrderedItem{OrderId: &orderId})
 		if err != nil {
@@ -354,109 +517,35 @@ func (i *orderService) ListOrderedItemWithItem(ctx context.Context, req *pb.List
 
 func (i *orderService) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest, md *utils.ClientMetadata) (*pb.Order, error) {
 	var res *pb.Order
+	var orderLifecycleRes *entity.OrderLifecycle
 	id := uuid.MustParse(req.Order.Id)
-	var cancelReasons string
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		orderRes, err := i.dao.NewOrderRepository().GetOrder(tx, &entity.Order{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("order not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("order not found")
 		} else if err != nil {
 			return err
 		}
-		switch req.Order.Status {
-		case pb.OrderStatusType_OrderStatusTypeExpired:
-			return errors.New("status error")
-		case pb.OrderStatusType_OrderStatusTypeRejected:
-			if orderRes.Status != "OrderStatusTypeOrdered" {
-				return errors.New("status error")
-			}
-			unionOrderAndOrderedItemRes, unionOrderAndOrderedItemErr := i.dao.NewUnionOrderAndOrderedItemRepository().ListUnionOrderAndOrderedItem(tx, &entity.UnionOrderAndOrderedItem{OrderId: &id})
-			if unionOrderAndOrderedItemErr != nil {
-				return unionOrderAndOrderedItemErr
-			}
-			orderedItemFks := make([]uuid.UUID, 0, len(*unionOrderAndOrderedItemRes))
-			for _, item := range *unionOrderAndOrderedItemRes {
-				orderedItemFks = append(orderedItemFks, *item.OrderedItemId)
-			}
-			orderedItemsRes, err := i.dao.NewOrderedRepository().ListOrderedItemByIds(tx, orderedItemFks)
-			if err != nil {
-				return err
-			}
-			itemFks := make([]uuid.UUID, 0, len(*orderedItemsRes))
-			for _, item := range *orderedItemsRes {
-				itemFks = append(itemFks, *item.ItemId)
-			}
-			itemsRes, err := i.dao.NewItemRepository().ListItemInIds(ctx, tx, itemFks)
-			if err != nil {
-				return err
-			}
-			for _, item := range *orderedItemsRes {
-				var index = -1
-				for i, n := range *itemsRes {
-					if *n.ID == *item.ItemId {
-						index = i
-					}
-				}
-				(*itemsRes)[index].Availability += int64(item.Quantity)
-			}
-			for _, item := range *itemsRes {
-				_, err := i.dao.NewItemRepository().UpdateItem(ctx, tx, &entity.ItemBusiness{ID: item.ID}, &item)
-				if err != nil {
-					return err
-				}
-			}
-			cancelReasons = req.Order.CancelReasons
-			// case pb.OrderStatusType_OrderStatusTypeApproved:
-			// 	if orderRes.Status != "OrderStatusTypePending" {
-			// 		return errors.New("status error")
-			// 	}
-			// case pb.OrderStatusType_OrderStatusTypeDone:
-			// 	if orderRes.Status != "OrderStatusTypeApproved" {
-			// 		return errors.New("status error")
-			// 	}
-			// case pb.OrderStatusType_OrderStatusTypeReceived:
-			// 	if orderRes.Status != "OrderStatusTypeApproved" && orderRes.Status != "OrderStatusTypeDone" {
-			// 		return errors.New("status error")
-			// 	}
-		}
-		updateOrderRes, err := i.dao.NewOrderRepository().UpdateOrder(tx, &entity.Order{ID: &id}, &entity.Order{Status: req.Order.Status.String(), CancelReasons: cancelReasons})
+		actors, err := i.orderActors(ctx, tx, principal.AuthorizationToken.UserId, orderRes)
 		if err != nil {
 			return err
 		}
-		_, err = i.dao.NewOrderLifecycleRepository().CreateOrderLifecycle(tx, &entity.OrderLifecycle{Status: req.Order.Status.String(), OrderId: &id, CreateTime: updateOrderRes.UpdateTime})
+		updateOrderRes, lifecycleRes, err := i.changeOrderStatus(ctx, tx, orderRes, req.Order.Status, req.Order.CancelReasons, actors...)
 		if err != nil {
 			return err
 		}
+		orderLifecycleRes = lifecycleRes
 		res = &pb.Order{Id: updateOrderRes.ID.String(), DeliveryPriceCup: updateOrderRes.DeliveryPriceCup, BusinessThumbnail: i.config.BusinessAvatarBulkName + "/" + updateOrderRes.BusinessThumbnail, Status: *utils.ParseOrderStatusType(&updateOrderRes.Status), OrderType: *utils.ParseOrderType(&updateOrderRes.OrderType), PriceCup: updateOrderRes.PriceCup, BusinessId: updateOrderRes.BusinessId.String(), UserId: updateOrderRes.UserId.String(), Coordinates: &pb.Point{Latitude: updateOrderRes.Coordinates.FlatCoords()[0], Longitude: updateOrderRes.Coordinates.FlatCoords()[1]}, StartOrderTime: timestamppb.New(updateOrderRes.StartOrderTime), EndOrderTime: timestamppb.New(updateOrderRes.EndOrderTime), CreateTime: timestamppb.New(updateOrderRes.CreateTime), UpdateTime: timestamppb.New(updateOrderRes.UpdateTime), Number: updateOrderRes.Number, Address: updateOrderRes.Address, Instructions: updateOrderRes.Instructions, ShortId: updateOrderRes.ShortId, CancelReasons: updateOrderRes.CancelReasons, BusinessName: updateOrderRes.BusinessName, ItemsQuantity: updateOrderRes.ItemsQuantity}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	i.publishOrderLifecycles(ctx, orderLifecycleRes)
 	return res, nil
 }
 
@@ -469,42 +558,15 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		var coordinates ewkb.Point
 		var number string
 		var address string
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		startOrderTimeWeekday := req.StartOrderTime.AsTime()
-		endOrderTimeWeekday := req.EndOrderTime.AsTime()
-		startOrderTimeWeekdayHour, _, _ := startOrderTimeWeekday.Clock()
-		if startOrderTimeWeekdayHour >= 0 && startOrderTimeWeekdayHour <= 4 {
-			startOrderTimeWeekday = startOrderTimeWeekday.AddDate(0, 0, 1)
-		}
-		weekday := startOrderTimeWeekday.Local().Weekday().String()
-		listCartItemRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId})
+		listCartItemRes, err := i.dao.NewCartItemRepository().ListCartItemAll(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		} else if listCartItemRes == nil || len(*listCartItemRes) == 0 {
-			return errors.New("cart items not found")
+			return apperror.InvalidArgument("cart items not found")
 		}
 		var cartItems []uuid.UUID
 		for _, item := range *listCartItemRes {
@@ -529,8 +591,8 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		if req.UserAddressId != "" {
 			userAddressId = uuid.MustParse(req.UserAddressId)
 			userAddress, err = i.dao.NewUserAddressRepository().GetUserAddress(tx, &entity.UserAddress{ID: &userAddressId})
-			if err != nil && err.Error() == "record not found" {
-				return errors.New("user address not found")
+			if apperror.IsNotFound(err) {
+				return apperror.NotFound("user address not found")
 			} else if err != nil {
 				return err
 			}
@@ -556,7 +618,7 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		// Add 10 minutes for payments.
 		previousTime = previousTime.Add(10 * time.Minute)
 		if previousTime.After(req.EndOrderTime.AsTime()) {
-			return errors.New("not fulfilled the previous time of the business")
+			return apperror.InvalidArgument("not fulfilled the previous time of the business")
 		}
 		// If the order is for delivery, check if the location is in the delivery range of the business
 		// location := userAddress.Coordinates
@@ -566,180 +628,16 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		// 		return err
 		// 	}
 		// 	if !*isInRange {
-		// 		return errors.New("business not in range")
+		// 		return apperror.FailedPrecondition("business not in range")
 		// 	}
 		// }
-		var zeroTime time.Time
-		switch weekday {
-		case "Sunday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeSunday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeSunday.Clock()
-			openingTimeSunday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeSunday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeSunday = closingTimeSunday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeSunday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeSunday) || endOrderTimeWeekday.After(closingTimeSunday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeSunday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeSunday.Clock()
-				secondOpeningTimeSunday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeSunday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeSunday = secondClosingTimeSunday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeSunday) || (endOrderTimeWeekday.After(closingTimeSunday) && startOrderTimeWeekday.Before(secondOpeningTimeSunday))) || (startOrderTimeWeekday.After(secondClosingTimeSunday) || endOrderTimeWeekday.After(secondClosingTimeSunday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Monday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeMonday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeMonday.Clock()
-			openingTimeMonday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeMonday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeMonday = closingTimeMonday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeMonday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeMonday) || endOrderTimeWeekday.After(closingTimeMonday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeMonday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeMonday.Clock()
-				secondOpeningTimeMonday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeMonday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeMonday = secondClosingTimeMonday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeMonday) || (endOrderTimeWeekday.After(closingTimeMonday) && startOrderTimeWeekday.Before(secondOpeningTimeMonday))) || (startOrderTimeWeekday.After(secondClosingTimeMonday) || endOrderTimeWeekday.After(secondClosingTimeMonday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Tuesday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeTuesday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeTuesday.Clock()
-			openingTimeTuesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeTuesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeTuesday = closingTimeTuesday.AddDate(0, 0, 1)
+		// The whole order window must fall in the opening hours of the business
+		if !businessScheduleRes.IsOpenBetween(req.StartOrderTime.AsTime(), req.EndOrderTime.AsTime()) {
+			closedErr := datasource.ErrBusinessClosed
+			if nextOpening, ok := businessScheduleRes.NextOpening(req.StartOrderTime.AsTime()); ok && nextOpening.After(req.StartOrderTime.AsTime()) {
+				closedErr = closedErr.WithMetadata("next_opening_time", nextOpening.UTC().Format(time.RFC3339))
 			}
-			if businessScheduleRes.SecondOpeningTimeTuesday.String() == "" {
-				if startOrderTimeWeekday.Before(openingTimeTuesday) || endOrderTimeWeekday.After(closingTimeTuesday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeTuesday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeTuesday.Clock()
-				secondOpeningTimeTuesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeTuesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeTuesday = secondClosingTimeTuesday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeTuesday) || (endOrderTimeWeekday.After(closingTimeTuesday) && startOrderTimeWeekday.Before(secondOpeningTimeTuesday))) || (startOrderTimeWeekday.After(secondClosingTimeTuesday) || endOrderTimeWeekday.After(secondClosingTimeTuesday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Wednesday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeWednesday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeWednesday.Clock()
-			openingTimeWednesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeWednesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeWednesday = closingTimeWednesday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeWednesday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeWednesday) || endOrderTimeWeekday.After(closingTimeWednesday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeWednesday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeWednesday.Clock()
-				secondOpeningTimeWednesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeWednesday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeWednesday = secondClosingTimeWednesday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeWednesday) || (endOrderTimeWeekday.After(closingTimeWednesday) && startOrderTimeWeekday.Before(secondOpeningTimeWednesday))) || (startOrderTimeWeekday.After(secondClosingTimeWednesday) || endOrderTimeWeekday.After(secondClosingTimeWednesday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Thursday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeThursday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeThursday.Clock()
-			openingTimeThursday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeThursday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeThursday = closingTimeThursday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeThursday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeThursday) || endOrderTimeWeekday.After(closingTimeThursday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeThursday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeThursday.Clock()
-				secondOpeningTimeThursday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeThursday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeThursday = secondClosingTimeThursday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeThursday) || (endOrderTimeWeekday.After(closingTimeThursday) && startOrderTimeWeekday.Before(secondOpeningTimeThursday))) || (startOrderTimeWeekday.After(secondClosingTimeThursday) || endOrderTimeWeekday.After(secondClosingTimeThursday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Friday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeFriday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeFriday.Clock()
-			openingTimeFriday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeFriday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeFriday = closingTimeFriday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeFriday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeFriday) || endOrderTimeWeekday.After(closingTimeFriday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.SecondOpeningTimeFriday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.SecondClosingTimeFriday.Clock()
-				secondOpeningTimeFriday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeFriday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeFriday = secondClosingTimeFriday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeFriday) || (endOrderTimeWeekday.After(closingTimeFriday) && startOrderTimeWeekday.Before(secondOpeningTimeFriday))) || (startOrderTimeWeekday.After(secondClosingTimeFriday) || endOrderTimeWeekday.After(secondClosingTimeFriday)) {
-					return errors.New("business closed")
-				}
-			}
-		case "Saturday":
-			openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeSaturday.Clock()
-			closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeSaturday.Clock()
-			openingTimeSaturday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-			closingTimeSaturday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-			if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-				closingTimeSaturday = closingTimeSaturday.AddDate(0, 0, 1)
-			}
-			if businessScheduleRes.SecondOpeningTimeSaturday == zeroTime {
-				if startOrderTimeWeekday.Before(openingTimeSaturday) || endOrderTimeWeekday.After(closingTimeSaturday) {
-					return errors.New("business closed")
-				}
-			} else {
-				openingHour, openingMinutes, _ := businessScheduleRes.FirstOpeningTimeSaturday.Clock()
-				closingHour, closingMinutes, _ := businessScheduleRes.FirstClosingTimeSaturday.Clock()
-				secondOpeningTimeSaturday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), openingHour, openingMinutes, 0, 0, time.UTC)
-				secondClosingTimeSaturday := time.Date(startOrderTimeWeekday.Year(), startOrderTimeWeekday.Month(), startOrderTimeWeekday.Day(), closingHour, closingMinutes, 0, 0, time.UTC)
-				if openingHour > closingHour && closingHour >= 0 && closingHour <= 4 {
-					secondClosingTimeSaturday = secondClosingTimeSaturday.AddDate(0, 0, 1)
-				}
-				if (startOrderTimeWeekday.Before(openingTimeSaturday) || (endOrderTimeWeekday.After(closingTimeSaturday) && startOrderTimeWeekday.Before(secondOpeningTimeSaturday))) || (startOrderTimeWeekday.After(secondClosingTimeSaturday) || endOrderTimeWeekday.After(secondClosingTimeSaturday)) {
-					return errors.New("business closed")
-				}
-			}
-
+			return closedErr
 		}
 		_, err = i.dao.NewOrderedRepository().BatchCreateOrderedItem(tx, &orderedItems)
 		if err != nil {
@@ -747,12 +645,12 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		}
 		businessPaymentMethodId := uuid.MustParse(req.BusinessPaymentMethodId)
 		businessPaymentMethod, err := i.dao.NewBusinessPaymentMethodRepository().GetBusinessPaymentMethod(ctx, tx, &entity.BusinessPaymentMethod{ID: &businessPaymentMethodId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("business payment method not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("business payment method not found")
 		} else if err != nil {
 			return err
 		}
-		createOrderRes, err := i.dao.NewOrderRepository().CreateOrder(tx, &entity.Order{ItemsQuantity: quantity, BusinessThumbnail: businessRes.Thumbnail, OrderType: req.OrderType.String(), UserId: authorizationTokenRes.UserId, StartOrderTime: req.StartOrderTime.AsTime().UTC(), EndOrderTime: req.EndOrderTime.AsTime().UTC(), Coordinates: coordinates, AuthorizationTokenId: authorizationTokenRes.ID, BusinessId: (*listCartItemRes)[0].BusinessId, PriceCup: price_cup.String(), CreateTime: createTime, UpdateTime: createTime, Number: number, Address: address, Instructions: req.Instructions, BusinessName: businessRes.Name, Status: "OrderStatusTypeOrdered", Phone: req.Phone, PaymentMethodType: businessPaymentMethod.Type, DeliveryPriceCup: businessRes.DeliveryPriceCup})
+		createOrderRes, err := i.dao.NewOrderRepository().CreateOrder(tx, &entity.Order{ItemsQuantity: quantity, BusinessThumbnail: businessRes.Thumbnail, OrderType: req.OrderType.String(), UserId: principal.AuthorizationToken.UserId, StartOrderTime: req.StartOrderTime.AsTime().UTC(), EndOrderTime: req.EndOrderTime.AsTime().UTC(), Coordinates: coordinates, AuthorizationTokenId: principal.AuthorizationToken.ID, BusinessId: (*listCartItemRes)[0].BusinessId, PriceCup: price_cup.String(), CreateTime: createTime, UpdateTime: createTime, Number: number, Address: address, Instructions: req.Instructions, BusinessName: businessRes.Name, Status: "OrderStatusTypeOrdered", Phone: req.Phone, PaymentMethodType: businessPaymentMethod.Type, DeliveryPriceCup: businessRes.DeliveryPriceCup})
 		if err != nil {
 			return err
 		}
@@ -760,6 +658,10 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		if err != nil {
 			return err
 		}
+		err = i.notifyOrderCreated(ctx, tx, createOrderRes)
+		if err != nil {
+			return err
+		}
 		unionOrderAndOrderedItems := make([]entity.UnionOrderAndOrderedItem, 0, len(orderedItems))
 		for _, item := range orderedItems {
 			unionOrderAndOrderedItems = append(unionOrderAndOrderedItems, entity.UnionOrderAndOrderedItem{OrderId: createOrderRes.ID, OrderedItemId: item.ID})
@@ -768,7 +670,7 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 		if err != nil {
 			return err
 		}
-		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: authorizationTokenRes.UserId}, nil)
+		_, err = i.dao.NewCartItemRepository().DeleteCartItem(tx, &entity.CartItem{UserId: principal.AuthorizationToken.UserId}, nil)
 		if err != nil {
 			return err
 		}
@@ -788,30 +690,10 @@ func (i *orderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReque
 func (i *orderService) ListOrder(ctx context.Context, req *pb.ListOrderRequest, md *utils.ClientMetadata) (*pb.ListOrderResponse, error) {
 	var res pb.ListOrderResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		var ordersRes *[]entity.OrderBusiness
 		var nextPage time.Time
 		if req.NextPage == nil {
@@ -820,11 +702,11 @@ func (i *orderService) ListOrder(ctx context.Context, req *pb.ListOrderRequest,
 			nextPage = req.NextPage.AsTime()
 		}
 		// if !req.Upcoming && !req.History {
-		// 	ordersRes, err = i.dao.NewOrderRepository().ListOrderWithBusiness(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: authorizationTokenRes.UserId})
+		// 	ordersRes, err = i.dao.NewOrderRepository().ListOrderWithBusiness(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: principal.AuthorizationToken.UserId})
 		// } else {
-		// 	ordersRes, err = i.dao.NewOrderRepository().ListOrderFilter(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: authorizationTokenRes.UserId}, req.Upcoming)
+		// 	ordersRes, err = i.dao.NewOrderRepository().ListOrderFilter(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: principal.AuthorizationToken.UserId}, req.Upcoming)
 		// }
-		ordersRes, err = i.dao.NewOrderRepository().ListOrderFilter(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: authorizationTokenRes.UserId}, req.Upcoming)
+		ordersRes, err = i.dao.NewOrderRepository().ListOrderFilter(tx, &entity.OrderBusiness{CreateTime: nextPage, UserId: principal.AuthorizationToken.UserId}, req.Upcoming)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+)
+
+// OrderActor identifies who is requesting a change in the status of an order.
+type OrderActor int
+
+const (
+	OrderActorCustomer OrderActor = iota + 1
+	OrderActorBusiness
+	OrderActorMessenger
+	OrderActorSystem
+)
+
+func (a OrderActor) String() string {
+	switch a {
+	case OrderActorCustomer:
+		return "customer"
+	case OrderActorBusiness:
+		return "business"
+	case OrderActorMessenger:
+		return "messenger"
+	case OrderActorSystem:
+		return "system"
+	default:
+		return "unknown"
+	}
+}
+
+// Names of the user permissions that grant the business and messenger actors
+// over the orders of a business.
+const (
+	updateOrderPermission  = "update_order"
+	deliverOrderPermission = "deliver_order"
+)
+
+type orderTransition struct {
+	to     pb.OrderStatusType
+	actors []OrderActor
+	// When set the transition is only valid for orders of this type.
+	orderType pb.OrderType
+}
+
+// orderTransitions is the order lifecycle. Delivered, Rejected, Cancelled and
+// Expired are terminal, so they don't have entries.
+var orderTransitions = map[pb.OrderStatusType][]orderTransition{
+	pb.OrderStatusType_OrderStatusTypePendingPayment: {
+		{to: pb.OrderStatusType_OrderStatusTypeOrdered, actors: []OrderActor{OrderActorSystem}},
+		{to: pb.OrderStatusType_OrderStatusTypeCancelled, actors: []OrderActor{OrderActorCustomer}},
+		{to: pb.OrderStatusType_OrderStatusTypeExpired, actors: []OrderActor{OrderActorSystem}},
+	},
+	pb.OrderStatusType_OrderStatusTypeOrdered: {
+		{to: pb.OrderStatusType_OrderStatusTypeAccepted, actors: []OrderActor{OrderActorBusiness}},
+		{to: pb.OrderStatusType_OrderStatusTypeRejected, actors: []OrderActor{OrderActorBusiness}},
+		{to: pb.OrderStatusType_OrderStatusTypeCancelled, actors: []OrderActor{OrderActorCustomer}},
+		{to: pb.OrderStatusType_OrderStatusTypeExpired, actors: []OrderActor{OrderActorSystem}},
+	},
+	pb.OrderStatusType_OrderStatusTypeAccepted: {
+		{to: pb.OrderStatusType_OrderStatusTypeReady, actors: []OrderActor{OrderActorBusiness}},
+		{to: pb.OrderStatusType_OrderStatusTypeCancelled, actors: []OrderActor{OrderActorBusiness}},
+	},
+	pb.OrderStatusType_OrderStatusTypeReady: {
+		{to: pb.OrderStatusType_OrderStatusTypeAssignedMessenger, actors: []OrderActor{OrderActorBusiness}, orderType: pb.OrderType_OrderTypeHomeDelivery},
+		{to: pb.OrderStatusType_OrderStatusTypeDelivered, actors: []OrderActor{OrderActorBusiness}, orderType: pb.OrderType_OrderTypePickUp},
+		{to: pb.OrderStatusType_OrderStatusTypeCancelled, actors: []OrderActor{OrderActorBusiness}},
+	},
+	pb.OrderStatusType_OrderStatusTypeAssignedMessenger: {
+		{to: pb.OrderStatusType_OrderStatusTypeDelivered, actors: []OrderActor{OrderActorMessenger}},
+	},
+}
+
+func (t *orderTransition) allows(orderType pb.OrderType, actors []OrderActor) bool {
+	if t.orderType != pb.OrderType_OrderTypeUnspecified && t.orderType != orderType {
+		return false
+	}
+	for _, allowed := range t.actors {
+		for _, actor := range actors {
+			if allowed == actor {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextOrderStatuses returns the statuses that any of the actors can move an
+// order of the given type to from its current status.
+func NextOrderStatuses(from pb.OrderStatusType, orderType pb.OrderType, actors ...OrderActor) []pb.OrderStatusType {
+	res := make([]pb.OrderStatusType, 0)
+	for _, t := range orderTransitions[from] {
+		if t.allows(orderType, actors) {
+			res = append(res, t.to)
+		}
+	}
+	return res
+}
+
+// CheckOrderTransition returns a failed precondition wrapping an
+// *OrderStatusTransitionError when none of the actors can move an order of the
+// given type from one status to the other.
+func CheckOrderTransition(from pb.OrderStatusType, to pb.OrderStatusType, orderType pb.OrderType, actors ...OrderActor) error {
+	allowed := NextOrderStatuses(from, orderType, actors...)
+	for _, status := range allowed {
+		if status == to {
+			return nil
+		}
+	}
+	transitionErr := &OrderStatusTransitionError{From: from, To: to, Allowed: allowed}
+	return apperror.FailedPrecondition(transitionErr.Error()).
+		WithReason("ORDER_STATUS_TRANSITION_NOT_ALLOWED").
+		WithDomain("order").
+		WithMetadata("from", from.String()).
+		WithMetadata("to", to.String()).
+		WithMetadata("allowed", transitionErr.AllowedString()).
+		Wrap(transitionErr)
+}
+
+// OrderStatusIsFinal reports whether no actor can move an order out of the status.
+func OrderStatusIsFinal(status pb.OrderStatusType) bool {
+	return len(orderTransitions[status]) == 0
+}
+
+// OrderStatusReleasesStock reports whether moving an order to the status gives
+// the ordered quantities back to the items.
+func OrderStatusReleasesStock(status pb.OrderStatusType) bool {
+	switch status {
+	case pb.OrderStatusType_OrderStatusTypeRejected, pb.OrderStatusType_OrderStatusTypeCancelled, pb.OrderStatusType_OrderStatusTypeExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+type OrderStatusTransitionError struct {
+	From    pb.OrderStatusType
+	To      pb.OrderStatusType
+	Allowed []pb.OrderStatusType
+}
+
+func (e *OrderStatusTransitionError) Error() string {
+	return fmt.Sprintf("order status can't change from %s to %s", e.From.String(), e.To.String())
+}
+
+// AllowedString returns the allowed next statuses as a comma separated list.
+func (e *OrderStatusTransitionError) AllowedString() string {
+	names := make([]string, 0, len(e.Allowed))
+	for _, status := range e.Allowed {
+		names = append(names, status.String())
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+)
+
+func TestCheckOrderTransition(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      pb.OrderStatusType
+		to        pb.OrderStatusType
+		orderType pb.OrderType
+		actors    []OrderActor
+		ok        bool
+	}{
+		{"business accepts", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeAccepted, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorBusiness}, true},
+		{"customer can't accept", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeAccepted, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorCustomer}, false},
+		{"customer cancels", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeCancelled, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorCustomer}, true},
+		{"customer can't cancel accepted", pb.OrderStatusType_OrderStatusTypeAccepted, pb.OrderStatusType_OrderStatusTypeCancelled, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorCustomer}, false},
+		{"delivered skipping accepted", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeDelivered, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorBusiness}, false},
+		{"reopen cancelled", pb.OrderStatusType_OrderStatusTypeCancelled, pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorCustomer, OrderActorBusiness}, false},
+		{"pick up ready delivered", pb.OrderStatusType_OrderStatusTypeReady, pb.OrderStatusType_OrderStatusTypeDelivered, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorBusiness}, true},
+		{"home delivery ready delivered", pb.OrderStatusType_OrderStatusTypeReady, pb.OrderStatusType_OrderStatusTypeDelivered, pb.OrderType_OrderTypeHomeDelivery, []OrderActor{OrderActorBusiness}, false},
+		{"messenger delivers", pb.OrderStatusType_OrderStatusTypeAssignedMessenger, pb.OrderStatusType_OrderStatusTypeDelivered, pb.OrderType_OrderTypeHomeDelivery, []OrderActor{OrderActorMessenger}, true},
+		{"system expires", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeExpired, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorSystem}, true},
+		{"business can't expire", pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeExpired, pb.OrderType_OrderTypePickUp, []OrderActor{OrderActorBusiness}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckOrderTransition(tt.from, tt.to, tt.orderType, tt.actors...)
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok {
+				var transitionErr *OrderStatusTransitionError
+				if !errors.As(err, &transitionErr) {
+					t.Fatalf("expected *OrderStatusTransitionError, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderStatusTransitionErrorAllowed(t *testing.T) {
+	err := CheckOrderTransition(pb.OrderStatusType_OrderStatusTypeOrdered, pb.OrderStatusType_OrderStatusTypeReady, pb.OrderType_OrderTypePickUp, OrderActorBusiness)
+	var transitionErr *OrderStatusTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("expected *OrderStatusTransitionError, got %v", err)
+	}
+	want := "OrderStatusTypeAccepted,OrderStatusTypeRejected"
+	if got := transitionErr.AllowedString(); got != want {
+		t.Fatalf("allowed = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/entity"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+)
+
+func TestChangeOrderStatusConcurrent(t *testing.T) {
+	dao := newMemoryRepository()
+	service := NewOrderService(dao, newTestSqldb(t), &config.Config{}).(*orderService)
+	ctx := context.Background()
+	item := &entity.ItemBusiness{ID: newTestId(), Availability: 3}
+	dao.items[*item.ID] = item
+	order := &entity.Order{ID: newTestId(), UserId: newTestId(), Status: pb.OrderStatusType_OrderStatusTypeOrdered.String(), OrderType: pb.OrderType_OrderTypePickUp.String()}
+	dao.orders[*order.ID] = order
+	orderedItem := &entity.OrderedItem{ID: newTestId(), ItemId: item.ID, Quantity: 2}
+	dao.orderedItems[*orderedItem.ID] = orderedItem
+	dao.orderItems = []entity.UnionOrderAndOrderedItem{{ID: newTestId(), OrderId: order.ID, OrderedItemId: orderedItem.ID}}
+
+	// The customer and the expiration job read the order in the same status.
+	cancelled, expired := *order, *order
+	_, _, err := service.changeOrderStatus(ctx, nil, &cancelled, pb.OrderStatusType_OrderStatusTypeCancelled, "changed my mind", OrderActorCustomer)
+	if err != nil {
+		t.Fatalf("changeOrderStatus() to cancelled = %v", err)
+	}
+	_, _, err = service.changeOrderStatus(ctx, nil, &expired, pb.OrderStatusType_OrderStatusTypeExpired, "", OrderActorSystem)
+	if err != errOrderStatusChanged {
+		t.Fatalf("changeOrderStatus() from a stale status = %v, want %v", err, errOrderStatusChanged)
+	}
+	if order.Status != pb.OrderStatusType_OrderStatusTypeCancelled.String() {
+		t.Errorf("the order is %s, want cancelled", order.Status)
+	}
+	if item.Availability != 5 {
+		t.Errorf("the item availability is %d, want the stock released once to 5", item.Availability)
+	}
+	if len(dao.orderLifecycles) != 1 {
+		t.Errorf("got %d lifecycle events, want 1", len(dao.orderLifecycles))
+	}
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/notification"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type OutboxService interface {
+	DispatchOutbox(ctx context.Context) (int, error)
+}
+
+const (
+	dispatchOutboxBatchSize = 20
+	outboxMaxRetryDelay     = time.Hour
+	outboxWebhookTimeout    = 10 * time.Second
+
+	// outboxClaimLease covers the delivery of a whole batch, its webhooks
+	// time out after outboxWebhookTimeout.
+	outboxClaimLease = 5 * time.Minute
+)
+
+type outboxService struct {
+	dao        repository.Repository
+	config     *config.Config
+	sqldb      *sqldb.Sql
+	email      notification.Notifier
+	push       notification.Notifier
+	httpClient *http.Client
+}
+
+func NewOutboxService(dao repository.Repository, config *config.Config, sqldb *sqldb.Sql, email notification.Notifier, push notification.Notifier) OutboxService {
+	return &outboxService{dao: dao, config: config, sqldb: sqldb, email: email, push: push, httpClient: &http.Client{Timeout: outboxWebhookTimeout}}
+}
+
+// enqueueOutbox stores a message in the outbox within the transaction of the
+// change that produced it, so the message is only delivered when the change is
+// committed.
+func enqueueOutbox(tx *gorm.DB, dao repository.Repository, channel string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = dao.NewOutboxRepository().CreateOutbox(tx, &entity.Outbox{Channel: channel, Payload: string(data)})
+	return err
+}
+
+// DispatchOutbox delivers the pending messages that are due, in batches, and
+// returns how many were delivered. A batch is claimed with a lease and
+// delivered out of any transaction, then every result is recorded on its own,
+// so a slow relay holds no lock. A failed message is retried with an
+// exponential backoff until OUTBOX_MAX_ATTEMPTS, then it is marked as dead.
+func (i *outboxService) DispatchOutbox(ctx context.Context) (int, error) {
+	retryBaseDelay, err := time.ParseDuration(i.config.OutboxRetryBaseDelay)
+	if err != nil {
+		return 0, err
+	}
+	var delivered int
+	for {
+		// The lease identifies the claim, the database keeps microseconds.
+		leaseTime := time.Now().UTC().Add(outboxClaimLease).Truncate(time.Microsecond)
+		outboxRes, err := i.dao.NewOutboxRepository().ClaimOutbox(i.sqldb.Gorm, time.Now().UTC(), leaseTime, dispatchOutboxBatchSize)
+		if err != nil {
+			return delivered, err
+		}
+		for index := range *outboxRes {
+			message := &(*outboxRes)[index]
+			deliverErr := i.deliver(ctx, message)
+			now := time.Now().UTC()
+			if deliverErr == nil {
+				message.Status = entity.OutboxStatusDelivered
+				message.LastError = ""
+				message.DeliverTime = &now
+			} else {
+				message.Attempts++
+				message.LastError = deliverErr.Error()
+				if int(message.Attempts) >= i.config.OutboxMaxAttempts {
+					message.Status = entity.OutboxStatusDead
+				} else {
+					message.NextAttemptTime = now.Add(outboxRetryDelay(retryBaseDelay, message.Attempts))
+				}
+			}
+			_, err = i.dao.NewOutboxRepository().UpdateOutbox(i.sqldb.Gorm, &entity.Outbox{ID: message.ID, NextAttemptTime: leaseTime}, message)
+			if apperror.IsNotFound(err) {
+				// The lease passed and another dispatcher claimed the message.
+				log.Warnf("outbox message %s: lease lost", message.ID)
+				continue
+			} else if err != nil {
+				return delivered, err
+			}
+			if deliverErr == nil {
+				delivered++
+			}
+		}
+		if len(*outboxRes) < dispatchOutboxBatchSize || ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+	}
+}
+
+// outboxRetryDelay doubles the base delay with every failed attempt.
+func outboxRetryDelay(base time.Duration, attempts int32) time.Duration {
+	delay := base
+	for n := int32(1); n < attempts; n++ {
+		delay *= 2
+		if delay >= outboxMaxRetryDelay {
+			return outboxMaxRetryDelay
+		}
+	}
+	return delay
+}
+
+func (i *outboxService) deliver(ctx context.Context, message *entity.Outbox) error {
+	switch message.Channel {
+	case entity.OutboxChannelEmail, entity.OutboxChannelPush:
+		var payload notification.Message
+		if err := json.Unmarshal([]byte(message.Payload), &payload); err != nil {
+			return err
+		}
+		if message.Channel == entity.OutboxChannelEmail {
+			return i.email.Notify(ctx, &payload)
+		}
+		return i.push.Notify(ctx, &payload)
+	case entity.OutboxChannelWebhook:
+		var payload entity.OutboxWebhook
+		if err := json.Unmarshal([]byte(message.Payload), &payload); err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, payload.Url, bytes.NewReader(payload.Body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		res, err := i.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return fmt.Errorf("webhook responded %s", res.Status)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown outbox channel %s", message.Channel)
+	}
+}
@@ -2,12 +2,11 @@ package usecase
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
@@ -37,38 +36,18 @@ func NewPermissionService(dao repository.Repository, sqldb *sqldb.Sql) Permissio
 func (i *permissionService) GetPermission(ctx context.Context, req *pb.GetPermissionRequest, md *utils.ClientMetadata) (*pb.Permission, error) {
 	var res pb.Permission
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "read_permission"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "read_permission"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		id := uuid.MustParse(req.Id)
 		permissionRes, err := i.dao.NewPermissionRepository().GetPermission(ctx, tx, &entity.Permission{ID: &id})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("permission not found")
 		} else if err != nil {
 			return err
 		}
@@ -89,33 +68,13 @@ func (i *permissionService) GetPermission(ctx context.Context, req *pb.GetPermis
 func (i *permissionService) ListPermission(ctx context.Context, req *pb.ListPermissionRequest, md *utils.ClientMetadata) (*pb.ListPermissionResponse, error) {
 	var res pb.ListPermissionResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "read_permission"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "read_permission"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		var nextPage time.Time
 		if req.NextPage == nil {
@@ -155,38 +114,18 @@ func (i *permissionService) ListPermission(ctx context.Context, req *pb.ListPerm
 func (i *permissionService) DeletePermission(ctx context.Context, req *pb.DeletePermissionRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	var res gp.Empty
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "delete_permission"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "delete_permission"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		id := uuid.MustParse(req.Id)
 		_, err = i.dao.NewPermissionRepository().DeletePermission(ctx, tx, &entity.Permission{ID: &id}, nil)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("permission not found")
 		} else if err != nil {
 			return err
 		}
@@ -201,33 +140,13 @@ func (i *permissionService) DeletePermission(ctx context.Context, req *pb.Delete
 func (i *permissionService) CreatePermission(ctx context.Context, req *pb.CreatePermissionRequest, md *utils.ClientMetadata) (*pb.Permission, error) {
 	var res pb.Permission
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: authorizationTokenRes.UserId, Name: "create_permission"})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("permission denied")
+		_, err = i.dao.NewUserPermissionRepository().GetUserPermission(ctx, tx, &entity.UserPermission{UserId: principal.AuthorizationToken.UserId, Name: "create_permission"})
+		if apperror.IsNotFound(err) {
+			return apperror.ErrPermissionDenied
 		}
 		permissionRes, err := i.dao.NewPermissionRepository().CreatePermission(ctx, tx, &entity.Permission{Name: req.Permission.Name})
 		if err != nil {
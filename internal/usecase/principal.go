@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	"github.com/daniarmas/api_go/utils"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Principal is the caller of a request. The authentication interceptor
+// resolves it once and the usecases read it from the context.
+type Principal struct {
+	Application *entity.Application
+	// AuthorizationToken, User and Device are nil when no user signed in.
+	AuthorizationToken *entity.AuthorizationToken
+	User               *entity.User
+	Device             *entity.Device
+}
+
+// AuthPolicy tells what a method requires from its caller.
+type AuthPolicy int
+
+const (
+	// AuthPolicyUser requires a valid application and a signed in user. It's
+	// the policy of the methods missing from the policy table.
+	AuthPolicyUser AuthPolicy = iota
+	// AuthPolicyOptionalUser requires a valid application and resolves the
+	// user only when the request carries an authorization token.
+	AuthPolicyOptionalUser
+	// AuthPolicyApplication only requires a valid application.
+	AuthPolicyApplication
+	// AuthPolicyPublic doesn't require anything.
+	AuthPolicyPublic
+)
+
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of the context that carries the principal.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal resolved for the request, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// principalFromContext returns the principal of a signed in user.
+func principalFromContext(ctx context.Context) (*Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.AuthorizationToken == nil {
+		return nil, apperror.ErrUnauthenticatedUser
+	}
+	return principal, nil
+}
+
+// applicationFromContext returns the application that sent the request.
+func applicationFromContext(ctx context.Context) (*entity.Application, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Application == nil {
+		return nil, apperror.Unauthenticated("unauthenticated application")
+	}
+	return principal.Application, nil
+}
+
+type Authenticator interface {
+	// Authenticate resolves the caller of the method according to its policy
+	// and returns the context with the principal.
+	Authenticate(ctx context.Context, method string) (context.Context, error)
+}
+
+type authenticator struct {
+	dao      repository.Repository
+	sqldb    *sqldb.Sql
+	policies map[string]AuthPolicy
+}
+
+func NewAuthenticator(dao repository.Repository, sqldb *sqldb.Sql, policies map[string]AuthPolicy) Authenticator {
+	return &authenticator{dao: dao, sqldb: sqldb, policies: policies}
+}
+
+func (i *authenticator) Authenticate(ctx context.Context, method string) (context.Context, error) {
+	policy := i.policies[method]
+	if policy == AuthPolicyPublic {
+		return ctx, nil
+	}
+	md := utils.GetMetadata(ctx)
+	tx := i.sqldb.Gorm.WithContext(ctx)
+	application, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if !application.Allows(method) {
+		return nil, apperror.PermissionDenied("application not allowed").WithMetadata("method", method)
+	}
+	if md.DeviceIdentifier != nil && *md.DeviceIdentifier != "" {
+		err = checkDeviceBan(ctx, tx, i.dao, *md.DeviceIdentifier)
+		if err != nil {
+			return nil, err
+		}
+	}
+	principal := &Principal{Application: application}
+	signedIn := md.Authorization != nil && *md.Authorization != ""
+	switch {
+	case policy == AuthPolicyUser && !signedIn:
+		return nil, apperror.ErrUnauthenticatedUser
+	case policy == AuthPolicyUser, policy == AuthPolicyOptionalUser && signedIn:
+		err = i.resolveUser(ctx, tx, md, principal)
+		if err != nil {
+			return nil, err
+		}
+		err = checkUserBan(ctx, tx, i.dao, principal.User.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ContextWithPrincipal(ctx, principal), nil
+}
+
+// resolveUser loads the authorization token of the request with its user and
+// device into the principal.
+func (i *authenticator) resolveUser(ctx context.Context, tx *gorm.DB, md *utils.ClientMetadata, principal *Principal) error {
+	jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
+	err := repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+	if err != nil {
+		return err
+	}
+	authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
+	if apperror.IsNotFound(err) {
+		return apperror.ErrUnauthenticatedUser
+	} else if err != nil {
+		return err
+	}
+	userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
+	if apperror.IsNotFound(err) {
+		return apperror.ErrUnauthenticatedUser
+	} else if err != nil {
+		return err
+	}
+	devicesRes, err := i.dao.NewDeviceRepository().ListDeviceInIds(tx, []uuid.UUID{*authorizationTokenRes.DeviceId})
+	if err != nil {
+		return err
+	} else if len(*devicesRes) == 0 {
+		return apperror.ErrUnauthenticatedUser
+	}
+	principal.AuthorizationToken = authorizationTokenRes
+	principal.User = userRes
+	principal.Device = &(*devicesRes)[0]
+	// Failing to record the activity of the session doesn't fail the request
+	err = i.dao.NewAuthorizationTokenRepository().TouchAuthorizationToken(ctx, tx, authorizationTokenRes.ID, utils.GetClientIp(ctx))
+	if err != nil {
+		log.Error(err)
+	}
+	return nil
+}
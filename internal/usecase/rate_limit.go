@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/ratelimit"
+	"github.com/daniarmas/api_go/utils"
+	log "github.com/sirupsen/logrus"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var errTooManyRequests = apperror.ResourceExhausted("too many requests")
+
+type RateLimiter interface {
+	// Limit counts the request against the policies of the method and returns
+	// a ResourceExhausted error when one of them is exceeded.
+	Limit(ctx context.Context, method string, req interface{}) error
+}
+
+type rateLimiter struct {
+	limiter *ratelimit.Limiter
+}
+
+func NewRateLimiter(limiter *ratelimit.Limiter) RateLimiter {
+	return &rateLimiter{limiter: limiter}
+}
+
+func (i *rateLimiter) Limit(ctx context.Context, method string, req interface{}) error {
+	for _, policy := range i.limiter.Policies(method) {
+		subject := rateLimitSubject(ctx, req, policy.Key)
+		if subject == "" {
+			continue
+		}
+		allowed, retryAfter, err := i.limiter.Allow(ctx, method, policy, subject)
+		if err != nil {
+			// An unavailable redis doesn't take the api down with it.
+			log.WithFields(log.Fields{"err": err}).Error("rate limit: " + method)
+			continue
+		}
+		if !allowed {
+			return errTooManyRequests.WithMetadata("key", string(policy.Key)).WithDetails(&epb.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+		}
+	}
+	return nil
+}
+
+// rateLimitSubject returns who the request is counted for, or "" when the
+// request doesn't carry the key.
+func rateLimitSubject(ctx context.Context, req interface{}, key ratelimit.Key) string {
+	switch key {
+	case ratelimit.KeyDevice:
+		md, _ := metadata.FromIncomingContext(ctx)
+		if deviceIdentifier := md.Get("Device-Id"); len(deviceIdentifier) != 0 {
+			return deviceIdentifier[0]
+		}
+	case ratelimit.KeyEmail:
+		if r, ok := req.(interface{ GetEmail() string }); ok {
+			return strings.ToLower(strings.TrimSpace(r.GetEmail()))
+		}
+	case ratelimit.KeyIp:
+		return utils.GetClientIp(ctx)
+	case ratelimit.KeyUser:
+		if principal, ok := PrincipalFromContext(ctx); ok && principal.AuthorizationToken != nil {
+			return principal.AuthorizationToken.UserId.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/daniarmas/api_go/utils"
+	"google.golang.org/grpc/codes"
+)
+
+func testClientMetadata(deviceIdentifier string) *utils.ClientMetadata {
+	platform, systemVersion, model, firebaseCloudMessagingId := "Android", "12", "Pixel 6", "fcm-"+deviceIdentifier
+	return &utils.ClientMetadata{DeviceIdentifier: &deviceIdentifier, Platform: &platform, SystemVersion: &systemVersion, Model: &model, FirebaseCloudMessagingId: &firebaseCloudMessagingId}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	setTestKeyring(t)
+	dao := newMemoryRepository()
+	service := NewAuthenticationService(dao, &config.Config{AppName: "Mool"}, newTestSqldb(t), nil)
+	md := testClientMetadata("device")
+	ctx := ContextWithPrincipal(context.Background(), &Principal{Application: &entity.Application{Name: "mool", Version: "1.0.0"}})
+
+	user := &entity.User{ID: newTestId(), Email: "user@example.com"}
+	dao.users[*user.ID] = user
+	device, _ := dao.NewDeviceRepository().CreateDevice(ctx, nil, &entity.Device{DeviceIdentifier: *md.DeviceIdentifier, FirebaseCloudMessagingId: *md.FirebaseCloudMessagingId})
+	first, _ := dao.NewRefreshTokenRepository().CreateRefreshToken(ctx, nil, &entity.RefreshToken{UserId: user.ID, DeviceId: device.ID})
+	dao.NewAuthorizationTokenRepository().CreateAuthorizationToken(ctx, nil, &entity.AuthorizationToken{RefreshTokenId: first.ID, UserId: user.ID, DeviceId: device.ID})
+	firstToken := &datasource.JsonWebTokenMetadata{TokenId: first.ID}
+	if err := repository.Datasource.NewJwtTokenDatasource().CreateJwtRefreshToken(firstToken); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rotate: the token is used once and its successor joins its family.
+	rotated, err := service.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: *firstToken.Token}, md)
+	if err != nil {
+		t.Fatalf("RefreshToken() = %v", err)
+	}
+	if dao.refreshTokens[*first.ID].UsedTime == nil {
+		t.Fatal("the refreshed token isn't marked as used")
+	}
+	if len(dao.refreshTokens) != 2 || len(dao.authorizationTokens) != 1 {
+		t.Fatalf("got %d refresh and %d authorization tokens, want 2 and 1", len(dao.refreshTokens), len(dao.authorizationTokens))
+	}
+	second := &datasource.JsonWebTokenMetadata{Token: &rotated.RefreshToken}
+	if err := repository.Datasource.NewJwtTokenDatasource().ParseJwtRefreshToken(second); err != nil {
+		t.Fatal(err)
+	}
+	if successor := dao.refreshTokens[*second.TokenId]; *successor.FamilyId != *first.ID || *successor.ParentId != *first.ID {
+		t.Fatalf("the successor has the family %v and the parent %v, want %v", successor.FamilyId, successor.ParentId, first.ID)
+	}
+	rotated, err = service.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: rotated.RefreshToken}, md)
+	if err != nil {
+		t.Fatalf("RefreshToken() of the successor = %v", err)
+	}
+
+	// Reuse: presenting a used token revokes the whole family.
+	_, err = service.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: *firstToken.Token}, md)
+	if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.Unauthenticated || appErr.Message != "refresh token reused" {
+		t.Fatalf("RefreshToken() of a used token = %v, want refresh token reused", err)
+	}
+	if len(dao.refreshTokens) != 0 || len(dao.authorizationTokens) != 0 {
+		t.Fatalf("%d refresh and %d authorization tokens of the family survived", len(dao.refreshTokens), len(dao.authorizationTokens))
+	}
+	channels := map[string]int{}
+	for _, message := range dao.outbox {
+		channels[message.Channel]++
+	}
+	if channels[entity.OutboxChannelEmail] != 1 || channels[entity.OutboxChannelPush] != 1 {
+		t.Fatalf("the revocation enqueued %v, want an email and a push", channels)
+	}
+	_, err = service.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: rotated.RefreshToken}, md)
+	if err == nil {
+		t.Fatal("the last token of a revoked family is still valid")
+	}
+}
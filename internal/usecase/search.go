@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/pkg/apperror"
+)
+
+var errInvalidPageToken = apperror.InvalidArgument("invalid pageToken")
+
+// searchPageToken is the opaque cursor of the next page of a search. It
+// keeps the query, a token is only valid for the search that returned it.
+type searchPageToken struct {
+	Query string `json:"q"`
+	datasource.SearchCursor
+}
+
+func encodeSearchPageToken(query string, cursor datasource.SearchCursor) string {
+	data, _ := json.Marshal(searchPageToken{Query: query, SearchCursor: cursor})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeSearchPageToken returns the cursor of the token, nil for the first
+// page.
+func decodeSearchPageToken(token string, query string) (*datasource.SearchCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	var pageToken searchPageToken
+	err = json.Unmarshal(data, &pageToken)
+	if err != nil || pageToken.Query != query {
+		return nil, errInvalidPageToken
+	}
+	return &pageToken.SearchCursor, nil
+}
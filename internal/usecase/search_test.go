@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daniarmas/api_go/internal/datasource"
+	"github.com/daniarmas/api_go/internal/entity"
+	pb "github.com/daniarmas/api_go/pkg/grpc"
+	"github.com/google/uuid"
+)
+
+func TestSearchPageToken(t *testing.T) {
+	cursor := datasource.SearchCursor{Score: 1250000, Proximity: 830, Availability: 1, Id: uuid.New()}
+	token := encodeSearchPageToken("pizza", cursor)
+	got, err := decodeSearchPageToken(token, "pizza")
+	if err != nil || *got != cursor {
+		t.Fatalf("decodeSearchPageToken() = %v, %v, want %v", got, err, cursor)
+	}
+	if got, err := decodeSearchPageToken("", "pizza"); got != nil || err != nil {
+		t.Errorf("the first page has a cursor: %v, %v", got, err)
+	}
+	for _, test := range []struct{ token, query string }{{token, "pasta"}, {"not a token", "pizza"}, {token[1:], "pizza"}} {
+		if _, err := decodeSearchPageToken(test.token, test.query); err == nil {
+			t.Errorf("the token %q of %q is valid", test.token, test.query)
+		}
+	}
+}
+
+func TestFeedPageToken(t *testing.T) {
+	cursor := datasource.FeedCursor{Rank: -42, Nearness: 0.0123456789, Id: uuid.New()}
+	at := time.Date(2022, 3, 4, 18, 30, 15, 0, time.UTC)
+	token := encodeFeedPageToken(pb.FeedOrder_FeedOrderPopularity, at, cursor)
+	got, err := decodeFeedPageToken(token, pb.FeedOrder_FeedOrderPopularity)
+	if err != nil || got.FeedCursor != cursor || !time.Unix(got.Time, 0).Equal(at) {
+		t.Fatalf("decodeFeedPageToken() = %v, %v, want %v at %v", got, err, cursor, at)
+	}
+	if _, err := decodeFeedPageToken(token, pb.FeedOrder_FeedOrderOpenNow); err == nil {
+		t.Error("the token of another order is valid")
+	}
+}
+
+func TestBusinessOrderPageToken(t *testing.T) {
+	businessId := uuid.New()
+	cursor := datasource.BusinessOrderCursor{CreateTime: time.Date(2022, 3, 4, 18, 30, 15, 123456000, time.UTC), Id: uuid.New()}
+	token := encodeBusinessOrderPageToken(businessId, cursor)
+	got, err := decodeBusinessOrderPageToken(token, businessId)
+	if err != nil || !got.CreateTime.Equal(cursor.CreateTime) || got.Id != cursor.Id {
+		t.Fatalf("decodeBusinessOrderPageToken() = %v, %v, want %v", got, err, cursor)
+	}
+	if _, err := decodeBusinessOrderPageToken(token, uuid.New()); err == nil {
+		t.Error("the token of another business is valid")
+	}
+}
+
+func TestMergeSearchSuggestions(t *testing.T) {
+	here, there := uuid.New(), uuid.New()
+	id := uuid.New()
+	suggestions := []entity.SearchSuggestion{
+		{Type: entity.SearchSuggestionTypeItem, Id: &id, Text: "Pizza napolitana", MunicipalityId: &there},
+		{Type: entity.SearchSuggestionTypeBusiness, Id: &id, Text: "Pizzería La Esquina", MunicipalityId: &here},
+		{Type: entity.SearchSuggestionTypeItem, Id: &id, Text: "Pizza", MunicipalityId: &there},
+		{Type: entity.SearchSuggestionTypeCategory, Id: &id, Text: "Pizzas"},
+		{Type: entity.SearchSuggestionTypeItem, Id: &id, Text: "pizza", MunicipalityId: &here},
+	}
+	res := mergeSearchSuggestions([]string{"Pízza "}, suggestions, &here)
+	want := []struct {
+		text           string
+		suggestionType pb.SearchSuggestionType
+	}{
+		{"Pízza ", pb.SearchSuggestionType_SearchSuggestionTypeRecentQuery},
+		{"Pizzas", pb.SearchSuggestionType_SearchSuggestionTypeCategory},
+		{"Pizzería La Esquina", pb.SearchSuggestionType_SearchSuggestionTypeBusiness},
+		{"Pizza napolitana", pb.SearchSuggestionType_SearchSuggestionTypeItem},
+	}
+	if len(res) != len(want) {
+		t.Fatalf("got %d suggestions, want %d: %v", len(res), len(want), res)
+	}
+	for index, item := range want {
+		if res[index].Text != item.text || res[index].Type != item.suggestionType {
+			t.Errorf("suggestion %d: got %q %v, want %q %v", index, res[index].Text, res[index].Type, item.text, item.suggestionType)
+		}
+	}
+}
+
+func TestBusinessClusterCellSize(t *testing.T) {
+	if got := businessClusterCellSize(0); got != 90 {
+		t.Errorf("businessClusterCellSize(0) = %v, want 90", got)
+	}
+	if got, want := businessClusterCellSize(10), businessClusterCellSize(9)/2; got != want {
+		t.Errorf("businessClusterCellSize(10) = %v, want %v", got, want)
+	}
+}
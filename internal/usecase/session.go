@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/notification"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// deleteRefreshTokenFamily deletes every refresh token of the family of the
+// token with their authorization tokens, and returns the deleted refresh tokens.
+func deleteRefreshTokenFamily(ctx context.Context, tx *gorm.DB, dao repository.Repository, refreshToken *entity.RefreshToken) (*[]entity.RefreshToken, error) {
+	familyId := refreshToken.FamilyId
+	if familyId == nil {
+		familyId = refreshToken.ID
+	}
+	refreshTokens, err := dao.NewRefreshTokenRepository().DeleteRefreshTokenFamily(ctx, tx, familyId)
+	if err != nil {
+		return nil, err
+	}
+	refreshTokenIds := make([]uuid.UUID, 0, len(*refreshTokens))
+	for _, item := range *refreshTokens {
+		refreshTokenIds = append(refreshTokenIds, *item.ID)
+	}
+	_, err = dao.NewAuthorizationTokenRepository().DeleteAuthorizationTokenByRefreshTokenIds(ctx, tx, &refreshTokenIds)
+	if err != nil && !apperror.IsNotFound(err) {
+		return nil, err
+	}
+	return refreshTokens, nil
+}
+
+// revokeSessions closes the sessions, deleting their authorization tokens and
+// the refresh token families behind them, and returns their devices.
+func revokeSessions(ctx context.Context, tx *gorm.DB, dao repository.Repository, sessions []entity.AuthorizationToken) ([]entity.Device, error) {
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	sessionIds := make([]uuid.UUID, 0, len(sessions))
+	deviceIds := make([]uuid.UUID, 0, len(sessions))
+	for _, session := range sessions {
+		sessionIds = append(sessionIds, *session.ID)
+		deviceIds = append(deviceIds, *session.DeviceId)
+		refreshToken, err := dao.NewRefreshTokenRepository().GetRefreshTokenForUpdate(ctx, tx, &entity.RefreshToken{ID: session.RefreshTokenId})
+		if apperror.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		_, err = deleteRefreshTokenFamily(ctx, tx, dao, refreshToken)
+		if err != nil && !apperror.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	_, err := dao.NewAuthorizationTokenRepository().DeleteAuthorizationToken(ctx, tx, nil, &sessionIds)
+	if err != nil && !apperror.IsNotFound(err) {
+		return nil, err
+	}
+	devices, err := dao.NewDeviceRepository().ListDeviceInIds(tx, deviceIds)
+	if err != nil {
+		return nil, err
+	}
+	return *devices, nil
+}
+
+// notifySessionDelete sends the "session delete" push to the devices, so the
+// apps go back to the sign in.
+func notifySessionDelete(tx *gorm.DB, dao repository.Repository, devices []entity.Device, event notification.Event, locale string, data map[string]string) error {
+	for _, device := range devices {
+		if device.FirebaseCloudMessagingId == "" {
+			continue
+		}
+		err := enqueueOutbox(tx, dao, entity.OutboxChannelPush, &notification.Message{
+			Event:  event,
+			Locale: locale,
+			To:     device.FirebaseCloudMessagingId,
+			Data:   data,
+			Payload: map[string]string{
+				"res_id": "",
+				"res":    "session",
+				"verb":   "delete",
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
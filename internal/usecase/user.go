@@ -2,12 +2,11 @@ package usecase
 
 import (
 	"context"
-	"errors"
 
 	"github.com/daniarmas/api_go/config"
-	"github.com/daniarmas/api_go/internal/datasource"
 	"github.com/daniarmas/api_go/internal/entity"
 	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
 	pb "github.com/daniarmas/api_go/pkg/grpc"
 	"github.com/daniarmas/api_go/pkg/sqldb"
 	"github.com/daniarmas/api_go/utils"
@@ -16,6 +15,7 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/ewkb"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	gp "google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
@@ -47,45 +47,29 @@ func NewUserService(dao repository.Repository, config *config.Config, rdb *redis
 func (i *userService) UpdateUserConfiguration(ctx context.Context, req *pb.UpdateUserConfigurationRequest, md *utils.ClientMetadata) (*pb.UserConfiguration, error) {
 	var res pb.UserConfiguration
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		dataSaving := req.UserConfiguration.DataSaving
 		highQualityImagesWifi := req.UserConfiguration.HighQualityImagesWifi
 		highQualityImagesData := req.UserConfiguration.HighQualityImagesData
+		// The order notifications are only written when the client sends them,
+		// the clients that don't know them leave them as they are.
 		data := entity.UserConfiguration{
 			DataSaving:            &dataSaving,
 			HighQualityImagesWifi: &highQualityImagesWifi,
 			HighQualityImagesData: &highQualityImagesData,
 			PaymentMethod:         req.UserConfiguration.PaymentMethod.String(),
+			Language:              req.UserConfiguration.Language,
+			OrderNotifications:    req.UserConfiguration.OrderNotifications,
 		}
 		if req.UserConfiguration.PaymentMethod == pb.PaymentMethodType_PaymentMethodTypeUnspecified {
 			data.PaymentMethod = ""
 		}
-		userConfigurationRes, err := i.dao.NewUserConfigurationRepository().UpdateUserConfiguration(ctx, tx, &entity.UserConfiguration{UserId: authorizationTokenRes.UserId}, &data)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("user configuration not found")
+		userConfigurationRes, err := i.dao.NewUserConfigurationRepository().UpdateUserConfiguration(ctx, tx, &entity.UserConfiguration{UserId: principal.AuthorizationToken.UserId}, &data)
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user configuration not found")
 		} else if err != nil {
 			return err
 		}
@@ -95,6 +79,8 @@ func (i *userService) UpdateUserConfiguration(ctx context.Context, req *pb.Updat
 			HighQualityImagesWifi: *userConfigurationRes.HighQualityImagesWifi,
 			HighQualityImagesData: *userConfigurationRes.HighQualityImagesData,
 			PaymentMethod:         *utils.ParsePaymentMethodType(&userConfigurationRes.PaymentMethod),
+			Language:              userConfigurationRes.Language,
+			OrderNotifications:    userConfigurationRes.OrderNotifications,
 			UserId:                userConfigurationRes.UserId.String(),
 			CreateTime:            timestamppb.New(userConfigurationRes.CreateTime),
 			UpdateTime:            timestamppb.New(userConfigurationRes.UpdateTime),
@@ -110,31 +96,11 @@ func (i *userService) UpdateUserConfiguration(ctx context.Context, req *pb.Updat
 func (i *userService) GetUserAddress(ctx context.Context, req *pb.GetUserAddressRequest, md *utils.ClientMetadata) (*pb.UserAddress, error) {
 	var res pb.UserAddress
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
-		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
+		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
@@ -168,31 +134,11 @@ func (i *userService) GetUserAddress(ctx context.Context, req *pb.GetUserAddress
 func (i *userService) UpdateUserAddress(ctx context.Context, req *pb.UpdateUserAddressRequest, md *utils.ClientMetadata) (*pb.UserAddress, error) {
 	var res pb.UserAddress
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
+		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
@@ -201,13 +147,13 @@ func (i *userService) UpdateUserAddress(ctx context.Context, req *pb.UpdateUserA
 			return err
 		}
 		if len(*listAddressRes) == 10 {
-			return errors.New("only can have 10 user_address")
+			return apperror.ResourceExhausted("only can have 10 user_address")
 		}
 		id := uuid.MustParse(req.Id)
 		if req.UserAddress.Selected {
-			_, err := i.dao.NewUserAddressRepository().UpdateUserAddressByUserId(tx, &entity.UserAddress{UserId: authorizationTokenRes.UserId}, &entity.UserAddress{Selected: false})
-			if err != nil && err.Error() == "record not found" {
-				return errors.New("user address not found")
+			_, err := i.dao.NewUserAddressRepository().UpdateUserAddressByUserId(tx, &entity.UserAddress{UserId: principal.AuthorizationToken.UserId}, &entity.UserAddress{Selected: false})
+			if apperror.IsNotFound(err) {
+				return apperror.NotFound("user address not found")
 			} else if err != nil {
 				return err
 			}
@@ -233,8 +179,8 @@ func (i *userService) UpdateUserAddress(ctx context.Context, req *pb.UpdateUserA
 		}
 		var updateUserAddressRes *entity.UserAddress
 		updateUserAddressRes, err = i.dao.NewUserAddressRepository().UpdateUserAddress(tx, &entity.UserAddress{ID: &id}, &where)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("user address not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user address not found")
 		} else if err != nil {
 			return err
 		}
@@ -262,34 +208,14 @@ func (i *userService) UpdateUserAddress(ctx context.Context, req *pb.UpdateUserA
 
 func (i *userService) DeleteUserAddress(ctx context.Context, req *pb.DeleteUserAddressRequest, md *utils.ClientMetadata) (*gp.Empty, error) {
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		_, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		_, err = i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
 		id := uuid.MustParse(req.Id)
 		_, err = i.dao.NewUserAddressRepository().DeleteUserAddress(tx, &entity.UserAddress{ID: &id}, nil)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("user address not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user address not found")
 		} else if err != nil {
 			return err
 		}
@@ -304,31 +230,11 @@ func (i *userService) DeleteUserAddress(ctx context.Context, req *pb.DeleteUserA
 func (i *userService) CreateUserAddress(ctx context.Context, req *pb.CreateUserAddressRequest, md *utils.ClientMetadata) (*pb.UserAddress, error) {
 	var res pb.UserAddress
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
-		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
+		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
@@ -337,12 +243,12 @@ func (i *userService) CreateUserAddress(ctx context.Context, req *pb.CreateUserA
 			return err
 		}
 		if len(*listAddressRes) == 10 {
-			return errors.New("only can have 10 user_address")
+			return apperror.ResourceExhausted("only can have 10 user_address")
 		}
 		location := ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.UserAddress.Coordinates.Latitude, req.UserAddress.Coordinates.Longitude}).SetSRID(4326)}
 		muncipalityRes, err := i.dao.NewMunicipalityRepository().GetMunicipalityByCoordinate(tx, location)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("municipality not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("municipality not found")
 		} else if err != nil {
 			return err
 		}
@@ -375,31 +281,11 @@ func (i *userService) CreateUserAddress(ctx context.Context, req *pb.CreateUserA
 func (i *userService) ListUserAddress(ctx context.Context, req *gp.Empty, md *utils.ClientMetadata) (*pb.ListUserAddressResponse, error) {
 	var res pb.ListUserAddressResponse
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		userId := *authorizationTokenRes.UserId
+		userId := *principal.AuthorizationToken.UserId
 		listUserAddressRes, err := i.dao.NewUserAddressRepository().ListUserAddress(tx, &entity.UserAddress{UserId: &userId})
 		if err != nil {
 			return err
@@ -434,19 +320,15 @@ func (i *userService) GetAddressInfo(ctx context.Context, req *pb.GetAddressInfo
 	var res pb.GetAddressInfoResponse
 	location := ewkb.Point{Point: geom.NewPoint(geom.XY).MustSetCoords([]float64{req.Location.Latitude, req.Location.Longitude}).SetSRID(4326)}
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
-		if err != nil {
-			return err
-		}
 		muncipalityRes, err := i.dao.NewMunicipalityRepository().GetMunicipalityByCoordinate(tx, location)
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("municipality not found")
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("municipality not found")
 		} else if err != nil {
 			return err
 		}
 		provinceRes, err := i.dao.NewProvinceRepository().GetProvince(tx, &entity.Province{ID: muncipalityRes.ProvinceId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("province not found")
+		if apperror.IsNotFound(err) {
+			return apperror.InvalidArgument("province not found")
 		} else if err != nil {
 			return err
 		}
@@ -462,33 +344,13 @@ func (i *userService) GetAddressInfo(ctx context.Context, req *pb.GetAddressInfo
 func (i *userService) GetUser(ctx context.Context, md *utils.ClientMetadata) (*pb.User, error) {
 	var res pb.User
 	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+		principal, err := principalFromContext(ctx)
 		if err != nil {
 			return err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
-		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
-			return err
-		}
-		userRes, err := i.dao.NewUserRepository().GetUserWithAddress(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("user not found")
+		userRes, err := i.dao.NewUserRepository().GetUserWithAddress(ctx, tx, &entity.User{ID: principal.AuthorizationToken.UserId})
+		if apperror.IsNotFound(err) {
+			return apperror.NotFound("user not found")
 		} else if err != nil {
 			return err
 		}
@@ -553,36 +415,24 @@ func (i *userService) GetUser(ctx context.Context, md *utils.ClientMetadata) (*p
 
 func (i *userService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest, md *utils.ClientMetadata) (*pb.User, error) {
 	var res pb.User
-	err := i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
-		_, err := i.dao.NewApplicationRepository().CheckApplication(ctx, tx, *md.AccessToken)
+	var verificationCode *entity.VerificationCode
+	var err error
+	if req.User.Email != "" && req.Code != "" {
+		verificationCode, err = checkVerificationCode(ctx, i.sqldb, i.dao, i.config, req.Code, &entity.VerificationCode{Email: req.User.Email, DeviceIdentifier: *md.DeviceIdentifier, Type: "ChangeUserEmail"})
 		if err != nil {
-			return err
+			return nil, err
 		}
-		jwtAuthorizationToken := &datasource.JsonWebTokenMetadata{Token: md.Authorization}
-		err = repository.Datasource.NewJwtTokenDatasource().ParseJwtAuthorizationToken(jwtAuthorizationToken)
+	}
+	err = i.sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		principal, err := principalFromContext(ctx)
 		if err != nil {
-			switch err.Error() {
-			case "Token is expired":
-				return errors.New("authorization token expired")
-			case "signature is invalid":
-				return errors.New("authorization token signature is invalid")
-			case "token contains an invalid number of segments":
-				return errors.New("authorization token contains an invalid number of segments")
-			default:
-				return err
-			}
-		}
-		authorizationTokenRes, err := i.dao.NewAuthorizationTokenRepository().GetAuthorizationToken(ctx, tx, &entity.AuthorizationToken{ID: jwtAuthorizationToken.TokenId})
-		if err != nil && err.Error() == "record not found" {
-			return errors.New("unauthenticated user")
-		} else if err != nil {
 			return err
 		}
 		// chech if is the user or if have permission
-		if req.User.Id != "" && authorizationTokenRes.UserId.String() != req.User.Id {
-			return errors.New("permission denied")
+		if req.User.Id != "" && principal.AuthorizationToken.UserId.String() != req.User.Id {
+			return apperror.ErrPermissionDenied
 		}
-		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: authorizationTokenRes.UserId})
+		userRes, err := i.dao.NewUserRepository().GetUser(ctx, tx, &entity.User{ID: principal.AuthorizationToken.UserId})
 		if err != nil {
 			return err
 		}
@@ -595,13 +445,14 @@ func (i *userService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest,
 		var updatedUserRes *entity.User
 		if req.User.Email != "" {
 			if req.Code == "" {
-				return errors.New("missing code")
-			}
-			verificationCode, err := i.dao.NewVerificationCodeRepository().GetVerificationCode(ctx, tx, &entity.VerificationCode{Email: req.User.Email, Code: req.Code, DeviceIdentifier: *md.DeviceIdentifier, Type: "ChangeUserEmail"})
-			if err != nil && err.Error() == "record not found" {
-				return errors.New("verification code not found")
-			} else if err != nil {
-				return err
+				return apperror.InvalidArgument("missing code").WithDetails(
+					&epb.BadRequest{
+						FieldViolations: []*epb.BadRequest_FieldViolation{{
+							Field:       "Code",
+							Description: "The code field is required for update the email",
+						}},
+					},
+				)
 			}
 			_, err = i.dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{ID: verificationCode.ID}, nil)
 			if err != nil {
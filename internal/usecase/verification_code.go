@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"strconv"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/internal/repository"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"github.com/daniarmas/api_go/pkg/sqldb"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+)
+
+var (
+	errVerificationCodeNotFound = apperror.NotFound("verification code not found")
+	errVerificationCodeExpired  = apperror.FailedPrecondition("verification code expired")
+	errVerificationCodeLocked   = apperror.ResourceExhausted("too many verification attempts")
+)
+
+// verificationCodeLockedError tells the client how long to wait before asking
+// for a new code.
+func verificationCodeLockedError(retryAfter time.Duration) error {
+	return errVerificationCodeLocked.WithDetails(&epb.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+}
+
+// checkVerificationCodeLockout fails while the email is locked out on the
+// device.
+func checkVerificationCodeLockout(ctx context.Context, dao repository.Repository, email string, deviceIdentifier string) error {
+	lockout, err := dao.NewVerificationCodeRepository().GetVerificationCodeLockout(ctx, email, deviceIdentifier)
+	if err != nil {
+		return err
+	} else if lockout > 0 {
+		return verificationCodeLockedError(lockout)
+	}
+	return nil
+}
+
+// checkVerificationCode checks the code typed by the user against the last
+// code of the type sent to the email on the device. A wrong guess counts as an
+// attempt, and after VERIFICATION_CODE_MAX_ATTEMPTS the code is deleted and
+// the email is locked out on the device for VERIFICATION_CODE_LOCKOUT.
+//
+// It runs in its own transaction, before the transaction of the request, so
+// the attempts are recorded even though the request fails. The request
+// deletes the code once it's used.
+func checkVerificationCode(ctx context.Context, sqldb *sqldb.Sql, dao repository.Repository, cfg *config.Config, code string, where *entity.VerificationCode) (*entity.VerificationCode, error) {
+	err := checkVerificationCodeLockout(ctx, dao, where.Email, where.DeviceIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	lockout, err := time.ParseDuration(cfg.VerificationCodeLockout)
+	if err != nil {
+		return nil, err
+	}
+	var verificationCode *entity.VerificationCode
+	var checkErr error
+	err = sqldb.Gorm.Transaction(func(tx *gorm.DB) error {
+		verificationCode, err = dao.NewVerificationCodeRepository().GetVerificationCodeForUpdate(ctx, tx, &entity.VerificationCode{Email: where.Email, Type: where.Type, DeviceIdentifier: where.DeviceIdentifier})
+		if apperror.IsNotFound(err) {
+			checkErr = errVerificationCodeNotFound
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if time.Now().UTC().After(verificationCode.ExpirationTime) {
+			checkErr = errVerificationCodeExpired
+			return nil
+		}
+		if subtle.ConstantTimeCompare([]byte(verificationCode.Code), []byte(code)) == 1 {
+			return nil
+		}
+		attempts := verificationCode.Attempts + 1
+		if int(attempts) >= cfg.VerificationCodeMaxAttempts {
+			_, err = dao.NewVerificationCodeRepository().DeleteVerificationCode(ctx, tx, &entity.VerificationCode{ID: verificationCode.ID}, nil)
+			if err != nil {
+				return err
+			}
+			err = dao.NewVerificationCodeRepository().LockVerificationCode(ctx, where.Email, where.DeviceIdentifier, lockout)
+			if err != nil {
+				return err
+			}
+			checkErr = verificationCodeLockedError(lockout)
+			return nil
+		}
+		_, err = dao.NewVerificationCodeRepository().UpdateVerificationCode(ctx, tx, &entity.VerificationCode{ID: verificationCode.ID}, &entity.VerificationCode{Attempts: attempts})
+		if err != nil {
+			return err
+		}
+		checkErr = errVerificationCodeNotFound.WithMetadata("attempts_left", strconv.Itoa(cfg.VerificationCodeMaxAttempts-int(attempts)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	} else if checkErr != nil {
+		return nil, checkErr
+	}
+	return verificationCode, nil
+}
+
+// newVerificationCodeExpirationTime returns when a code sent now expires.
+func newVerificationCodeExpirationTime(cfg *config.Config) (time.Time, error) {
+	ttl, err := time.ParseDuration(cfg.VerificationCodeTtl)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().UTC().Add(ttl), nil
+}
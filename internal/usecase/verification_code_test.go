@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/daniarmas/api_go/internal/entity"
+	"github.com/daniarmas/api_go/pkg/apperror"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckVerificationCode(t *testing.T) {
+	dao := newMemoryRepository()
+	sqldb := newTestSqldb(t)
+	cfg := &config.Config{VerificationCodeMaxAttempts: 3, VerificationCodeLockout: "30m"}
+	ctx := context.Background()
+	where := &entity.VerificationCode{Email: "user@example.com", DeviceIdentifier: "device", Type: "SignIn"}
+	code, _ := dao.NewVerificationCodeRepository().CreateVerificationCode(ctx, nil, &entity.VerificationCode{Code: "123456", Email: where.Email, DeviceIdentifier: where.DeviceIdentifier, Type: where.Type, ExpirationTime: time.Now().UTC().Add(time.Hour)})
+
+	// A wrong guess counts as an attempt.
+	for _, attemptsLeft := range []string{"2", "1"} {
+		_, err := checkVerificationCode(ctx, sqldb, dao, cfg, "000000", where)
+		if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.NotFound || appErr.Metadata["attempts_left"] != attemptsLeft {
+			t.Fatalf("checkVerificationCode() of a wrong code = %v, want not found with %s attempts left", err, attemptsLeft)
+		}
+	}
+	if res, err := checkVerificationCode(ctx, sqldb, dao, cfg, "123456", where); err != nil || *res.ID != *code.ID {
+		t.Fatalf("checkVerificationCode() of the code = %v, %v", res, err)
+	}
+
+	// The last attempt deletes the code and locks the email out on the device.
+	_, err := checkVerificationCode(ctx, sqldb, dao, cfg, "000000", where)
+	if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.ResourceExhausted {
+		t.Fatalf("checkVerificationCode() of the last attempt = %v, want resource exhausted", err)
+	}
+	if len(dao.verificationCodes) != 0 {
+		t.Fatal("the code survived the last attempt")
+	}
+	dao.NewVerificationCodeRepository().CreateVerificationCode(ctx, nil, &entity.VerificationCode{Code: "654321", Email: where.Email, DeviceIdentifier: where.DeviceIdentifier, Type: where.Type, ExpirationTime: time.Now().UTC().Add(time.Hour)})
+	_, err = checkVerificationCode(ctx, sqldb, dao, cfg, "654321", where)
+	if appErr, ok := err.(*apperror.Error); !ok || appErr.Code != codes.ResourceExhausted {
+		t.Fatalf("checkVerificationCode() while locked out = %v, want resource exhausted", err)
+	}
+
+	// An expired code is refused even when it's right.
+	expired := &entity.VerificationCode{Email: "other@example.com", DeviceIdentifier: "device", Type: "SignIn"}
+	dao.NewVerificationCodeRepository().CreateVerificationCode(ctx, nil, &entity.VerificationCode{Code: "123456", Email: expired.Email, DeviceIdentifier: expired.DeviceIdentifier, Type: expired.Type, ExpirationTime: time.Now().UTC().Add(-time.Minute)})
+	_, err = checkVerificationCode(ctx, sqldb, dao, cfg, "123456", expired)
+	if err != errVerificationCodeExpired {
+		t.Fatalf("checkVerificationCode() of an expired code = %v, want %v", err, errVerificationCodeExpired)
+	}
+	_, err = checkVerificationCode(ctx, sqldb, dao, cfg, "123456", &entity.VerificationCode{Email: "none@example.com", DeviceIdentifier: "device", Type: "SignIn"})
+	if err != errVerificationCodeNotFound {
+		t.Fatalf("checkVerificationCode() without a code = %v, want %v", err, errVerificationCodeNotFound)
+	}
+}
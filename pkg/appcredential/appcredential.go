@@ -0,0 +1,67 @@
+// Package appcredential builds and checks the access tokens of the
+// applications. An access token is "<credential id>.<secret>", and only the
+// SHA-256 hash of the secret is stored.
+package appcredential
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// secretSize is the number of random bytes of a secret.
+const secretSize = 32
+
+var ErrMalformedToken = errors.New("appcredential: malformed access token")
+
+// legacyNamespace derives the credential ids of the access tokens issued
+// before the credentials.
+var legacyNamespace = uuid.MustParse("5b0c8f4e-3a7d-4c36-9d0e-7f2a61c9b8d4")
+
+// Generate returns a new access token of the credential and the hash to store.
+func Generate(id uuid.UUID) (token string, hash string, err error) {
+	secret := make([]byte, secretSize)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return id.String() + "." + encoded, Hash(encoded), nil
+}
+
+// Parse splits the access token into the credential id and the secret.
+func Parse(token string) (uuid.UUID, string, error) {
+	idSecret := strings.SplitN(token, ".", 2)
+	if len(idSecret) != 2 || idSecret[1] == "" {
+		return uuid.Nil, "", ErrMalformedToken
+	}
+	id, err := uuid.Parse(idSecret[0])
+	if err != nil {
+		return uuid.Nil, "", ErrMalformedToken
+	}
+	return id, idSecret[1], nil
+}
+
+// ParseLegacy returns the credential id of an access token issued before the
+// credentials, which is derived from the token, and the token as its secret.
+func ParseLegacy(token string) (uuid.UUID, string) {
+	return uuid.NewSHA1(legacyNamespace, []byte(token)), token
+}
+
+// Hash returns the hex encoded SHA-256 of the secret. The secrets are random,
+// so a fast hash is enough to keep them unusable when the table leaks.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify reports whether the secret has the hash, in constant time.
+func Verify(secret string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(secret)), []byte(hash)) == 1
+}
@@ -0,0 +1,211 @@
+package apperror
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
+)
+
+// Domain is the ErrorInfo domain of the errors that don't set their own.
+const Domain = "mool"
+
+// Error is an error of the domain. It carries the gRPC code and the reason
+// the clients act on, so the handlers don't need to match error messages.
+type Error struct {
+	Code codes.Code
+	// Reason is a stable UPPER_SNAKE_CASE identifier of the error.
+	Reason   string
+	Domain   string
+	Message  string
+	Metadata map[string]string
+	// Details are sent along the ErrorInfo, e.g. a BadRequest or a QuotaFailure.
+	Details []proto.Message
+	err     error
+}
+
+// New returns an error whose reason is derived from the message, e.g.
+// "order not found" has the reason ORDER_NOT_FOUND.
+func New(code codes.Code, message string) *Error {
+	return &Error{Code: code, Reason: reason(message), Domain: Domain, Message: message}
+}
+
+func NotFound(message string) *Error {
+	return New(codes.NotFound, message)
+}
+
+func AlreadyExists(message string) *Error {
+	return New(codes.AlreadyExists, message)
+}
+
+func InvalidArgument(message string) *Error {
+	return New(codes.InvalidArgument, message)
+}
+
+func FailedPrecondition(message string) *Error {
+	return New(codes.FailedPrecondition, message)
+}
+
+func PermissionDenied(message string) *Error {
+	return New(codes.PermissionDenied, message)
+}
+
+func Unauthenticated(message string) *Error {
+	return New(codes.Unauthenticated, message)
+}
+
+func ResourceExhausted(message string) *Error {
+	return New(codes.ResourceExhausted, message)
+}
+
+func Internal(message string) *Error {
+	return New(codes.Internal, message)
+}
+
+var (
+	// ErrNotFound is returned by the datasources when no record matches.
+	ErrNotFound = NotFound("record not found")
+
+	ErrUnauthenticatedUser = Unauthenticated("unauthenticated user")
+	ErrPermissionDenied    = PermissionDenied("permission denied")
+)
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether the target is an *Error with the same code and reason, so
+// copies made by the With methods still match the sentinel they came from.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code && e.Reason == t.Reason
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	if e.Metadata != nil {
+		c.Metadata = make(map[string]string, len(e.Metadata))
+		for k, v := range e.Metadata {
+			c.Metadata[k] = v
+		}
+	}
+	c.Details = append([]proto.Message(nil), e.Details...)
+	return &c
+}
+
+// WithReason returns a copy of the error with another reason.
+func (e *Error) WithReason(reason string) *Error {
+	c := e.clone()
+	c.Reason = reason
+	return c
+}
+
+// WithDomain returns a copy of the error with another ErrorInfo domain.
+func (e *Error) WithDomain(domain string) *Error {
+	c := e.clone()
+	c.Domain = domain
+	return c
+}
+
+// WithMetadata returns a copy of the error with the key added to its metadata.
+func (e *Error) WithMetadata(key string, value string) *Error {
+	c := e.clone()
+	if c.Metadata == nil {
+		c.Metadata = map[string]string{}
+	}
+	c.Metadata[key] = value
+	return c
+}
+
+// WithDetails returns a copy of the error with the details appended.
+func (e *Error) WithDetails(details ...proto.Message) *Error {
+	c := e.clone()
+	c.Details = append(c.Details, details...)
+	return c
+}
+
+// Wrap returns a copy of the error that unwraps to err.
+func (e *Error) Wrap(err error) *Error {
+	c := e.clone()
+	c.err = err
+	return c
+}
+
+// GRPCStatus returns the status sent to the clients. Its message is the error
+// message capitalized, and the details start with an ErrorInfo.
+func (e *Error) GRPCStatus() *status.Status {
+	s := status.New(e.Code, capitalize(e.Message)).Proto()
+	details := make([]proto.Message, 0, len(e.Details)+1)
+	details = append(details, &epb.ErrorInfo{Reason: e.Reason, Domain: e.Domain, Metadata: e.Metadata})
+	details = append(details, e.Details...)
+	for _, detail := range details {
+		value, err := anypb.New(detail)
+		if err != nil {
+			continue
+		}
+		s.Details = append(s.Details, value)
+	}
+	return status.FromProto(s)
+}
+
+// Convert returns the *Error in the chain of err. A gorm record not found
+// becomes ErrNotFound. It returns nil for any other error.
+func Convert(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound.Wrap(err)
+	}
+	return nil
+}
+
+// IsNotFound reports whether err means that no record matched.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, gorm.ErrRecordNotFound)
+}
+
+func reason(message string) string {
+	var b strings.Builder
+	underscore := false
+	lower := false
+	for _, r := range message {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			// Split camel case words, e.g. ThumbnailObject is THUMBNAIL_OBJECT.
+			if lower && unicode.IsUpper(r) {
+				underscore = true
+			}
+			lower = unicode.IsLower(r)
+			if underscore && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			underscore = false
+			b.WriteRune(unicode.ToUpper(r))
+		} else {
+			underscore = true
+			lower = false
+		}
+	}
+	return b.String()
+}
+
+func capitalize(message string) string {
+	for i, r := range message {
+		return string(unicode.ToUpper(r)) + message[i+len(string(r)):]
+	}
+	return message
+}
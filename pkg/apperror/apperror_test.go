@@ -0,0 +1,65 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func TestReason(t *testing.T) {
+	tests := map[string]string{
+		"order not found":                 "ORDER_NOT_FOUND",
+		"only can have 10 user_address":   "ONLY_CAN_HAVE_10_USER_ADDRESS",
+		"ThumbnailObject missing":         "THUMBNAIL_OBJECT_MISSING",
+		"authorization token expired":     "AUTHORIZATION_TOKEN_EXPIRED",
+		"the items in the cart, from one": "THE_ITEMS_IN_THE_CART_FROM_ONE",
+	}
+	for message, want := range tests {
+		if got := reason(message); got != want {
+			t.Errorf("reason(%q) = %q, want %q", message, got, want)
+		}
+	}
+}
+
+func TestIsMatchesCopies(t *testing.T) {
+	err := fmt.Errorf("get order: %w", ErrPermissionDenied.WithMetadata("businessId", "1"))
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Fatal("expected the copy to match the sentinel")
+	}
+	if errors.Is(NotFound("order not found"), ErrNotFound) {
+		t.Fatal("expected different reasons not to match")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if Convert(errors.New("boom")) != nil {
+		t.Fatal("expected nil for an unknown error")
+	}
+	appErr := Convert(fmt.Errorf("take: %w", gorm.ErrRecordNotFound))
+	if appErr == nil || appErr.Code != codes.NotFound || !IsNotFound(appErr) {
+		t.Fatalf("expected a not found error, got %v", appErr)
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	err := InvalidArgument("no availability").WithMetadata("availability", "2").WithDetails(&epb.QuotaFailure{})
+	st := err.GRPCStatus()
+	if st.Code() != codes.InvalidArgument || st.Message() != "No availability" {
+		t.Fatalf("unexpected status %v", st)
+	}
+	details := st.Details()
+	if len(details) != 2 {
+		t.Fatalf("expected 2 details, got %d", len(details))
+	}
+	info, ok := details[0].(*epb.ErrorInfo)
+	if !ok || info.Reason != "NO_AVAILABILITY" || info.Domain != Domain || info.Metadata["availability"] != "2" {
+		t.Fatalf("unexpected error info %v", details[0])
+	}
+	if _, ok := details[1].(*epb.QuotaFailure); !ok {
+		t.Fatalf("expected a quota failure, got %T", details[1])
+	}
+}
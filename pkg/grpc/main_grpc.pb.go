@@ -32,7 +32,10 @@ type AuthenticationServiceClient interface {
 	SessionExists(ctx context.Context, in *SessionExistsRequest, opts ...grpc.CallOption) (*SessionExistsResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	ListSession(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeAllOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendPushNotification(ctx context.Context, in *SendPushNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListJwk(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJwkResponse, error)
 }
 
 type authenticationServiceClient struct {
@@ -124,6 +127,24 @@ func (c *authenticationServiceClient) ListSession(ctx context.Context, in *empty
 	return out, nil
 }
 
+func (c *authenticationServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/main.AuthenticationService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) RevokeAllOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/main.AuthenticationService/RevokeAllOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticationServiceClient) SendPushNotification(ctx context.Context, in *SendPushNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/main.AuthenticationService/SendPushNotification", in, out, opts...)
@@ -133,6 +154,15 @@ func (c *authenticationServiceClient) SendPushNotification(ctx context.Context,
 	return out, nil
 }
 
+func (c *authenticationServiceClient) ListJwk(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJwkResponse, error) {
+	out := new(ListJwkResponse)
+	err := c.cc.Invoke(ctx, "/main.AuthenticationService/ListJwk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility
@@ -146,7 +176,10 @@ type AuthenticationServiceServer interface {
 	SessionExists(context.Context, *SessionExistsRequest) (*SessionExistsResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	ListSession(context.Context, *emptypb.Empty) (*ListSessionResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	RevokeAllOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	SendPushNotification(context.Context, *SendPushNotificationRequest) (*emptypb.Empty, error)
+	ListJwk(context.Context, *emptypb.Empty) (*ListJwkResponse, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -181,9 +214,18 @@ func (UnimplementedAuthenticationServiceServer) RefreshToken(context.Context, *R
 func (UnimplementedAuthenticationServiceServer) ListSession(context.Context, *emptypb.Empty) (*ListSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSession not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) RevokeAllOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) SendPushNotification(context.Context, *SendPushNotificationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPushNotification not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) ListJwk(context.Context, *emptypb.Empty) (*ListJwkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJwk not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 
 // UnsafeAuthenticationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -359,6 +401,42 @@ func _AuthenticationService_ListSession_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AuthenticationService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AuthenticationService/RevokeAllOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RevokeAllOtherSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthenticationService_SendPushNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendPushNotificationRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +455,24 @@ func _AuthenticationService_SendPushNotification_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_ListJwk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ListJwk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AuthenticationService/ListJwk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ListJwk(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,10 +516,22 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSession",
 			Handler:    _AuthenticationService_ListSession_Handler,
 		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthenticationService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _AuthenticationService_RevokeAllOtherSessions_Handler,
+		},
 		{
 			MethodName: "SendPushNotification",
 			Handler:    _AuthenticationService_SendPushNotification_Handler,
 		},
+		{
+			MethodName: "ListJwk",
+			Handler:    _AuthenticationService_ListJwk_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
@@ -792,6 +900,10 @@ type BusinessServiceClient interface {
 	// rpc CreateBusinessSchedule (CreateBusinessScheduleRequest) returns (CreateBusinessScheduleResponse) {}
 	UpdateBusiness(ctx context.Context, in *UpdateBusinessRequest, opts ...grpc.CallOption) (*Business, error)
 	BusinessIsInRange(ctx context.Context, in *BusinessIsInRangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SearchBusiness(ctx context.Context, in *SearchBusinessRequest, opts ...grpc.CallOption) (*SearchBusinessResponse, error)
+	ListBusinessInBounds(ctx context.Context, in *ListBusinessInBoundsRequest, opts ...grpc.CallOption) (*ListBusinessInBoundsResponse, error)
+	UpdateBusinessDeliveryZone(ctx context.Context, in *UpdateBusinessDeliveryZoneRequest, opts ...grpc.CallOption) (*BusinessDeliveryZone, error)
+	GetBusinessDeliveryZone(ctx context.Context, in *GetBusinessDeliveryZoneRequest, opts ...grpc.CallOption) (*BusinessDeliveryZone, error)
 	CreatePartnerApplication(ctx context.Context, in *CreatePartnerApplicationRequest, opts ...grpc.CallOption) (*PartnerApplication, error)
 	ListPartnerApplication(ctx context.Context, in *ListPartnerApplicationRequest, opts ...grpc.CallOption) (*ListPartnerApplicationResponse, error)
 	UpdatePartnerApplication(ctx context.Context, in *UpdatePartnerApplicationRequest, opts ...grpc.CallOption) (*PartnerApplication, error)
@@ -864,6 +976,42 @@ func (c *businessServiceClient) BusinessIsInRange(ctx context.Context, in *Busin
 	return out, nil
 }
 
+func (c *businessServiceClient) SearchBusiness(ctx context.Context, in *SearchBusinessRequest, opts ...grpc.CallOption) (*SearchBusinessResponse, error) {
+	out := new(SearchBusinessResponse)
+	err := c.cc.Invoke(ctx, "/main.BusinessService/SearchBusiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessServiceClient) ListBusinessInBounds(ctx context.Context, in *ListBusinessInBoundsRequest, opts ...grpc.CallOption) (*ListBusinessInBoundsResponse, error) {
+	out := new(ListBusinessInBoundsResponse)
+	err := c.cc.Invoke(ctx, "/main.BusinessService/ListBusinessInBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessServiceClient) UpdateBusinessDeliveryZone(ctx context.Context, in *UpdateBusinessDeliveryZoneRequest, opts ...grpc.CallOption) (*BusinessDeliveryZone, error) {
+	out := new(BusinessDeliveryZone)
+	err := c.cc.Invoke(ctx, "/main.BusinessService/UpdateBusinessDeliveryZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessServiceClient) GetBusinessDeliveryZone(ctx context.Context, in *GetBusinessDeliveryZoneRequest, opts ...grpc.CallOption) (*BusinessDeliveryZone, error) {
+	out := new(BusinessDeliveryZone)
+	err := c.cc.Invoke(ctx, "/main.BusinessService/GetBusinessDeliveryZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *businessServiceClient) CreatePartnerApplication(ctx context.Context, in *CreatePartnerApplicationRequest, opts ...grpc.CallOption) (*PartnerApplication, error) {
 	out := new(PartnerApplication)
 	err := c.cc.Invoke(ctx, "/main.BusinessService/CreatePartnerApplication", in, out, opts...)
@@ -947,6 +1095,10 @@ type BusinessServiceServer interface {
 	// rpc CreateBusinessSchedule (CreateBusinessScheduleRequest) returns (CreateBusinessScheduleResponse) {}
 	UpdateBusiness(context.Context, *UpdateBusinessRequest) (*Business, error)
 	BusinessIsInRange(context.Context, *BusinessIsInRangeRequest) (*emptypb.Empty, error)
+	SearchBusiness(context.Context, *SearchBusinessRequest) (*SearchBusinessResponse, error)
+	ListBusinessInBounds(context.Context, *ListBusinessInBoundsRequest) (*ListBusinessInBoundsResponse, error)
+	UpdateBusinessDeliveryZone(context.Context, *UpdateBusinessDeliveryZoneRequest) (*BusinessDeliveryZone, error)
+	GetBusinessDeliveryZone(context.Context, *GetBusinessDeliveryZoneRequest) (*BusinessDeliveryZone, error)
 	CreatePartnerApplication(context.Context, *CreatePartnerApplicationRequest) (*PartnerApplication, error)
 	ListPartnerApplication(context.Context, *ListPartnerApplicationRequest) (*ListPartnerApplicationResponse, error)
 	UpdatePartnerApplication(context.Context, *UpdatePartnerApplicationRequest) (*PartnerApplication, error)
@@ -980,6 +1132,18 @@ func (UnimplementedBusinessServiceServer) UpdateBusiness(context.Context, *Updat
 func (UnimplementedBusinessServiceServer) BusinessIsInRange(context.Context, *BusinessIsInRangeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BusinessIsInRange not implemented")
 }
+func (UnimplementedBusinessServiceServer) SearchBusiness(context.Context, *SearchBusinessRequest) (*SearchBusinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBusiness not implemented")
+}
+func (UnimplementedBusinessServiceServer) ListBusinessInBounds(context.Context, *ListBusinessInBoundsRequest) (*ListBusinessInBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessInBounds not implemented")
+}
+func (UnimplementedBusinessServiceServer) UpdateBusinessDeliveryZone(context.Context, *UpdateBusinessDeliveryZoneRequest) (*BusinessDeliveryZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessDeliveryZone not implemented")
+}
+func (UnimplementedBusinessServiceServer) GetBusinessDeliveryZone(context.Context, *GetBusinessDeliveryZoneRequest) (*BusinessDeliveryZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessDeliveryZone not implemented")
+}
 func (UnimplementedBusinessServiceServer) CreatePartnerApplication(context.Context, *CreatePartnerApplicationRequest) (*PartnerApplication, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartnerApplication not implemented")
 }
@@ -1125,6 +1289,78 @@ func _BusinessService_BusinessIsInRange_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BusinessService_SearchBusiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBusinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServiceServer).SearchBusiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BusinessService/SearchBusiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServiceServer).SearchBusiness(ctx, req.(*SearchBusinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusinessService_ListBusinessInBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessInBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServiceServer).ListBusinessInBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BusinessService/ListBusinessInBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServiceServer).ListBusinessInBounds(ctx, req.(*ListBusinessInBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusinessService_UpdateBusinessDeliveryZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessDeliveryZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServiceServer).UpdateBusinessDeliveryZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BusinessService/UpdateBusinessDeliveryZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServiceServer).UpdateBusinessDeliveryZone(ctx, req.(*UpdateBusinessDeliveryZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusinessService_GetBusinessDeliveryZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessDeliveryZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServiceServer).GetBusinessDeliveryZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BusinessService/GetBusinessDeliveryZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServiceServer).GetBusinessDeliveryZone(ctx, req.(*GetBusinessDeliveryZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BusinessService_CreatePartnerApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartnerApplicationRequest)
 	if err := dec(in); err != nil {
@@ -1300,6 +1536,22 @@ var BusinessService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BusinessIsInRange",
 			Handler:    _BusinessService_BusinessIsInRange_Handler,
 		},
+		{
+			MethodName: "SearchBusiness",
+			Handler:    _BusinessService_SearchBusiness_Handler,
+		},
+		{
+			MethodName: "ListBusinessInBounds",
+			Handler:    _BusinessService_ListBusinessInBounds_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessDeliveryZone",
+			Handler:    _BusinessService_UpdateBusinessDeliveryZone_Handler,
+		},
+		{
+			MethodName: "GetBusinessDeliveryZone",
+			Handler:    _BusinessService_GetBusinessDeliveryZone_Handler,
+		},
 		{
 			MethodName: "CreatePartnerApplication",
 			Handler:    _BusinessService_CreatePartnerApplication_Handler,
@@ -1348,6 +1600,7 @@ type ItemServiceClient interface {
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SearchItem(ctx context.Context, in *SearchItemRequest, opts ...grpc.CallOption) (*SearchItemResponse, error)
 	SearchItemByBusiness(ctx context.Context, in *SearchItemByBusinessRequest, opts ...grpc.CallOption) (*SearchItemByBusinessResponse, error)
+	SuggestSearch(ctx context.Context, in *SuggestSearchRequest, opts ...grpc.CallOption) (*SuggestSearchResponse, error)
 }
 
 type itemServiceClient struct {
@@ -1421,6 +1674,15 @@ func (c *itemServiceClient) SearchItemByBusiness(ctx context.Context, in *Search
 	return out, nil
 }
 
+func (c *itemServiceClient) SuggestSearch(ctx context.Context, in *SuggestSearchRequest, opts ...grpc.CallOption) (*SuggestSearchResponse, error) {
+	out := new(SuggestSearchResponse)
+	err := c.cc.Invoke(ctx, "/main.ItemService/SuggestSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemServiceServer is the server API for ItemService service.
 // All implementations must embed UnimplementedItemServiceServer
 // for forward compatibility
@@ -1432,6 +1694,7 @@ type ItemServiceServer interface {
 	DeleteItem(context.Context, *DeleteItemRequest) (*emptypb.Empty, error)
 	SearchItem(context.Context, *SearchItemRequest) (*SearchItemResponse, error)
 	SearchItemByBusiness(context.Context, *SearchItemByBusinessRequest) (*SearchItemByBusinessResponse, error)
+	SuggestSearch(context.Context, *SuggestSearchRequest) (*SuggestSearchResponse, error)
 	mustEmbedUnimplementedItemServiceServer()
 }
 
@@ -1460,6 +1723,9 @@ func (UnimplementedItemServiceServer) SearchItem(context.Context, *SearchItemReq
 func (UnimplementedItemServiceServer) SearchItemByBusiness(context.Context, *SearchItemByBusinessRequest) (*SearchItemByBusinessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchItemByBusiness not implemented")
 }
+func (UnimplementedItemServiceServer) SuggestSearch(context.Context, *SuggestSearchRequest) (*SuggestSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSearch not implemented")
+}
 func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
 
 // UnsafeItemServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1599,6 +1865,24 @@ func _ItemService_SearchItemByBusiness_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ItemService_SuggestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).SuggestSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.ItemService/SuggestSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).SuggestSearch(ctx, req.(*SuggestSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ItemService_ServiceDesc is the grpc.ServiceDesc for ItemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1634,6 +1918,10 @@ var ItemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchItemByBusiness",
 			Handler:    _ItemService_SearchItemByBusiness_Handler,
 		},
+		{
+			MethodName: "SuggestSearch",
+			Handler:    _ItemService_SuggestSearch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
@@ -2024,6 +2312,10 @@ type OrderServiceClient interface {
 	ListOrderedItem(ctx context.Context, in *ListOrderedItemRequest, opts ...grpc.CallOption) (*ListOrderedItemResponse, error)
 	GetCheckoutInfo(ctx context.Context, in *GetCheckoutInfoRequest, opts ...grpc.CallOption) (*GetCheckoutInfoResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrderService_WatchOrderClient, error)
+	ListBusinessOrder(ctx context.Context, in *ListBusinessOrderRequest, opts ...grpc.CallOption) (*ListBusinessOrderResponse, error)
+	GetBusinessOrder(ctx context.Context, in *GetBusinessOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	UpdateBusinessOrder(ctx context.Context, in *UpdateBusinessOrderRequest, opts ...grpc.CallOption) (*Order, error)
 }
 
 type orderServiceClient struct {
@@ -2097,6 +2389,65 @@ func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrderService_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], "/main.OrderService/WatchOrder", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderService_WatchOrderClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type orderServiceWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceWatchOrderClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderServiceClient) ListBusinessOrder(ctx context.Context, in *ListBusinessOrderRequest, opts ...grpc.CallOption) (*ListBusinessOrderResponse, error) {
+	out := new(ListBusinessOrderResponse)
+	err := c.cc.Invoke(ctx, "/main.OrderService/ListBusinessOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetBusinessOrder(ctx context.Context, in *GetBusinessOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/main.OrderService/GetBusinessOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateBusinessOrder(ctx context.Context, in *UpdateBusinessOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/main.OrderService/UpdateBusinessOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
@@ -2108,6 +2459,10 @@ type OrderServiceServer interface {
 	ListOrderedItem(context.Context, *ListOrderedItemRequest) (*ListOrderedItemResponse, error)
 	GetCheckoutInfo(context.Context, *GetCheckoutInfoRequest) (*GetCheckoutInfoResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error)
+	WatchOrder(*WatchOrderRequest, OrderService_WatchOrderServer) error
+	ListBusinessOrder(context.Context, *ListBusinessOrderRequest) (*ListBusinessOrderResponse, error)
+	GetBusinessOrder(context.Context, *GetBusinessOrderRequest) (*Order, error)
+	UpdateBusinessOrder(context.Context, *UpdateBusinessOrderRequest) (*Order, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -2136,6 +2491,18 @@ func (UnimplementedOrderServiceServer) GetCheckoutInfo(context.Context, *GetChec
 func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) WatchOrder(*WatchOrderRequest, OrderService_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) ListBusinessOrder(context.Context, *ListBusinessOrderRequest) (*ListBusinessOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) GetBusinessOrder(context.Context, *GetBusinessOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateBusinessOrder(context.Context, *UpdateBusinessOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2275,6 +2642,81 @@ func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).WatchOrder(m, &orderServiceWatchOrderServer{stream})
+}
+
+type OrderService_WatchOrderServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type orderServiceWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceWatchOrderServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OrderService_ListBusinessOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListBusinessOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrderService/ListBusinessOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListBusinessOrder(ctx, req.(*ListBusinessOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetBusinessOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetBusinessOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrderService/GetBusinessOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetBusinessOrder(ctx, req.(*GetBusinessOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateBusinessOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBusinessOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateBusinessOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.OrderService/UpdateBusinessOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateBusinessOrder(ctx, req.(*UpdateBusinessOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2310,8 +2752,26 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _OrderService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "ListBusinessOrder",
+			Handler:    _OrderService_ListBusinessOrder_Handler,
+		},
+		{
+			MethodName: "GetBusinessOrder",
+			Handler:    _OrderService_GetBusinessOrder_Handler,
+		},
+		{
+			MethodName: "UpdateBusinessOrder",
+			Handler:    _OrderService_UpdateBusinessOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrderService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
 }
 
@@ -2811,6 +3271,200 @@ var PaymentMethodService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "main.proto",
 }
 
+// BanServiceClient is the client API for BanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BanServiceClient interface {
+	BanDevice(ctx context.Context, in *BanDeviceRequest, opts ...grpc.CallOption) (*BannedDevice, error)
+	UnbanDevice(ctx context.Context, in *UnbanDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BannedUser, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type banServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBanServiceClient(cc grpc.ClientConnInterface) BanServiceClient {
+	return &banServiceClient{cc}
+}
+
+func (c *banServiceClient) BanDevice(ctx context.Context, in *BanDeviceRequest, opts ...grpc.CallOption) (*BannedDevice, error) {
+	out := new(BannedDevice)
+	err := c.cc.Invoke(ctx, "/main.BanService/BanDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banServiceClient) UnbanDevice(ctx context.Context, in *UnbanDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/main.BanService/UnbanDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banServiceClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BannedUser, error) {
+	out := new(BannedUser)
+	err := c.cc.Invoke(ctx, "/main.BanService/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banServiceClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/main.BanService/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BanServiceServer is the server API for BanService service.
+// All implementations must embed UnimplementedBanServiceServer
+// for forward compatibility
+type BanServiceServer interface {
+	BanDevice(context.Context, *BanDeviceRequest) (*BannedDevice, error)
+	UnbanDevice(context.Context, *UnbanDeviceRequest) (*emptypb.Empty, error)
+	BanUser(context.Context, *BanUserRequest) (*BannedUser, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBanServiceServer()
+}
+
+// UnimplementedBanServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBanServiceServer struct {
+}
+
+func (UnimplementedBanServiceServer) BanDevice(context.Context, *BanDeviceRequest) (*BannedDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanDevice not implemented")
+}
+func (UnimplementedBanServiceServer) UnbanDevice(context.Context, *UnbanDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanDevice not implemented")
+}
+func (UnimplementedBanServiceServer) BanUser(context.Context, *BanUserRequest) (*BannedUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedBanServiceServer) UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedBanServiceServer) mustEmbedUnimplementedBanServiceServer() {}
+
+// UnsafeBanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BanServiceServer will
+// result in compilation errors.
+type UnsafeBanServiceServer interface {
+	mustEmbedUnimplementedBanServiceServer()
+}
+
+func RegisterBanServiceServer(s grpc.ServiceRegistrar, srv BanServiceServer) {
+	s.RegisterService(&BanService_ServiceDesc, srv)
+}
+
+func _BanService_BanDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServiceServer).BanDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BanService/BanDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServiceServer).BanDevice(ctx, req.(*BanDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanService_UnbanDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServiceServer).UnbanDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BanService/UnbanDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServiceServer).UnbanDevice(ctx, req.(*UnbanDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanService_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServiceServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BanService/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServiceServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanService_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServiceServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.BanService/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServiceServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BanService_ServiceDesc is the grpc.ServiceDesc for BanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.BanService",
+	HandlerType: (*BanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BanDevice",
+			Handler:    _BanService_BanDevice_Handler,
+		},
+		{
+			MethodName: "UnbanDevice",
+			Handler:    _BanService_UnbanDevice_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _BanService_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _BanService_UnbanUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "main.proto",
+}
+
 // ObjectStorageServiceClient is the client API for ObjectStorageService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -2896,3 +3550,161 @@ var ObjectStorageService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
 }
+
+// AnalyticsServiceClient is the client API for AnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnalyticsServiceClient interface {
+	CollectAnalytics(ctx context.Context, in *CollectAnalyticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBusinessAnalytics(ctx context.Context, in *GetBusinessAnalyticsRequest, opts ...grpc.CallOption) (*GetBusinessAnalyticsResponse, error)
+	ListItemAnalytics(ctx context.Context, in *ListItemAnalyticsRequest, opts ...grpc.CallOption) (*ListItemAnalyticsResponse, error)
+}
+
+type analyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalyticsServiceClient(cc grpc.ClientConnInterface) AnalyticsServiceClient {
+	return &analyticsServiceClient{cc}
+}
+
+func (c *analyticsServiceClient) CollectAnalytics(ctx context.Context, in *CollectAnalyticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/main.AnalyticsService/CollectAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetBusinessAnalytics(ctx context.Context, in *GetBusinessAnalyticsRequest, opts ...grpc.CallOption) (*GetBusinessAnalyticsResponse, error) {
+	out := new(GetBusinessAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/main.AnalyticsService/GetBusinessAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListItemAnalytics(ctx context.Context, in *ListItemAnalyticsRequest, opts ...grpc.CallOption) (*ListItemAnalyticsResponse, error) {
+	out := new(ListItemAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/main.AnalyticsService/ListItemAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalyticsServiceServer is the server API for AnalyticsService service.
+// All implementations must embed UnimplementedAnalyticsServiceServer
+// for forward compatibility
+type AnalyticsServiceServer interface {
+	CollectAnalytics(context.Context, *CollectAnalyticsRequest) (*emptypb.Empty, error)
+	GetBusinessAnalytics(context.Context, *GetBusinessAnalyticsRequest) (*GetBusinessAnalyticsResponse, error)
+	ListItemAnalytics(context.Context, *ListItemAnalyticsRequest) (*ListItemAnalyticsResponse, error)
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+// UnimplementedAnalyticsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAnalyticsServiceServer struct {
+}
+
+func (UnimplementedAnalyticsServiceServer) CollectAnalytics(context.Context, *CollectAnalyticsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAnalytics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetBusinessAnalytics(context.Context, *GetBusinessAnalyticsRequest) (*GetBusinessAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessAnalytics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListItemAnalytics(context.Context, *ListItemAnalyticsRequest) (*ListItemAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemAnalytics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
+
+// UnsafeAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeAnalyticsServiceServer interface {
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+func RegisterAnalyticsServiceServer(s grpc.ServiceRegistrar, srv AnalyticsServiceServer) {
+	s.RegisterService(&AnalyticsService_ServiceDesc, srv)
+}
+
+func _AnalyticsService_CollectAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CollectAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AnalyticsService/CollectAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CollectAnalytics(ctx, req.(*CollectAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetBusinessAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetBusinessAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AnalyticsService/GetBusinessAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetBusinessAnalytics(ctx, req.(*GetBusinessAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListItemAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListItemAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/main.AnalyticsService/ListItemAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListItemAnalytics(ctx, req.(*ListItemAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AnalyticsService",
+	HandlerType: (*AnalyticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CollectAnalytics",
+			Handler:    _AnalyticsService_CollectAnalytics_Handler,
+		},
+		{
+			MethodName: "GetBusinessAnalytics",
+			Handler:    _AnalyticsService_GetBusinessAnalytics_Handler,
+		},
+		{
+			MethodName: "ListItemAnalytics",
+			Handler:    _AnalyticsService_ListItemAnalytics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "main.proto",
+}
@@ -0,0 +1,223 @@
+// Package keyring holds the asymmetric keys that sign and verify the json web
+// tokens of the api, and the secret of the former HS256 tokens while they are
+// still accepted.
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/golang-jwt/jwt"
+)
+
+// Key is a signing key identified by the kid header of the tokens.
+type Key struct {
+	Id     string
+	Method jwt.SigningMethod
+	// PrivateKey is nil for the retired keys that only kept the public key.
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+}
+
+// Keyring has the active key, which signs the new tokens, and the retired
+// keys, which still verify the tokens they signed.
+type Keyring struct {
+	active       *Key
+	keys         map[string]*Key
+	legacySecret []byte
+	legacyUntil  time.Time
+}
+
+// New loads the keyring from JWT_KEYS_DIR with JWT_ACTIVE_KEY_ID as the
+// active key. The HS256 tokens signed with JWT_LEGACY_SECRET are verified
+// until JWT_LEGACY_UNTIL, an RFC 3339 time.
+func New(cfg *config.Config) (*Keyring, error) {
+	keyring, err := Load(cfg.JwtKeysDir, cfg.JwtActiveKeyId)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.JwtLegacySecret != "" {
+		until, err := time.Parse(time.RFC3339, cfg.JwtLegacyUntil)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: invalid JWT_LEGACY_UNTIL: %w", err)
+		}
+		keyring.SetLegacySecret([]byte(cfg.JwtLegacySecret), until)
+	}
+	return keyring, nil
+}
+
+// Load reads every <kid>.pem file of the directory. A file holds a PKCS #8 or
+// PKCS #1 private key, or the PKIX public key of a retired key. RSA keys sign
+// with RS256 and Ed25519 keys with EdDSA.
+func Load(dir string, activeId string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*Key, 0, len(paths))
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParseKey(strings.TrimSuffix(filepath.Base(path), ".pem"), data)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: %s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	return NewKeyring(activeId, keys...)
+}
+
+// NewKeyring builds a keyring from the keys. The active key must have its
+// private key.
+func NewKeyring(activeId string, keys ...*Key) (*Keyring, error) {
+	keyring := &Keyring{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if _, ok := keyring.keys[key.Id]; ok {
+			return nil, fmt.Errorf("keyring: duplicated key %s", key.Id)
+		}
+		keyring.keys[key.Id] = key
+	}
+	active, ok := keyring.keys[activeId]
+	if !ok {
+		return nil, fmt.Errorf("keyring: active key %q not found", activeId)
+	} else if active.PrivateKey == nil {
+		return nil, fmt.Errorf("keyring: active key %q has no private key", activeId)
+	}
+	keyring.active = active
+	return keyring, nil
+}
+
+// ParseKey parses a PEM encoded private or public key.
+func ParseKey(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, jwt.ErrKeyMustBePEMEncoded
+	}
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem block %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	key := &Key{Id: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method, key.PublicKey = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Method, key.PublicKey = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, errors.New("only rsa and ed25519 keys are supported")
+	}
+	return key, nil
+}
+
+// Active returns the key that signs the new tokens.
+func (k *Keyring) Active() *Key {
+	return k.active
+}
+
+// Key returns the key with the id, active or retired.
+func (k *Keyring) Key(id string) (*Key, bool) {
+	key, ok := k.keys[id]
+	return key, ok
+}
+
+// SetLegacySecret accepts the HS256 tokens signed with the secret until the
+// time, the tokens signed before the keyring.
+func (k *Keyring) SetLegacySecret(secret []byte, until time.Time) {
+	k.legacySecret, k.legacyUntil = secret, until
+}
+
+// LegacySecret returns the secret of the HS256 tokens, or false when they
+// aren't accepted at the time.
+func (k *Keyring) LegacySecret(now time.Time) ([]byte, bool) {
+	if len(k.legacySecret) == 0 || !now.Before(k.legacyUntil) {
+		return nil, false
+	}
+	return k.legacySecret, true
+}
+
+// JWK is the public part of a key as a json web key (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	// N and E are the modulus and exponent of the RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv and X are the curve and public key of the Ed25519 keys.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS returns the public keys of the keyring, sorted by id.
+func (k *Keyring) JWKS() []JWK {
+	res := make([]JWK, 0, len(k.keys))
+	for _, key := range k.keys {
+		jwk := JWK{Use: "sig", Alg: key.Method.Alg(), Kid: key.Id}
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+		res = append(res, jwk)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Kid < res[j].Kid })
+	return res
+}
+
+// ServeHTTP publishes the public keys as a JWKS document, e.g. on
+// /.well-known/jwks.json.
+func (k *Keyring) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := json.Marshal(struct {
+		Keys []JWK `json:"keys"`
+	}{k.JWKS()})
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Write(data)
+}
@@ -0,0 +1,90 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func writePem(t *testing.T, path string, blockType string, der []byte) {
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	_, edPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(edPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePem(t, filepath.Join(dir, "2022-10.pem"), "PRIVATE KEY", der)
+	rsaPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err = x509.MarshalPKIXPublicKey(&rsaPrivateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePem(t, filepath.Join(dir, "2022-09.pem"), "PUBLIC KEY", der)
+
+	if _, err := Load(dir, "2022-09"); err == nil {
+		t.Fatal("expected an error for an active key without private key")
+	}
+	keys, err := Load(dir, "2022-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.Active().Id != "2022-10" || keys.Active().Method.Alg() != "EdDSA" {
+		t.Fatalf("unexpected active key %+v", keys.Active())
+	}
+	if retired, ok := keys.Key("2022-09"); !ok || retired.Method.Alg() != "RS256" {
+		t.Fatalf("expected the retired rsa key, got %+v", retired)
+	}
+	jwks := keys.JWKS()
+	if len(jwks) != 2 || jwks[0].Kid != "2022-09" || jwks[0].Kty != "RSA" || jwks[0].E != "AQAB" || jwks[1].Kty != "OKP" || jwks[1].X == "" {
+		t.Fatalf("unexpected jwks %+v", jwks)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := NewKeyring("2022-10", &Key{Id: "2022-10", Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey, PublicKey: privateKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := httptest.NewRecorder()
+	keys.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	var jwks struct {
+		Keys []JWK `json:"keys"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &jwks); err != nil || recorder.Code != http.StatusOK || recorder.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected response %d %q: %v", recorder.Code, recorder.Body.String(), err)
+	}
+	if len(jwks.Keys) != 1 || jwks.Keys[0].Kid != "2022-10" || jwks.Keys[0].Crv != "Ed25519" {
+		t.Fatalf("unexpected jwks %+v", jwks.Keys)
+	}
+	recorder = httptest.NewRecorder()
+	keys.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("POST answered %d", recorder.Code)
+	}
+}
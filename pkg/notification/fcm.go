@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"firebase.google.com/go/messaging"
+)
+
+// FCMNotifier sends the messages as push notifications through Firebase Cloud
+// Messaging.
+type FCMNotifier struct {
+	client *messaging.Client
+}
+
+func NewFCMNotifier(client *messaging.Client) *FCMNotifier {
+	return &FCMNotifier{client: client}
+}
+
+func (n *FCMNotifier) Notify(ctx context.Context, message *Message) error {
+	if n.client == nil {
+		return errors.New("firebase messaging client not configured")
+	}
+	content, err := Render(message.Event, message.Locale, message.Data)
+	if err != nil {
+		return err
+	}
+	if content.Push == "" {
+		return fmt.Errorf("notification: no push template for event %s", message.Event)
+	}
+	_, err = n.client.Send(ctx, &messaging.Message{
+		Notification: &messaging.Notification{
+			Title: content.Subject,
+			Body:  content.Push,
+		},
+		Data:  message.Payload,
+		Token: message.To,
+	})
+	return err
+}
@@ -0,0 +1,41 @@
+package notification
+
+import "context"
+
+// Event is the kind of notification, it selects the templates of the message.
+type Event string
+
+const (
+	EventVerificationCode          Event = "verification_code"
+	EventSignInAlert               Event = "sign_in_alert"
+	EventOrderCreated              Event = "order_created"
+	EventOrderStatusChanged        Event = "order_status_changed"
+	EventPartnerApplicationDecided Event = "partner_application_decided"
+	EventSessionRevoked            Event = "session_revoked"
+	EventSessionClosed             Event = "session_closed"
+)
+
+// Events lists every event that has templates.
+var Events = []Event{EventVerificationCode, EventSignInAlert, EventOrderCreated, EventOrderStatusChanged, EventPartnerApplicationDecided, EventSessionRevoked, EventSessionClosed}
+
+// DefaultLocale is used when the user didn't choose a language or there are no
+// templates for the chosen one.
+const DefaultLocale = "es"
+
+// Message is a notification to a single recipient. It is stored as JSON in the
+// outbox until it's delivered.
+type Message struct {
+	Event  Event  `json:"event"`
+	Locale string `json:"locale"`
+	// To is the email address or the device registration token of the recipient.
+	To string `json:"to"`
+	// Data fills the templates of the event.
+	Data map[string]string `json:"data"`
+	// Payload is sent along push notifications for the app to act on them.
+	Payload map[string]string `json:"payload,omitempty"`
+}
+
+// Notifier delivers a message through a backend.
+type Notifier interface {
+	Notify(ctx context.Context, message *Message) error
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"strconv"
+	"sync"
+
+	"github.com/daniarmas/api_go/config"
+	gomail "gopkg.in/mail.v2"
+)
+
+// SMTPNotifier sends the messages as email. The connection to the server is
+// kept open between messages and dialed again when the server drops it.
+type SMTPNotifier struct {
+	dialer *gomail.Dialer
+	from   string
+	mu     sync.Mutex
+	sender gomail.SendCloser
+}
+
+func NewSMTPNotifier(cfg *config.Config) (*SMTPNotifier, error) {
+	port, err := strconv.Atoi(cfg.EmailSmtpPort)
+	if err != nil {
+		return nil, err
+	}
+	dialer := gomail.NewDialer(cfg.EmailHostname, port, cfg.EmailAddress, cfg.EmailAddressPassword)
+	dialer.TLSConfig = &tls.Config{ServerName: cfg.EmailHostname, MinVersion: tls.VersionTLS12}
+	// Port 465 uses implicit TLS, any other port must upgrade with STARTTLS.
+	dialer.StartTLSPolicy = gomail.MandatoryStartTLS
+	return &SMTPNotifier{dialer: dialer, from: cfg.EmailAddress}, nil
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, message *Message) error {
+	content, err := Render(message.Event, message.Locale, message.Data)
+	if err != nil {
+		return err
+	}
+	m := gomail.NewMessage()
+	m.SetHeader("From", n.from)
+	m.SetHeader("To", message.To)
+	m.SetHeader("Subject", content.Subject)
+	m.SetBody("text/plain", content.Text)
+	if content.Html != "" {
+		m.AddAlternative("text/html", content.Html)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.sender != nil {
+		if err = gomail.Send(n.sender, m); err == nil {
+			return nil
+		}
+		// The server may have closed an idle connection, retry once on a new one.
+		n.sender.Close()
+		n.sender = nil
+	}
+	sender, err := n.dialer.Dial()
+	if err != nil {
+		return err
+	}
+	n.sender = sender
+	return gomail.Send(n.sender, m)
+}
+
+// Close closes the connection to the server, if any.
+func (n *SMTPNotifier) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.sender == nil {
+		return nil
+	}
+	err := n.sender.Close()
+	n.sender = nil
+	return err
+}
@@ -0,0 +1,149 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+// Content is a message rendered for a locale. Html and Push are empty when
+// the event has no template for them.
+type Content struct {
+	Subject string
+	Text    string
+	Html    string
+	Push    string
+}
+
+type eventTemplates struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+	push    *texttemplate.Template
+}
+
+// templates holds the parsed templates by locale and event. Every template of
+// a locale can use the definitions in its partials.tmpl.
+var templates = mustLoadTemplates()
+
+func mustLoadTemplates() map[string]map[Event]*eventTemplates {
+	res, err := loadTemplates()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func loadTemplates() (map[string]map[Event]*eventTemplates, error) {
+	locales, err := fs.ReadDir(templatesFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]map[Event]*eventTemplates, len(locales))
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+		res[locale.Name()] = make(map[Event]*eventTemplates, len(Events))
+		for _, event := range Events {
+			var t eventTemplates
+			if t.subject, err = parseText(locale.Name(), event, "subject"); err != nil {
+				return nil, err
+			}
+			if t.text, err = parseText(locale.Name(), event, "txt"); err != nil {
+				return nil, err
+			}
+			if t.push, err = parseText(locale.Name(), event, "push"); err != nil {
+				return nil, err
+			}
+			if t.html, err = parseHtml(locale.Name(), event); err != nil {
+				return nil, err
+			}
+			if t.subject == nil || t.text == nil {
+				continue
+			}
+			res[locale.Name()][event] = &t
+		}
+	}
+	if _, ok := res[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("notification: no templates for the default locale %s", DefaultLocale)
+	}
+	return res, nil
+}
+
+func templateFile(locale string, event Event, kind string) (string, bool) {
+	name := path.Join("templates", locale, fmt.Sprintf("%s.%s.tmpl", event, kind))
+	_, err := fs.Stat(templatesFS, name)
+	return name, err == nil
+}
+
+func parseText(locale string, event Event, kind string) (*texttemplate.Template, error) {
+	name, ok := templateFile(locale, event, kind)
+	if !ok {
+		return nil, nil
+	}
+	return texttemplate.New(path.Base(name)).Option("missingkey=error").ParseFS(templatesFS, name, path.Join("templates", locale, "partials.tmpl"))
+}
+
+func parseHtml(locale string, event Event) (*htmltemplate.Template, error) {
+	name, ok := templateFile(locale, event, "html")
+	if !ok {
+		return nil, nil
+	}
+	return htmltemplate.New(path.Base(name)).Option("missingkey=error").ParseFS(templatesFS, name, path.Join("templates", locale, "partials.tmpl"))
+}
+
+// SupportedLocale reports whether there are templates for the locale.
+func SupportedLocale(locale string) bool {
+	_, ok := templates[locale]
+	return ok
+}
+
+// Render executes the templates of the event for the locale, falling back to
+// the default locale.
+func Render(event Event, locale string, data map[string]string) (*Content, error) {
+	t, ok := templates[locale][event]
+	if !ok {
+		t, ok = templates[DefaultLocale][event]
+	}
+	if !ok {
+		return nil, fmt.Errorf("notification: no templates for event %s", event)
+	}
+	var res Content
+	var err error
+	if res.Subject, err = executeText(t.subject, data); err != nil {
+		return nil, err
+	}
+	if res.Text, err = executeText(t.text, data); err != nil {
+		return nil, err
+	}
+	if res.Push, err = executeText(t.push, data); err != nil {
+		return nil, err
+	}
+	if t.html != nil {
+		var buf bytes.Buffer
+		if err = t.html.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		res.Html = buf.String()
+	}
+	return &res, nil
+}
+
+func executeText(t *texttemplate.Template, data map[string]string) (string, error) {
+	if t == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
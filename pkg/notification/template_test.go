@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+)
+
+var testData = map[string]string{
+	"code":          "123456",
+	"time":          "2022-01-02 15:04:05 +0000 UTC",
+	"model":         "Pixel",
+	"platform":      "Android",
+	"systemVersion": "12",
+	"appName":       "Mool",
+	"shortId":       "AB12",
+	"businessName":  "Café <Centro>",
+	"status":        "OrderStatusTypeAccepted",
+	"itemsQuantity": "3",
+	"priceCup":      "150.00",
+}
+
+func TestRenderEveryEventAndLocale(t *testing.T) {
+	for locale := range templates {
+		for _, event := range Events {
+			content, err := Render(event, locale, testData)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", locale, event, err)
+			}
+			if content.Subject == "" || content.Text == "" {
+				t.Fatalf("%s/%s: empty subject or text", locale, event)
+			}
+		}
+	}
+}
+
+func TestRenderFallsBackToDefaultLocale(t *testing.T) {
+	content, err := Render(EventVerificationCode, "xx", testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content.Text, "código") {
+		t.Fatalf("text = %q, want the %s template", content.Text, DefaultLocale)
+	}
+}
+
+func TestRenderEscapesHtml(t *testing.T) {
+	content, err := Render(EventOrderStatusChanged, "en", testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(content.Html, "<Centro>") {
+		t.Fatalf("html not escaped: %q", content.Html)
+	}
+	if !strings.Contains(content.Text, "was accepted") {
+		t.Fatalf("text = %q", content.Text)
+	}
+}
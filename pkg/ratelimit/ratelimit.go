@@ -0,0 +1,121 @@
+// Package ratelimit counts the requests of the api in Redis with sliding
+// window limits.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daniarmas/api_go/config"
+	"github.com/go-redis/redis/v9"
+	"github.com/google/uuid"
+)
+
+// Key tells who the requests of a policy are counted for.
+type Key string
+
+const (
+	KeyDevice Key = "device"
+	KeyEmail  Key = "email"
+	KeyIp     Key = "ip"
+	KeyUser   Key = "user"
+)
+
+// Policy allows Limit requests of a method by subject in any Window.
+type Policy struct {
+	Key    Key
+	Limit  int64
+	Window time.Duration
+}
+
+type Limiter struct {
+	rdb      *redis.Client
+	policies map[string][]Policy
+}
+
+// New builds a limiter with the policies of RATE_LIMIT_POLICIES.
+func New(cfg *config.Config, rdb *redis.Client) (*Limiter, error) {
+	policies, err := ParsePolicies(cfg.RateLimitPolicies)
+	if err != nil {
+		return nil, err
+	}
+	return &Limiter{rdb: rdb, policies: policies}, nil
+}
+
+// ParsePolicies parses a comma separated list of
+// <full method name>=<limit>/<window>/<key> policies, e.g.
+// "/main.AuthenticationService/SignIn=5/15m/email". A method can have a
+// policy by key.
+func ParsePolicies(value string) (map[string][]Policy, error) {
+	policies := map[string][]Policy{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		methodPolicy := strings.SplitN(item, "=", 2)
+		if len(methodPolicy) != 2 || !strings.HasPrefix(methodPolicy[0], "/") {
+			return nil, fmt.Errorf("ratelimit: invalid policy %q", item)
+		}
+		fields := strings.Split(methodPolicy[1], "/")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("ratelimit: invalid policy %q", item)
+		}
+		limit, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("ratelimit: invalid limit in policy %q", item)
+		}
+		window, err := time.ParseDuration(fields[1])
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("ratelimit: invalid window in policy %q", item)
+		}
+		key := Key(fields[2])
+		switch key {
+		case KeyDevice, KeyEmail, KeyIp, KeyUser:
+		default:
+			return nil, fmt.Errorf("ratelimit: invalid key in policy %q", item)
+		}
+		policies[methodPolicy[0]] = append(policies[methodPolicy[0]], Policy{Key: key, Limit: limit, Window: window})
+	}
+	return policies, nil
+}
+
+// Policies returns the policies of the method.
+func (l *Limiter) Policies(method string) []Policy {
+	return l.policies[method]
+}
+
+// slidingWindow keeps the time of every request of the window in a sorted
+// set. It returns 0 when the request is allowed, or else the milliseconds until
+// the oldest request leaves the window.
+var slidingWindow = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+if redis.call("ZCARD", KEYS[1]) < tonumber(ARGV[3]) then
+	redis.call("ZADD", KEYS[1], now, ARGV[4])
+	redis.call("PEXPIRE", KEYS[1], window)
+	return 0
+end
+local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+return tonumber(oldest[2]) + window - now
+`)
+
+// Allow counts the request of the subject against the policy of the method.
+// When the limit is reached the request is not counted, and it returns how
+// long the subject has to wait.
+func (l *Limiter) Allow(ctx context.Context, method string, policy Policy, subject string) (bool, time.Duration, error) {
+	key := fmt.Sprintf("rate_limit:%s:%s:%s", method, policy.Key, subject)
+	now := time.Now().UnixMilli()
+	retryAfter, err := slidingWindow.Run(ctx, l.rdb, []string{key}, now, policy.Window.Milliseconds(), policy.Limit, uuid.NewString()).Int64()
+	if err != nil {
+		return false, 0, err
+	}
+	if retryAfter > 0 {
+		return false, time.Duration(retryAfter) * time.Millisecond, nil
+	}
+	return true, 0, nil
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies(" /main.AuthenticationService/SignIn=5/15m/email, /main.AuthenticationService/SignIn=20/1h/ip,,/main.UserService/GetUser=100/1m/user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]Policy{
+		"/main.AuthenticationService/SignIn": {
+			{Key: KeyEmail, Limit: 5, Window: 15 * time.Minute},
+			{Key: KeyIp, Limit: 20, Window: time.Hour},
+		},
+		"/main.UserService/GetUser": {
+			{Key: KeyUser, Limit: 100, Window: time.Minute},
+		},
+	}
+	if !reflect.DeepEqual(policies, want) {
+		t.Errorf("got %v, want %v", policies, want)
+	}
+}
+
+func TestParsePoliciesInvalid(t *testing.T) {
+	for _, value := range []string{
+		"SignIn=5/15m/email",
+		"/main.AuthenticationService/SignIn",
+		"/main.AuthenticationService/SignIn=5/15m",
+		"/main.AuthenticationService/SignIn=0/15m/email",
+		"/main.AuthenticationService/SignIn=5/never/email",
+		"/main.AuthenticationService/SignIn=5/15m/phone",
+	} {
+		if _, err := ParsePolicies(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	log "github.com/sirupsen/logrus"
+)
+
+// Job is a task that runs periodically. Every run first takes a lease in Redis
+// named after the job, so when several replicas run the same scheduler only one
+// of them runs the job in each interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	rdb    *redis.Client
+	owner  string
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(rdb *redis.Client) *Scheduler {
+	hostname, _ := os.Hostname()
+	return &Scheduler{rdb: rdb, owner: fmt.Sprintf("%s:%d", hostname, os.Getpid())}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every job in its own goroutine until Stop is called.
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+			for {
+				s.run(ctx, job)
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	// The lease is not released after the run, it expires a little before the
+	// next tick so that a job runs at most once per interval across replicas.
+	acquired, err := s.rdb.SetNX(ctx, "lease:"+job.Name, s.owner, job.Interval-job.Interval/10).Result()
+	if err != nil {
+		log.Errorf("scheduler: lease %s: %v", job.Name, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	if err := job.Run(ctx); err != nil {
+		log.Errorf("scheduler: job %s: %v", job.Name, err)
+	}
+}
@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// AuthenticateFunc resolves the caller of the method and returns the context
+// the handler runs with.
+type AuthenticateFunc func(ctx context.Context, method string) (context.Context, error)
+
+// Resolve the caller of the Unary requests once, before the handler runs
+func UnaryPrincipalInterceptor(authenticate AuthenticateFunc) grpc.UnaryServerInterceptor {
+	return grpc_auth.UnaryServerInterceptor(methodAuthFunc(authenticate))
+}
+
+// Resolve the caller of the Stream requests once, before the handler runs
+func StreamPrincipalInterceptor(authenticate AuthenticateFunc) grpc.StreamServerInterceptor {
+	return grpc_auth.StreamServerInterceptor(methodAuthFunc(authenticate))
+}
+
+func methodAuthFunc(authenticate AuthenticateFunc) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		method, _ := grpc.Method(ctx)
+		if isHealthCheckRequest(method) {
+			return ctx, nil
+		}
+		return authenticate(ctx, method)
+	}
+}
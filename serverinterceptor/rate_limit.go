@@ -0,0 +1,29 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// RateLimitFunc counts the request against the limits of the method and
+// returns an error when one of them is exceeded.
+type RateLimitFunc func(ctx context.Context, method string, req interface{}) error
+
+// Reject the Unary requests over the limits of their method
+func UnaryRateLimitInterceptor(limit RateLimitFunc) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (_ interface{}, err error) {
+		if isHealthCheckRequest(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		err = limit(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
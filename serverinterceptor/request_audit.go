@@ -2,6 +2,8 @@ package interceptors
 
 import (
 	"context"
+	"fmt"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	logrus "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,8 +22,46 @@ func SetHealthCheckMethodName(methodName string) {
 	healthCheckMethodName = methodName
 }
 
+// TrustedProxies are the networks of the proxies in front of the server, only
+// the x-forwarded-for of their requests is honored.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses a comma separated list of CIDRs or addresses.
+func ParseTrustedProxies(value string) (TrustedProxies, error) {
+	var res TrustedProxies
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", item)
+			}
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", item)
+		}
+		res = append(res, network)
+	}
+	return res, nil
+}
+
+func (t TrustedProxies) contains(ip net.IP) bool {
+	for _, network := range t {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // Logging request information for Unary requests
-func UnaryAuditServiceRequest() grpc.UnaryServerInterceptor {
+func UnaryAuditServiceRequest(trustedProxies TrustedProxies) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -38,13 +78,14 @@ func UnaryAuditServiceRequest() grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
 		}
 
+		ip := clientIp(md, peer.Addr, trustedProxies)
 		start := time.Now()
-		resp, err := handler(ctx, req)
+		resp, err := handler(withClientIp(ctx, md, ip), req)
 		logRequest(
 			start,
 			info.FullMethod,
 			md["user-agent"],
-			peer.Addr,
+			ip,
 			info.FullMethod,
 			err,
 		)
@@ -54,7 +95,7 @@ func UnaryAuditServiceRequest() grpc.UnaryServerInterceptor {
 }
 
 // Logging request information for Stream requests
-func StreamAuditServiceRequest() grpc.StreamServerInterceptor {
+func StreamAuditServiceRequest(trustedProxies TrustedProxies) grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
 		stream grpc.ServerStream,
@@ -68,13 +109,16 @@ func StreamAuditServiceRequest() grpc.StreamServerInterceptor {
 		if !ok {
 			return status.Errorf(codes.InvalidArgument, "missing metadata")
 		}
+		ip := clientIp(md, peer.Addr, trustedProxies)
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withClientIp(stream.Context(), md, ip)
 		start := time.Now()
-		err = handler(srv, stream)
+		err = handler(srv, wrapped)
 		logRequest(
 			start,
 			info.FullMethod,
 			md["user-agent"],
-			peer.Addr,
+			ip,
 			info.FullMethod,
 			err,
 		)
@@ -82,7 +126,50 @@ func StreamAuditServiceRequest() grpc.StreamServerInterceptor {
 	}
 }
 
-func logRequest(start time.Time, requestMethod string, userAgents []string, ip net.Addr, fullMethod string, err error) {
+// ClientIpMetadataKey is the incoming metadata key of the ip of the client
+// resolved by the audit interceptor, read it with utils.GetClientIp.
+const ClientIpMetadataKey = "client-ip"
+
+// withClientIp passes the ip of the client down in the incoming metadata, the
+// value sent by the client under the same key is replaced.
+func withClientIp(ctx context.Context, md metadata.MD, ip string) context.Context {
+	md = md.Copy()
+	md.Set(ClientIpMetadataKey, ip)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// clientIp returns the address of the peer, or when the peer is a trusted
+// proxy, the last address of x-forwarded-for that isn't one. The addresses
+// before it were sent by the client and can be forged.
+func clientIp(md metadata.MD, addr net.Addr, trustedProxies TrustedProxies) string {
+	if addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		host = addr.String()
+	}
+	if peerIp := net.ParseIP(host); peerIp == nil || !trustedProxies.contains(peerIp) {
+		return host
+	}
+	var forwarded []string
+	for _, value := range md.Get("x-forwarded-for") {
+		forwarded = append(forwarded, strings.Split(value, ",")...)
+	}
+	for index := len(forwarded) - 1; index >= 0; index-- {
+		ip := net.ParseIP(strings.TrimSpace(forwarded[index]))
+		if ip == nil {
+			break
+		}
+		host = ip.String()
+		if !trustedProxies.contains(ip) {
+			break
+		}
+	}
+	return host
+}
+
+func logRequest(start time.Time, requestMethod string, userAgents []string, ip string, fullMethod string, err error) {
 	if isHealthCheckRequest(requestMethod) {
 		return
 	}
@@ -125,7 +212,7 @@ func logRequest(start time.Time, requestMethod string, userAgents []string, ip n
 }
 
 func isHealthCheckRequest(requestMethod string) bool {
-	if strings.Contains(requestMethod, "Health/Check") {
+	if strings.Contains(requestMethod, "Health/Check") || strings.Contains(requestMethod, "Health/Watch") {
 		return true
 	}
 	return false
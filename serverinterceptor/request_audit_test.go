@@ -0,0 +1,41 @@
+package interceptors
+
+import (
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestClientIp(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies("10.0.0.0/8, 192.168.1.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded string
+		want      string
+	}{
+		{"no proxy", "203.0.113.7:5000", "", "203.0.113.7"},
+		{"untrusted peer", "203.0.113.7:5000", "198.51.100.1", "203.0.113.7"},
+		{"trusted proxy", "10.0.0.2:5000", "198.51.100.1", "198.51.100.1"},
+		{"forged by the client", "10.0.0.2:5000", "1.2.3.4, 198.51.100.1", "198.51.100.1"},
+		{"proxy chain", "10.0.0.2:5000", "198.51.100.1, 192.168.1.1, 10.0.0.3", "198.51.100.1"},
+		{"invalid address", "10.0.0.2:5000", "unknown", "10.0.0.2"},
+	}
+	for _, test := range tests {
+		md := metadata.MD{}
+		if test.forwarded != "" {
+			md.Set("x-forwarded-for", test.forwarded)
+		}
+		addr, _ := net.ResolveTCPAddr("tcp", test.peer)
+		if got := clientIp(md, addr, trustedProxies); got != test.want {
+			t.Errorf("%s: clientIp() = %q, want %q", test.name, got, test.want)
+		}
+	}
+	if _, err := ParseTrustedProxies("10.0.0.0/33"); err == nil {
+		t.Error("ParseTrustedProxies() accepted an invalid CIDR")
+	}
+}
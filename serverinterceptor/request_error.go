@@ -0,0 +1,54 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/daniarmas/api_go/pkg/apperror"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Convert the errors returned by the Unary handlers into gRPC statuses
+func UnaryErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (_ interface{}, err error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, errorStatus(err, info.FullMethod)
+		}
+		return resp, nil
+	}
+}
+
+// Convert the errors returned by the Stream handlers into gRPC statuses
+func StreamErrorInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		err = handler(srv, stream)
+		if err != nil {
+			return errorStatus(err, info.FullMethod)
+		}
+		return nil
+	}
+}
+
+// errorStatus keeps the statuses built by the handlers, maps the domain errors
+// to their code and hides anything else behind an internal error.
+func errorStatus(err error, method string) error {
+	if appErr := apperror.Convert(err); appErr != nil {
+		return appErr.GRPCStatus().Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	log.WithFields(log.Fields{"err": err}).Error(method)
+	return status.Error(codes.Internal, "Internal server error")
+}
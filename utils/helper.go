@@ -18,11 +18,12 @@ func requestErrorHandler(p interface{}) (err error) {
 }
 
 // GetDefaultUnaryServerInterceptors returns the default interceptors server unary connections
-func GetDefaultUnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+func GetDefaultUnaryServerInterceptors(isPublic interceptors.PublicMethodFunc, trustedProxies interceptors.TrustedProxies) []grpc.UnaryServerInterceptor {
 	return []grpc.UnaryServerInterceptor{
-		interceptors.UnaryAuditServiceRequest(),
+		interceptors.UnaryAuditServiceRequest(trustedProxies),
 		interceptors.UnaryLogRequestCanceled(),
-		interceptors.UnaryMetadataRequestInterceptor(),
+		interceptors.UnaryMetadataRequestInterceptor(isPublic),
+		interceptors.UnaryErrorInterceptor(),
 		//Recovery handlers should typically be last in the chain so that other middleware
 		// (e.g. logging) can operate on the recovered state instead of being directly affected by any panic
 		grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(requestErrorHandler)),
@@ -30,11 +31,12 @@ func GetDefaultUnaryServerInterceptors() []grpc.UnaryServerInterceptor {
 }
 
 // GetDefaultStreamServerInterceptors returns the default interceptors for server streams connections
-func GetDefaultStreamServerInterceptors() []grpc.StreamServerInterceptor {
+func GetDefaultStreamServerInterceptors(isPublic interceptors.PublicMethodFunc, trustedProxies interceptors.TrustedProxies) []grpc.StreamServerInterceptor {
 	return []grpc.StreamServerInterceptor{
-		interceptors.StreamAuditServiceRequest(),
+		interceptors.StreamAuditServiceRequest(trustedProxies),
 		interceptors.StreamLogRequestCanceled(),
-		interceptors.StreamMetadataRequestInterceptor(),
+		interceptors.StreamMetadataRequestInterceptor(isPublic),
+		interceptors.StreamErrorInterceptor(),
 		grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(requestErrorHandler)),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	interceptors "github.com/daniarmas/api_go/serverinterceptor"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -43,3 +44,13 @@ func GetMetadata(ctx context.Context) *ClientMetadata {
 	}
 	return &resMetadata
 }
+
+// GetClientIp returns the ip of the client resolved by the audit interceptor,
+// or "" when the request didn't go through it.
+func GetClientIp(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ip := md.Get(interceptors.ClientIpMetadataKey); len(ip) != 0 {
+		return ip[0]
+	}
+	return ""
+}